@@ -0,0 +1,93 @@
+package blob_storage
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"cloud.google.com/go/storage"
+)
+
+// NewGCSStorage is storage on google cloud storage bucket, used on production where instance disk is
+// ephemeral and not shared between instance.
+func NewGCSStorage(ctx context.Context, bucket string) (Storage, error) {
+	if bucket == "" {
+		return nil, errors.New("gcs bucket empty")
+	}
+
+	client, err := storage.NewClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &gcsStorage{
+		bucket: client.Bucket(bucket),
+	}, nil
+}
+
+type gcsStorage struct {
+	bucket *storage.BucketHandle
+}
+
+func (g *gcsStorage) object(key string) (*storage.ObjectHandle, error) {
+	err := validKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return g.bucket.Object(key), nil
+}
+
+// Put implements Storage. object only visible after writer closed successfully.
+func (g *gcsStorage) Put(ctx context.Context, key string, content io.Reader) error {
+	object, err := g.object(key)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	writer := object.NewWriter(ctx)
+	_, err = io.Copy(writer, content)
+	if err != nil {
+		// cancel before close so half written object is discarded
+		cancel()
+		writer.Close()
+		return err
+	}
+
+	return writer.Close()
+}
+
+// Get implements Storage. content is streamed from bucket.
+func (g *gcsStorage) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	object, err := g.object(key)
+	if err != nil {
+		return nil, err
+	}
+
+	reader, err := object.NewReader(ctx)
+	if errors.Is(err, storage.ErrObjectNotExist) {
+		return nil, ErrBlobNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return reader, nil
+}
+
+// Delete implements Storage. deleting missing blob is not error.
+func (g *gcsStorage) Delete(ctx context.Context, key string) error {
+	object, err := g.object(key)
+	if err != nil {
+		return err
+	}
+
+	err = object.Delete(ctx)
+	if errors.Is(err, storage.ErrObjectNotExist) {
+		return nil
+	}
+	return err
+}
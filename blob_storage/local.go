@@ -0,0 +1,94 @@
+package blob_storage
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// NewLocalStorage is storage on local filesystem under dir, only for test. production instance disk is
+// ephemeral and not shared, use NewGCSStorage.
+func NewLocalStorage(dir string) Storage {
+	return &localStorage{
+		dir: dir,
+	}
+}
+
+type localStorage struct {
+	dir string
+}
+
+func (l *localStorage) filePath(key string) (string, error) {
+	err := validKey(key)
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(l.dir, filepath.FromSlash(key)), nil
+}
+
+// Put implements Storage. content written to temp file first so reader never get half written file.
+func (l *localStorage) Put(ctx context.Context, key string, content io.Reader) error {
+	filename, err := l.filePath(key)
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(filepath.Dir(filename), 0o755)
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(filename), ".upload-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	_, err = io.Copy(tmp, content)
+	if err != nil {
+		tmp.Close()
+		return err
+	}
+	err = tmp.Close()
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), filename)
+}
+
+// Get implements Storage.
+func (l *localStorage) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	filename, err := l.filePath(key)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(filename)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, ErrBlobNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return file, nil
+}
+
+// Delete implements Storage. deleting missing blob is not error.
+func (l *localStorage) Delete(ctx context.Context, key string) error {
+	filename, err := l.filePath(key)
+	if err != nil {
+		return err
+	}
+
+	err = os.Remove(filename)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	return err
+}
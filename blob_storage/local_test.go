@@ -0,0 +1,47 @@
+package blob_storage_test
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/pdcgo/warehouse_service/blob_storage"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocalStorage(t *testing.T) {
+	ctx := context.Background()
+	storage := blob_storage.NewLocalStorage(t.TempDir())
+
+	err := storage.Put(ctx, "expense/1/10/receipt.jpg", strings.NewReader("receipt"))
+	assert.Nil(t, err)
+
+	t.Run("test get", func(t *testing.T) {
+		reader, err := storage.Get(ctx, "expense/1/10/receipt.jpg")
+		assert.Nil(t, err)
+		defer reader.Close()
+
+		content, err := io.ReadAll(reader)
+		assert.Nil(t, err)
+		assert.Equal(t, "receipt", string(content))
+	})
+
+	t.Run("test invalid key", func(t *testing.T) {
+		for _, key := range []string{"", "../secret", "/etc/passwd", "expense/../../secret", "expense//a"} {
+			_, err := storage.Get(ctx, key)
+			assert.ErrorIs(t, err, blob_storage.ErrInvalidKey, key)
+		}
+	})
+
+	t.Run("test delete", func(t *testing.T) {
+		err := storage.Delete(ctx, "expense/1/10/receipt.jpg")
+		assert.Nil(t, err)
+
+		_, err = storage.Get(ctx, "expense/1/10/receipt.jpg")
+		assert.ErrorIs(t, err, blob_storage.ErrBlobNotFound)
+
+		err = storage.Delete(ctx, "expense/1/10/receipt.jpg")
+		assert.Nil(t, err)
+	})
+}
@@ -0,0 +1,30 @@
+package blob_storage
+
+import (
+	"context"
+	"errors"
+	"io"
+	"path"
+	"strings"
+)
+
+var ErrBlobNotFound = errors.New("blob not found")
+var ErrInvalidKey = errors.New("invalid blob key")
+
+// Storage is where file content is kept, database only keeps the key.
+// key is slash separated path, like "expense/1/10/receipt.jpg".
+type Storage interface {
+	Put(ctx context.Context, key string, content io.Reader) error
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+}
+
+// validKey rejecting key that is not clean relative path, so it can't escape storage root.
+func validKey(key string) error {
+	clean := path.Clean(key)
+	if key == "" || clean != key || path.IsAbs(clean) || clean == ".." || strings.HasPrefix(clean, "../") {
+		return ErrInvalidKey
+	}
+
+	return nil
+}
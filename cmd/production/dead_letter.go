@@ -0,0 +1,131 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"os"
+
+	"github.com/pdcgo/schema/services/common/v1"
+	"github.com/pdcgo/warehouse_service/v2"
+	"github.com/pdcgo/warehouse_service/warehouse_models"
+	"github.com/urfave/cli/v3"
+)
+
+type DeadLetterCommand *cli.Command
+
+func NewDeadLetterCommand(service *warehouse_service.DeadLetterService) DeadLetterCommand {
+	printJson := func(data any) error {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(data)
+	}
+
+	return &cli.Command{
+		Name:  "dead-letter",
+		Usage: "list, inspect and replay stock event that failed on push handler",
+		Commands: []*cli.Command{
+			{
+				Name: "list",
+				Flags: []cli.Flag{
+					&cli.StringFlag{Name: "status", Usage: "retrying, dead, replaying, replayed or resolved"},
+					&cli.IntFlag{Name: "page", Value: 1},
+					&cli.IntFlag{Name: "limit", Value: 20},
+				},
+				Action: func(ctx context.Context, cmd *cli.Command) error {
+					data, pageInfo, err := service.List(ctx,
+						warehouse_models.DeadLetterStatus(cmd.String("status")),
+						&common.PageFilter{
+							Page:  int64(cmd.Int("page")),
+							Limit: int64(cmd.Int("limit")),
+						},
+					)
+					if err != nil {
+						return err
+					}
+
+					return printJson(map[string]any{
+						"data":      data,
+						"page_info": pageInfo,
+					})
+				},
+			},
+			{
+				Name: "inspect",
+				Flags: []cli.Flag{
+					&cli.Uint64Flag{Name: "id", Required: true},
+				},
+				Action: func(ctx context.Context, cmd *cli.Command) error {
+					data, err := service.Get(ctx, cmd.Uint64("id"))
+					if err != nil {
+						return err
+					}
+
+					return printJson(data)
+				},
+			},
+			{
+				Name: "replay",
+				Flags: []cli.Flag{
+					&cli.Uint64Flag{Name: "id", Usage: "dead letter id"},
+					&cli.BoolFlag{Name: "all", Usage: "replaying all dead"},
+				},
+				Action: func(ctx context.Context, cmd *cli.Command) error {
+					id := cmd.Uint64("id")
+					all := cmd.Bool("all")
+					switch {
+					case id != 0 && all:
+						return errors.New("use either --id or --all")
+					case id != 0:
+						data, err := service.Replay(ctx, id)
+						if err != nil {
+							return err
+						}
+
+						return printJson(data)
+					case !all:
+						return errors.New("--id or --all is required")
+					}
+
+					ids := []uint64{}
+					for page := int64(1); ; page++ {
+						data, _, err := service.List(ctx, warehouse_models.DeadLetterDead, &common.PageFilter{
+							Page:  page,
+							Limit: 100,
+						})
+						if err != nil {
+							return err
+						}
+
+						if len(data) == 0 {
+							break
+						}
+
+						for _, item := range data {
+							ids = append(ids, item.ID)
+						}
+					}
+
+					var failed int
+					for _, id := range ids {
+						data, err := service.Replay(ctx, id)
+						if err != nil {
+							failed++
+							slog.Error("replay failed", "id", id, "err", err.Error())
+							continue
+						}
+
+						slog.Info("replayed", "id", id, "message_id", data.MessageID)
+					}
+
+					if failed != 0 {
+						return errors.New("some dead letter failed to replay")
+					}
+
+					return nil
+				},
+			},
+		},
+	}
+}
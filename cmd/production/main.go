@@ -11,6 +11,7 @@ import (
 	"github.com/pdcgo/shared/interfaces/authorization_iface"
 	"github.com/pdcgo/shared/pkg/cloud_logging"
 	"github.com/pdcgo/shared/pkg/ware_cache"
+	"github.com/pdcgo/warehouse_service/blob_storage"
 	"github.com/urfave/cli/v3"
 	"gorm.io/gorm"
 )
@@ -33,22 +34,39 @@ func NewAuthorization(
 	return authorization.NewAuthorization(cache, db, cfg.JwtSecret)
 }
 
+// NewBlobStorage is storage of expense attachment on gcs bucket BLOB_STORAGE_BUCKET. local disk is not used
+// because instance disk is ephemeral and not shared between instance.
+func NewBlobStorage() (blob_storage.Storage, error) {
+	return blob_storage.NewGCSStorage(context.Background(), os.Getenv("BLOB_STORAGE_BUCKET"))
+}
+
 func NewDatabase(cfg *configs.AppConfig) (*gorm.DB, error) {
 	return db_connect.NewProductionDatabase("warehouse_service", &cfg.Database)
 }
 
 func NewApp(
 	serviceFunc ServiceApiFunc,
-	prepareStatFunc PrepareStatFunc,
+	prepareStatCommand PrepareStatCommand,
+	deadLetterCommand DeadLetterCommand,
+	outboxCommand OutboxCommand,
+	rebuildDailyHistoryCommand RebuildDailyHistoryCommand,
+	reconcileStockCommand ReconcileStockCommand,
+	reconcileAccountBalanceCommand ReconcileAccountBalanceCommand,
+	warehouseTimezoneCommand WarehouseTimezoneCommand,
+	warehouseStatCommand WarehouseStatCommand,
 ) *cli.Command {
 	return &cli.Command{
 		Name:   "Warehouse Service",
 		Action: cli.ActionFunc(serviceFunc),
 		Commands: []*cli.Command{
-			{
-				Name:   "prepare-stat",
-				Action: cli.ActionFunc(prepareStatFunc),
-			},
+			prepareStatCommand,
+			deadLetterCommand,
+			outboxCommand,
+			rebuildDailyHistoryCommand,
+			reconcileStockCommand,
+			reconcileAccountBalanceCommand,
+			warehouseTimezoneCommand,
+			warehouseStatCommand,
 		},
 	}
 }
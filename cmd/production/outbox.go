@@ -0,0 +1,57 @@
+package main
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/pdcgo/warehouse_service/v2"
+	"github.com/urfave/cli/v3"
+)
+
+type OutboxCommand *cli.Command
+
+func NewOutboxCommand(publisher *warehouse_service.StockOutboxPublisher) OutboxCommand {
+	return &cli.Command{
+		Name:  "outbox",
+		Usage: "publish and retry stock change outbox",
+		Commands: []*cli.Command{
+			{
+				Name:  "publish",
+				Usage: "publish pending outbox that is due",
+				Action: func(ctx context.Context, cmd *cli.Command) error {
+					var total int
+					for {
+						count, err := publisher.PublishPending(ctx)
+						if err != nil {
+							return err
+						}
+
+						total += count
+						if count < publisher.BatchSize {
+							break
+						}
+					}
+
+					slog.Info("outbox processed", "count", total)
+					return nil
+				},
+			},
+			{
+				Name:  "retry",
+				Usage: "put failed outbox back to pending",
+				Flags: []cli.Flag{
+					&cli.Uint64Flag{Name: "id", Usage: "outbox id, empty for retrying all failed"},
+				},
+				Action: func(ctx context.Context, cmd *cli.Command) error {
+					count, err := publisher.RetryFailed(ctx, cmd.Uint64("id"))
+					if err != nil {
+						return err
+					}
+
+					slog.Info("outbox set to pending", "count", count)
+					return nil
+				},
+			},
+		},
+	}
+}
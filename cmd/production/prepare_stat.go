@@ -2,121 +2,413 @@ package main
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log/slog"
 	"net/http"
+	"os"
+	"os/signal"
+	"sync"
+	"sync/atomic"
+	"syscall"
+	"time"
 
 	"connectrpc.com/connect"
 	"github.com/pdcgo/schema/services/warehouse_iface/v1"
 	"github.com/pdcgo/schema/services/warehouse_iface/v1/warehouse_ifaceconnect"
 	"github.com/pdcgo/shared/configs"
 	"github.com/pdcgo/shared/custom_connect"
+	"github.com/pdcgo/warehouse_service/warehouse_models"
 	"github.com/urfave/cli/v3"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
-type PrepareStatFunc cli.ActionFunc
+type PrepareStatCommand *cli.Command
 
-func NewPrepareStat(db *gorm.DB, cfg *configs.AppConfig) PrepareStatFunc {
-	return func(ctx context.Context, cmd *cli.Command) error {
-		cancel, err := custom_connect.InitTracer("warehouse-service")
+func NewPrepareStatCommand(db *gorm.DB, cfg *configs.AppConfig) PrepareStatCommand {
+	return &cli.Command{
+		Name:  "prepare-stat",
+		Usage: "preparing daily sku history for every sku with stock, resumable by run id",
+		Flags: []cli.Flag{
+			&cli.IntFlag{Name: "concurrency", Value: 4, Usage: "worker count calling PrepareSkus"},
+			&cli.IntFlag{Name: "batch", Value: 100, Usage: "sku per PrepareSkus call"},
+			&cli.Uint64Flag{Name: "warehouse", Usage: "warehouse id, empty for all warehouse"},
+			&cli.Uint64Flag{Name: "team", Usage: "team id, empty for all team"},
+			&cli.Uint64Flag{Name: "resume", Usage: "run id to continue, filter and batch size taken from the run"},
+			&cli.IntFlag{Name: "max-attempt", Value: 3, Usage: "attempt per batch before marked failed"},
+			&cli.DurationFlag{Name: "backoff", Value: time.Second * 2, Usage: "first retry delay, doubled every attempt"},
+		},
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			cancel, err := custom_connect.InitTracer("warehouse-service")
+			if err != nil {
+				return err
+			}
+
+			defer cancel(context.Background())
+
+			ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+			defer stop()
+
+			run, err := getPrepareStatRun(db.WithContext(ctx), cmd)
+			if err != nil {
+				return err
+			}
+
+			// creating client
+			inventoryServiceClient := warehouse_ifaceconnect.NewInventoryServiceClient(
+				http.DefaultClient,
+				cfg.InventoryService.Endpoint,
+				connect.WithGRPC(),
+			)
+
+			prepare := &prepareStat{
+				db:          db,
+				run:         run,
+				concurrency: max(cmd.Int("concurrency"), 1),
+				maxAttempt:  max(cmd.Int("max-attempt"), 1),
+				backoff:     cmd.Duration("backoff"),
+				prepareSkus: func(ctx context.Context, skuIDs []string) error {
+					_, err := inventoryServiceClient.PrepareSkus(ctx, &connect.Request[warehouse_iface.PrepareSkusRequest]{
+						Msg: &warehouse_iface.PrepareSkusRequest{
+							SkuIds: skuIDs,
+						},
+					})
+					return err
+				},
+			}
+
+			return prepare.Run(ctx)
+		},
+	}
+}
+
+func getPrepareStatRun(db *gorm.DB, cmd *cli.Command) (*warehouse_models.PrepareStatRun, error) {
+	run := warehouse_models.PrepareStatRun{}
+
+	runID := cmd.Uint64("resume")
+	if runID != 0 {
+		err := db.
+			Model(&warehouse_models.PrepareStatRun{}).
+			Where("id = ?", runID).
+			First(&run).
+			Error
 		if err != nil {
-			return err
+			return nil, err
 		}
 
-		defer cancel(ctx)
+		if run.Status == warehouse_models.PrepareStatDone {
+			return nil, fmt.Errorf("prepare stat run %d already done", run.ID)
+		}
 
-		skuChan := make(chan []string, 10)
+		return &run, nil
+	}
 
-		go func() {
-			slog.Info("running producer..")
-			defer close(skuChan)
+	run = warehouse_models.PrepareStatRun{
+		WarehouseID: cmd.Uint64("warehouse"),
+		TeamID:      cmd.Uint64("team"),
+		BatchSize:   max(cmd.Int("batch"), 1),
+		Status:      warehouse_models.PrepareStatRunning,
+	}
+
+	err := db.Create(&run).Error
+	return &run, err
+}
 
-			ctx, span := otel.Tracer("").Start(ctx, "prepare-statistic-producer")
-			defer span.End()
+type prepareStatBatch struct {
+	skuIDs []string
+}
 
-			db = db.WithContext(ctx)
+func (b *prepareStatBatch) first() string {
+	return b.skuIDs[0]
+}
 
-			query := db.
-				Raw(`
-				select 
-					distinct sku_id
-				from invertory_histories ih 
-				where ih.tx_id is null
-			`)
+func (b *prepareStatBatch) last() string {
+	return b.skuIDs[len(b.skuIDs)-1]
+}
 
-			rows, err := query.Rows()
+type prepareStat struct {
+	db          *gorm.DB
+	run         *warehouse_models.PrepareStatRun
+	concurrency int
+	maxAttempt  int
+	backoff     time.Duration
+	prepareSkus func(ctx context.Context, skuIDs []string) error
 
-			if err != nil {
-				slog.Error(err.Error())
-				span.SetAttributes(
-					attribute.String("producer.error", err.Error()),
-				)
-				span.SetStatus(codes.Error, err.Error())
-				return
-			}
+	successBatch atomic.Int64
+	failedBatch  atomic.Int64
+	skippedBatch atomic.Int64
+	successSku   atomic.Int64
+	failedSku    atomic.Int64
+}
 
-			defer rows.Close()
+func (p *prepareStat) Run(ctx context.Context) error {
+	var err error
 
-			result := []string{}
+	ctx, span := otel.Tracer("").Start(ctx, "prepare-statistic")
+	defer span.End()
 
-			batchCount := 100
+	slog.Info("running prepare stat",
+		"run_id", p.run.ID,
+		"warehouse_id", p.run.WarehouseID,
+		"team_id", p.run.TeamID,
+		"concurrency", p.concurrency,
+	)
 
-			for rows.Next() {
-				var skuID string
-				err = rows.Scan(&skuID)
+	// batch already done in previous attempt of same run
+	doneBatches := []*warehouse_models.PrepareStatCheckpoint{}
+	err = p.db.
+		WithContext(ctx).
+		Model(&warehouse_models.PrepareStatCheckpoint{}).
+		Where("run_id = ?", p.run.ID).
+		Where("status = ?", warehouse_models.PrepareStatDone).
+		Find(&doneBatches).
+		Error
+	if err != nil {
+		return err
+	}
 
-				if err != nil {
-					slog.Error(err.Error())
-					span.SetAttributes(
-						attribute.String("producer.parsing.error", err.Error()),
-					)
-					span.SetStatus(codes.Error, err.Error())
-					return
-				}
+	dones := map[string]bool{}
+	for _, done := range doneBatches {
+		dones[done.FirstSkuID+"|"+done.LastSkuID] = true
+	}
 
-				result = append(result, skuID)
+	batchChan := make(chan *prepareStatBatch, p.concurrency)
 
-				if len(result) > batchCount {
-					skuChan <- result
-					result = []string{}
-				}
-			}
+	var producerErr error
+	go func() {
+		defer close(batchChan)
+		producerErr = p.produce(ctx, dones, batchChan)
+	}()
 
-			if len(result) > 0 {
-				skuChan <- result
+	var wg sync.WaitGroup
+	for range p.concurrency {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for batch := range batchChan {
+				p.consume(ctx, batch)
 			}
 		}()
+	}
+	wg.Wait()
+
+	return p.finish(ctx, span, producerErr)
+}
 
-		// creating client
-		inventoryServiceClient := warehouse_ifaceconnect.NewInventoryServiceClient(
-			http.DefaultClient,
-			cfg.InventoryService.Endpoint,
-			connect.WithGRPC(),
+func (p *prepareStat) produce(ctx context.Context, dones map[string]bool, batchChan chan<- *prepareStatBatch) error {
+	ctx, span := otel.Tracer("").Start(ctx, "prepare-statistic-producer")
+	defer span.End()
+
+	// ordered so batch boundary stay same between resume
+	query := p.db.
+		WithContext(ctx).
+		Table("invertory_histories ih").
+		Joins("join skus s on s.id = ih.sku_id").
+		Where("ih.tx_id is null").
+		Distinct("ih.sku_id").
+		Order("ih.sku_id asc")
+
+	if p.run.WarehouseID != 0 {
+		query = query.Where("s.warehouse_id = ?", p.run.WarehouseID)
+	}
+	if p.run.TeamID != 0 {
+		query = query.Where("s.team_id = ?", p.run.TeamID)
+	}
+
+	rows, err := query.Rows()
+	if err != nil {
+		span.SetAttributes(
+			attribute.String("producer.error", err.Error()),
 		)
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
 
-		ctx, span := otel.Tracer("").Start(ctx, "prepare-statistic-consumer")
-		defer span.End()
+	defer rows.Close()
 
-		for skuIds := range skuChan {
-			slog.Info("preparing", "count", len(skuIds))
-			_, err = inventoryServiceClient.PrepareSkus(ctx, &connect.Request[warehouse_iface.PrepareSkusRequest]{
-				Msg: &warehouse_iface.PrepareSkusRequest{
-					SkuIds: skuIds,
-				},
-			})
+	send := func(skuIDs []string) error {
+		batch := &prepareStatBatch{skuIDs: skuIDs}
+		if dones[batch.first()+"|"+batch.last()] {
+			p.skippedBatch.Add(1)
+			return nil
+		}
 
+		select {
+		case batchChan <- batch:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	result := []string{}
+	for rows.Next() {
+		var skuID string
+		err = rows.Scan(&skuID)
+		if err != nil {
+			span.SetAttributes(
+				attribute.String("producer.parsing.error", err.Error()),
+			)
+			span.SetStatus(codes.Error, err.Error())
+			return err
+		}
+
+		result = append(result, skuID)
+
+		if len(result) >= p.run.BatchSize {
+			err = send(result)
 			if err != nil {
-				slog.Error(err.Error())
-				span.SetAttributes(
-					attribute.String("consumer.error", err.Error()),
-				)
-				span.SetStatus(codes.Error, err.Error())
+				return err
 			}
+			result = []string{}
+		}
+	}
+
+	if len(result) > 0 {
+		return send(result)
+	}
+
+	return rows.Err()
+}
+
+func (p *prepareStat) consume(ctx context.Context, batch *prepareStatBatch) {
+	var err error
+
+	ctx, span := otel.Tracer("").Start(ctx, "prepare-statistic-consumer")
+	defer span.End()
+
+	attempt := 0
+	for attempt < p.maxAttempt {
+		attempt++
+
+		err = p.prepareSkus(ctx, batch.skuIDs)
+		if err == nil || ctx.Err() != nil {
+			break
+		}
+
+		slog.Warn("prepare skus failed",
+			"first_sku_id", batch.first(),
+			"attempt", attempt,
+			"err", err.Error(),
+		)
+
+		if attempt == p.maxAttempt {
+			break
 		}
 
-		return nil
+		select {
+		case <-time.After(p.backoff * time.Duration(1<<(attempt-1))):
+		case <-ctx.Done():
+		}
+	}
+
+	checkpoint := warehouse_models.PrepareStatCheckpoint{
+		RunID:        p.run.ID,
+		FirstSkuID:   batch.first(),
+		LastSkuID:    batch.last(),
+		SkuCount:     len(batch.skuIDs),
+		Status:       warehouse_models.PrepareStatDone,
+		AttemptCount: attempt,
+		UpdatedAt:    time.Now(),
+	}
+
+	if err != nil {
+		span.SetAttributes(
+			attribute.String("consumer.error", err.Error()),
+		)
+		span.SetStatus(codes.Error, err.Error())
+
+		checkpoint.Status = warehouse_models.PrepareStatFailed
+		checkpoint.Error = err.Error()
+		p.failedBatch.Add(1)
+		p.failedSku.Add(int64(len(batch.skuIDs)))
+	} else {
+		p.successBatch.Add(1)
+		p.successSku.Add(int64(len(batch.skuIDs)))
+	}
+
+	// checkpoint still written when interrupted
+	err = p.db.
+		WithContext(context.WithoutCancel(ctx)).
+		Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "run_id"}, {Name: "first_sku_id"}, {Name: "last_sku_id"}},
+			DoUpdates: clause.Assignments(map[string]interface{}{
+				"sku_count":     checkpoint.SkuCount,
+				"status":        checkpoint.Status,
+				"attempt_count": gorm.Expr("prepare_stat_checkpoints.attempt_count + ?", checkpoint.AttemptCount),
+				"error":         checkpoint.Error,
+				"updated_at":    checkpoint.UpdatedAt,
+			}),
+		}).
+		Create(&checkpoint).
+		Error
+	if err != nil {
+		slog.Error("saving prepare stat checkpoint failed", "err", err.Error())
+	}
+}
+
+func (p *prepareStat) finish(ctx context.Context, span trace.Span, producerErr error) error {
+	summary := []attribute.KeyValue{
+		attribute.Int64("prepare_stat.run_id", int64(p.run.ID)),
+		attribute.Int64("prepare_stat.batch.success", p.successBatch.Load()),
+		attribute.Int64("prepare_stat.batch.failed", p.failedBatch.Load()),
+		attribute.Int64("prepare_stat.batch.skipped", p.skippedBatch.Load()),
+		attribute.Int64("prepare_stat.sku.success", p.successSku.Load()),
+		attribute.Int64("prepare_stat.sku.failed", p.failedSku.Load()),
+	}
+	span.SetAttributes(summary...)
+
+	status := warehouse_models.PrepareStatDone
+	var err error
+	switch {
+	case producerErr != nil:
+		status = warehouse_models.PrepareStatFailed
+		err = producerErr
+	case ctx.Err() != nil:
+		// interrupted, left running so it can be resumed
+		status = warehouse_models.PrepareStatRunning
+		err = ctx.Err()
+	case p.failedBatch.Load() != 0:
+		status = warehouse_models.PrepareStatFailed
+		err = fmt.Errorf("prepare stat run %d has %d failed batch, resume with --resume %d", p.run.ID, p.failedBatch.Load(), p.run.ID)
 	}
+
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	updates := map[string]interface{}{
+		"status":        status,
+		"success_count": gorm.Expr("success_count + ?", p.successBatch.Load()),
+		"failed_count":  p.failedBatch.Load(),
+		"skipped_count": p.skippedBatch.Load(),
+		"updated_at":    time.Now(),
+	}
+	if status != warehouse_models.PrepareStatRunning {
+		updates["finished_at"] = time.Now()
+	}
+
+	saveErr := p.db.
+		WithContext(context.WithoutCancel(ctx)).
+		Model(&warehouse_models.PrepareStatRun{}).
+		Where("id = ?", p.run.ID).
+		Updates(updates).
+		Error
+
+	slog.Info("prepare stat summary",
+		"run_id", p.run.ID,
+		"status", status,
+		"batch_success", p.successBatch.Load(),
+		"batch_failed", p.failedBatch.Load(),
+		"batch_skipped", p.skippedBatch.Load(),
+		"sku_success", p.successSku.Load(),
+		"sku_failed", p.failedSku.Load(),
+	)
+
+	return errors.Join(err, saveErr)
 }
@@ -0,0 +1,138 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/pdcgo/shared/db_models"
+	"github.com/pdcgo/shared/pkg/moretest"
+	"github.com/pdcgo/shared/pkg/moretest/moretest_mock"
+	"github.com/pdcgo/warehouse_service/warehouse_models"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestPrepareStat(t *testing.T) {
+	var db gorm.DB
+
+	var migrate moretest.SetupFunc = func(t *testing.T) func() error {
+		err := db.AutoMigrate(
+			&db_models.Sku{},
+			&db_models.InvertoryHistory{},
+			&warehouse_models.PrepareStatRun{},
+			&warehouse_models.PrepareStatCheckpoint{},
+		)
+		assert.NoError(t, err)
+
+		for _, skuID := range []string{"sku-1", "sku-2", "sku-3", "sku-4", "sku-5"} {
+			err = db.Create(&db_models.Sku{ID: db_models.SkuID(skuID), WarehouseID: 1, TeamID: 1}).Error
+			assert.NoError(t, err)
+			err = db.Create(&db_models.InvertoryHistory{SkuID: db_models.SkuID(skuID), WarehouseID: 1, TeamID: 1, Count: -1}).Error
+			assert.NoError(t, err)
+		}
+
+		return nil
+	}
+
+	moretest.Suite(t, "testing prepare stat",
+		moretest.SetupListFunc{
+			moretest_mock.MockSqliteDatabase(&db),
+			migrate,
+		},
+		func(t *testing.T) {
+			var lock sync.Mutex
+			calls := map[string]int{}
+			failing := map[string]int{} // first sku of batch and how many call still failing
+
+			newPrepare := func(t *testing.T, runID uint64) *prepareStat {
+				run := warehouse_models.PrepareStatRun{}
+				err := db.First(&run, runID).Error
+				assert.NoError(t, err)
+
+				return &prepareStat{
+					db:          &db,
+					run:         &run,
+					concurrency: 2,
+					maxAttempt:  2,
+					prepareSkus: func(ctx context.Context, skuIDs []string) error {
+						lock.Lock()
+						defer lock.Unlock()
+
+						calls[skuIDs[0]]++
+						if failing[skuIDs[0]] > 0 {
+							failing[skuIDs[0]]--
+							return errors.New("inventory service unavailable")
+						}
+						return nil
+					},
+				}
+			}
+
+			checkpoint := func(t *testing.T, runID uint64, firstSkuID string) *warehouse_models.PrepareStatCheckpoint {
+				data := warehouse_models.PrepareStatCheckpoint{}
+				err := db.Where("run_id = ? AND first_sku_id = ?", runID, firstSkuID).First(&data).Error
+				assert.NoError(t, err)
+				return &data
+			}
+
+			run := warehouse_models.PrepareStatRun{
+				BatchSize: 2,
+				Status:    warehouse_models.PrepareStatRunning,
+			}
+			err := db.Create(&run).Error
+			assert.NoError(t, err)
+
+			t.Run("failed call retried in same run", func(t *testing.T) {
+				failing["sku-3"] = 1 // recovered on second attempt
+				failing["sku-5"] = 2 // still failing on max attempt
+
+				err := newPrepare(t, run.ID).Run(t.Context())
+				assert.Error(t, err)
+
+				assert.Equal(t, 1, calls["sku-1"])
+				assert.Equal(t, 2, calls["sku-3"])
+				assert.Equal(t, 2, calls["sku-5"])
+
+				data := checkpoint(t, run.ID, "sku-3")
+				assert.Equal(t, warehouse_models.PrepareStatDone, data.Status)
+				assert.Equal(t, "sku-4", data.LastSkuID)
+				assert.Equal(t, 2, data.AttemptCount)
+
+				data = checkpoint(t, run.ID, "sku-5")
+				assert.Equal(t, warehouse_models.PrepareStatFailed, data.Status)
+				assert.Equal(t, 2, data.AttemptCount)
+				assert.Equal(t, "inventory service unavailable", data.Error)
+
+				err = db.First(&run, run.ID).Error
+				assert.NoError(t, err)
+				assert.Equal(t, warehouse_models.PrepareStatFailed, run.Status)
+				assert.Equal(t, int64(2), run.SuccessCount)
+				assert.Equal(t, int64(1), run.FailedCount)
+			})
+
+			t.Run("resume from checkpoint only running failed batch", func(t *testing.T) {
+				err := newPrepare(t, run.ID).Run(t.Context())
+				assert.NoError(t, err)
+
+				assert.Equal(t, 1, calls["sku-1"])
+				assert.Equal(t, 2, calls["sku-3"])
+				assert.Equal(t, 3, calls["sku-5"])
+
+				data := checkpoint(t, run.ID, "sku-5")
+				assert.Equal(t, warehouse_models.PrepareStatDone, data.Status)
+				assert.Equal(t, 3, data.AttemptCount)
+				assert.Empty(t, data.Error)
+
+				err = db.First(&run, run.ID).Error
+				assert.NoError(t, err)
+				assert.Equal(t, warehouse_models.PrepareStatDone, run.Status)
+				assert.Equal(t, int64(3), run.SuccessCount)
+				assert.Equal(t, int64(0), run.FailedCount)
+				assert.Equal(t, int64(2), run.SkippedCount)
+				assert.NotNil(t, run.FinishedAt)
+			})
+		},
+	)
+}
@@ -0,0 +1,43 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+
+	"github.com/pdcgo/warehouse_service/v2/inventory"
+	"github.com/urfave/cli/v3"
+	"gorm.io/gorm"
+)
+
+type RebuildDailyHistoryCommand *cli.Command
+
+func NewRebuildDailyHistoryCommand(db *gorm.DB) RebuildDailyHistoryCommand {
+	return &cli.Command{
+		Name:  "rebuild-daily-history",
+		Usage: "recompute daily_sku_histories from stock_change_logs and report different row",
+		Flags: []cli.Flag{
+			&cli.Uint64Flag{Name: "warehouse", Required: true},
+			&cli.StringSliceFlag{Name: "sku", Usage: "sku id, empty for all sku that changed in range"},
+			&cli.StringFlag{Name: "start", Required: true, Usage: "start day, 2006-01-02"},
+			&cli.StringFlag{Name: "end", Required: true, Usage: "end day, 2006-01-02"},
+			&cli.BoolFlag{Name: "fix", Usage: "write recomputed row"},
+		},
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			report, err := inventory.RebuildDailyHistory(db.WithContext(ctx), &inventory.RebuildDailyHistoryParams{
+				WarehouseID: cmd.Uint64("warehouse"),
+				SkuIDs:      cmd.StringSlice("sku"),
+				Start:       cmd.String("start"),
+				End:         cmd.String("end"),
+				Fix:         cmd.Bool("fix"),
+			})
+			if err != nil {
+				return err
+			}
+
+			encoder := json.NewEncoder(os.Stdout)
+			encoder.SetIndent("", "  ")
+			return encoder.Encode(report)
+		},
+	}
+}
@@ -0,0 +1,68 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"time"
+
+	"github.com/pdcgo/warehouse_service"
+	"github.com/urfave/cli/v3"
+	"gorm.io/gorm"
+)
+
+type ReconcileAccountBalanceCommand *cli.Command
+
+func NewReconcileAccountBalanceCommand(db *gorm.DB) ReconcileAccountBalanceCommand {
+	return &cli.Command{
+		Name:  "reconcile-account-balance",
+		Usage: "compare expense account recorded balance with previous balance plus income minus outcome",
+		Flags: []cli.Flag{
+			&cli.Uint64Flag{Name: "warehouse", Usage: "warehouse id", Required: true},
+			&cli.Uint64Flag{Name: "account", Usage: "account id, empty for all account of warehouse"},
+			&cli.StringFlag{Name: "start", Usage: "start day YYYY-MM-DD"},
+			&cli.StringFlag{Name: "end", Usage: "end day YYYY-MM-DD"},
+			&cli.BoolFlag{Name: "mismatch-only", Usage: "only print mismatch day"},
+		},
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			query := &warehouse_service.AccountReconciliationReq{
+				WarehouseId:  cmd.Uint64("warehouse"),
+				AccountId:    cmd.Uint64("account"),
+				MismatchOnly: cmd.Bool("mismatch-only"),
+			}
+
+			// day is resolved in warehouse timezone, noon keeps it on same day
+			parseDay := func(name string) (int64, error) {
+				value := cmd.String(name)
+				if value == "" {
+					return 0, nil
+				}
+				day, err := time.Parse("2006-01-02", value)
+				if err != nil {
+					return 0, errors.New(name + " must be YYYY-MM-DD")
+				}
+				return day.Add(12 * time.Hour).UnixMilli(), nil
+			}
+
+			var err error
+			query.StartDate, err = parseDay("start")
+			if err != nil {
+				return err
+			}
+			query.EndDate, err = parseDay("end")
+			if err != nil {
+				return err
+			}
+
+			report, err := warehouse_service.ReconcileAccountBalance(db.WithContext(ctx), query)
+			if err != nil {
+				return err
+			}
+
+			encoder := json.NewEncoder(os.Stdout)
+			encoder.SetIndent("", "  ")
+			return encoder.Encode(report)
+		},
+	}
+}
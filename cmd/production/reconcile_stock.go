@@ -0,0 +1,37 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+
+	"github.com/pdcgo/warehouse_service/v2/inventory"
+	"github.com/urfave/cli/v3"
+	"gorm.io/gorm"
+)
+
+type ReconcileStockCommand *cli.Command
+
+func NewReconcileStockCommand(db *gorm.DB) ReconcileStockCommand {
+	return &cli.Command{
+		Name:  "reconcile-stock",
+		Usage: "compare skus, invertory_histories and daily_sku_histories stock and save the result",
+		Flags: []cli.Flag{
+			&cli.Uint64Flag{Name: "warehouse", Usage: "warehouse id, empty for all warehouse"},
+			&cli.StringSliceFlag{Name: "sku", Usage: "sku id, empty for all sku"},
+		},
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			report, err := inventory.ReconcileStock(db.WithContext(ctx), &inventory.StockReconciliationParams{
+				WarehouseID: cmd.Uint64("warehouse"),
+				SkuIDs:      cmd.StringSlice("sku"),
+			})
+			if err != nil {
+				return err
+			}
+
+			encoder := json.NewEncoder(os.Stdout)
+			encoder.SetIndent("", "  ")
+			return encoder.Encode(report)
+		},
+	}
+}
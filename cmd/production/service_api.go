@@ -20,6 +20,7 @@ func NewServiceApi(
 	mux *http.ServeMux,
 	warehouseRegister warehouse_service.RegisterHandler,
 	reflectorRegister custom_connect.RegisterReflectFunc,
+	outboxPublisher *warehouse_service.StockOutboxPublisher,
 	// cache ware_cache.Cache
 	// auth authorization_iface.Authorization,
 ) ServiceApiFunc {
@@ -32,6 +33,8 @@ func NewServiceApi(
 
 		defer cancel(ctx)
 
+		go outboxPublisher.Run(ctx)
+
 		warehouseReflect := warehouseRegister()
 		reflectorRegister(warehouseReflect)
 
@@ -0,0 +1,102 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/pdcgo/shared/db_models"
+	"github.com/pdcgo/warehouse_service/v2/warehouse"
+	"github.com/pdcgo/warehouse_service/warehouse_query"
+	"github.com/urfave/cli/v3"
+	"gorm.io/gorm"
+)
+
+type WarehouseStatCommand *cli.Command
+
+func NewWarehouseStatCommand(db *gorm.DB) WarehouseStatCommand {
+	return &cli.Command{
+		Name:  "warehouse-stat",
+		Usage: "refresh warehouse stat columns and compute warehouse kpi",
+		Commands: []*cli.Command{
+			{
+				Name:  "refresh",
+				Usage: "refresh rack_count, product_count and capacity on warehouses",
+				Flags: []cli.Flag{
+					&cli.Uint64Flag{Name: "warehouse", Usage: "warehouse id, empty for all warehouse"},
+				},
+				Action: func(ctx context.Context, cmd *cli.Command) error {
+					warehouseIDs := []uint64{}
+					if cmd.Uint64("warehouse") != 0 {
+						warehouseIDs = append(warehouseIDs, cmd.Uint64("warehouse"))
+					} else {
+						err := db.
+							WithContext(ctx).
+							Model(&db_models.Warehouse{}).
+							Where("deleted = ?", false).
+							Pluck("id", &warehouseIDs).
+							Error
+						if err != nil {
+							return err
+						}
+					}
+
+					for _, warehouseID := range warehouseIDs {
+						err := warehouse.RefreshWarehouseStat(db.WithContext(ctx), warehouseID)
+						if err != nil {
+							return err
+						}
+
+						slog.Info("warehouse stat refreshed", "warehouse_id", warehouseID)
+					}
+
+					return nil
+				},
+			},
+			{
+				Name:  "kpi",
+				Usage: "compute stock, inbound, outbound, active sku and rack of warehouse in range",
+				Flags: []cli.Flag{
+					&cli.Uint64Flag{Name: "warehouse", Usage: "warehouse id", Required: true},
+					&cli.Uint64Flag{Name: "team", Usage: "team id, empty for all team"},
+					&cli.StringFlag{Name: "start", Required: true, Usage: "start day, 2006-01-02"},
+					&cli.StringFlag{Name: "end", Required: true, Usage: "end day, 2006-01-02"},
+				},
+				Action: func(ctx context.Context, cmd *cli.Command) error {
+					warehouseID := cmd.Uint64("warehouse")
+
+					// day boundary on warehouse timezone
+					loc, err := warehouse_query.GetWarehouseLocation(db.WithContext(ctx), uint(warehouseID))
+					if err != nil {
+						return err
+					}
+					start, err := time.ParseInLocation(time.DateOnly, cmd.String("start"), loc)
+					if err != nil {
+						return err
+					}
+					end, err := time.ParseInLocation(time.DateOnly, cmd.String("end"), loc)
+					if err != nil {
+						return err
+					}
+
+					kpi, err := warehouse.ComputeWarehouseKpi(
+						db.WithContext(ctx),
+						warehouseID,
+						cmd.Uint64("team"),
+						start,
+						end.AddDate(0, 0, 1).Add(-time.Nanosecond),
+					)
+					if err != nil {
+						return err
+					}
+
+					encoder := json.NewEncoder(os.Stdout)
+					encoder.SetIndent("", "  ")
+					return encoder.Encode(kpi)
+				},
+			},
+		},
+	}
+}
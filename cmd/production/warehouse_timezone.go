@@ -0,0 +1,35 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pdcgo/warehouse_service/warehouse_mutations"
+	"github.com/urfave/cli/v3"
+	"gorm.io/gorm"
+)
+
+type WarehouseTimezoneCommand *cli.Command
+
+func NewWarehouseTimezoneCommand(db *gorm.DB) WarehouseTimezoneCommand {
+	return &cli.Command{
+		Name:  "warehouse-timezone",
+		Usage: "set timezone used for warehouse day boundary",
+		Flags: []cli.Flag{
+			&cli.Uint64Flag{Name: "warehouse", Usage: "warehouse id", Required: true},
+			&cli.StringFlag{Name: "timezone", Usage: "IANA timezone, like Asia/Makassar", Required: true},
+		},
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			warehouseID := cmd.Uint64("warehouse")
+			timezone := cmd.String("timezone")
+
+			err := warehouse_mutations.SetWarehouseTimezone(db.WithContext(ctx), uint(warehouseID), timezone)
+			if err != nil {
+				return err
+			}
+
+			fmt.Printf("warehouse %d timezone set to %s\n", warehouseID, timezone)
+			return nil
+		},
+	}
+}
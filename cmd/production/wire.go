@@ -10,6 +10,7 @@ import (
 	"github.com/pdcgo/event_source"
 	"github.com/pdcgo/shared/configs"
 	"github.com/pdcgo/shared/custom_connect"
+	finance "github.com/pdcgo/warehouse_service"
 	"github.com/pdcgo/warehouse_service/v2"
 	"github.com/urfave/cli/v3"
 )
@@ -24,13 +25,25 @@ func InitializeApp() (*cli.Command, error) {
 		NewCache,
 		NewCacheManager,
 		NewAuthorization,
+		NewBlobStorage,
+		finance.NewExpenseAttachmentService,
 		event_source.NewPubSubDefaultClient,
 		event_source.NewPubsubEventSender,
+		warehouse_service.NewDeadLetterPolicy,
 		warehouse_service.NewWarehousePushHandler,
+		warehouse_service.NewDeadLetterService,
+		warehouse_service.NewStockOutboxPublisher,
 		warehouse_service.NewWarehousePushHttpHandler,
 		warehouse_service.NewRegister,
 		NewServiceApi,
-		NewPrepareStat,
+		NewPrepareStatCommand,
+		NewDeadLetterCommand,
+		NewOutboxCommand,
+		NewRebuildDailyHistoryCommand,
+		NewReconcileStockCommand,
+		NewReconcileAccountBalanceCommand,
+		NewWarehouseTimezoneCommand,
+		NewWarehouseStatCommand,
 		NewApp,
 	)
 
@@ -10,6 +10,7 @@ import (
 	"github.com/pdcgo/event_source"
 	"github.com/pdcgo/shared/configs"
 	"github.com/pdcgo/shared/custom_connect"
+	finance "github.com/pdcgo/warehouse_service"
 	"github.com/pdcgo/warehouse_service/v2"
 	"github.com/urfave/cli/v3"
 	"net/http"
@@ -41,13 +42,28 @@ func InitializeApp() (*cli.Command, error) {
 		return nil, err
 	}
 	eventSender := event_source.NewPubsubEventSender(client)
-	warehousePushHandler := warehouse_service.NewWarehousePushHandler(db, eventSender)
+	deadLetterPolicy := warehouse_service.NewDeadLetterPolicy()
+	warehousePushHandler := warehouse_service.NewWarehousePushHandler(db, eventSender, deadLetterPolicy)
 	warehousePushHttpHandler := warehouse_service.NewWarehousePushHttpHandler(warehousePushHandler)
 	cacheManager := NewCacheManager()
-	registerHandler := warehouse_service.NewRegister(db, authorization, serveMux, defaultInterceptor, warehousePushHttpHandler, appConfig, cacheManager)
+	deadLetterService := warehouse_service.NewDeadLetterService(db, eventSender)
+	storage, err := NewBlobStorage()
+	if err != nil {
+		return nil, err
+	}
+	expenseAttachmentService := finance.NewExpenseAttachmentService(db, authorization, storage)
+	registerHandler := warehouse_service.NewRegister(db, authorization, serveMux, defaultInterceptor, warehousePushHttpHandler, appConfig, cacheManager, eventSender, deadLetterService, expenseAttachmentService)
 	registerReflectFunc := custom_connect.NewRegisterReflect(serveMux)
-	serviceApiFunc := NewServiceApi(serveMux, registerHandler, registerReflectFunc)
-	prepareStatFunc := NewPrepareStat(db, appConfig)
-	command := NewApp(serviceApiFunc, prepareStatFunc)
+	stockOutboxPublisher := warehouse_service.NewStockOutboxPublisher(db, eventSender)
+	serviceApiFunc := NewServiceApi(serveMux, registerHandler, registerReflectFunc, stockOutboxPublisher)
+	prepareStatCommand := NewPrepareStatCommand(db, appConfig)
+	deadLetterCommand := NewDeadLetterCommand(deadLetterService)
+	outboxCommand := NewOutboxCommand(stockOutboxPublisher)
+	rebuildDailyHistoryCommand := NewRebuildDailyHistoryCommand(db)
+	reconcileStockCommand := NewReconcileStockCommand(db)
+	reconcileAccountBalanceCommand := NewReconcileAccountBalanceCommand(db)
+	warehouseTimezoneCommand := NewWarehouseTimezoneCommand(db)
+	warehouseStatCommand := NewWarehouseStatCommand(db)
+	command := NewApp(serviceApiFunc, prepareStatCommand, deadLetterCommand, outboxCommand, rebuildDailyHistoryCommand, reconcileStockCommand, reconcileAccountBalanceCommand, warehouseTimezoneCommand, warehouseStatCommand)
 	return command, nil
 }
@@ -0,0 +1,28 @@
+package warehouse_service
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"connectrpc.com/connect"
+	"github.com/pdcgo/shared/authorization"
+	"github.com/pdcgo/shared/interfaces/authorization_iface"
+)
+
+// legacyIdentityContext puts identity of request header in context as "identity", same as legacy
+// grpc auth, so service implementation read it with getIdentity.
+func legacyIdentityContext(ctx context.Context, auth authorization_iface.Authorization, header http.Header) (context.Context, error) {
+	authIdentity := auth.AuthIdentityFromHeader(header)
+	err := authIdentity.Err()
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, err)
+	}
+
+	identity, ok := authIdentity.Identity().(*authorization.JwtIdentity)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("identity is not jwt identity"))
+	}
+
+	return context.WithValue(ctx, "identity", identity), nil
+}
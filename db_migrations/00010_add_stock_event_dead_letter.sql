@@ -0,0 +1,20 @@
+-- +goose Up
+CREATE TABLE stock_event_dead_letters (
+    id              BIGSERIAL PRIMARY KEY,
+    message_id      TEXT NOT NULL,
+    raw             BYTEA,
+    error           TEXT,
+    attempt_count   BIGINT NOT NULL DEFAULT 0,
+    max_attempt     BIGINT NOT NULL DEFAULT 0,
+    status          TEXT NOT NULL,
+    last_attempt_at TIMESTAMPTZ,
+    replayed_at     TIMESTAMPTZ,
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at      TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX idx_stock_event_dead_letters_message_id ON stock_event_dead_letters (message_id);
+CREATE INDEX idx_stock_event_dead_letters_status ON stock_event_dead_letters (status);
+
+-- +goose Down
+DROP TABLE IF EXISTS stock_event_dead_letters;
@@ -0,0 +1,38 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE stock_reconciliation_runs (
+    id             BIGSERIAL   PRIMARY KEY,
+    warehouse_id   BIGINT      NOT NULL DEFAULT 0,
+    sku_count      BIGINT      NOT NULL DEFAULT 0,
+    warning_count  BIGINT      NOT NULL DEFAULT 0,
+    critical_count BIGINT      NOT NULL DEFAULT 0,
+    created_at     TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE stock_reconciliation_items (
+    id              BIGSERIAL    PRIMARY KEY,
+    run_id          BIGINT       NOT NULL REFERENCES stock_reconciliation_runs (id) ON DELETE CASCADE,
+    sku_id          VARCHAR(255) NOT NULL,
+    warehouse_id    BIGINT       NOT NULL,
+    stock_total     BIGINT       NOT NULL DEFAULT 0,
+    stock_ready     BIGINT       NOT NULL DEFAULT 0,
+    history_count   BIGINT       NOT NULL DEFAULT 0,
+    daily_end_count BIGINT,
+    daily_t         TIMESTAMPTZ,
+    severity        TEXT         NOT NULL,
+    note            TEXT,
+    created_at      TIMESTAMPTZ  NOT NULL DEFAULT NOW()
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE INDEX idx_stock_reconciliation_runs_warehouse_id ON stock_reconciliation_runs (warehouse_id);
+CREATE INDEX idx_stock_reconciliation_items_run_id ON stock_reconciliation_items (run_id);
+CREATE INDEX idx_stock_reconciliation_items_sku_id ON stock_reconciliation_items (sku_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS stock_reconciliation_items;
+DROP TABLE IF EXISTS stock_reconciliation_runs;
+-- +goose StatementEnd
@@ -0,0 +1,6 @@
+-- +goose Up
+-- IANA name, used wherever warehouse day boundary computed. existing warehouse is in WIB.
+ALTER TABLE warehouses ADD COLUMN IF NOT EXISTS timezone TEXT NOT NULL DEFAULT 'Asia/Jakarta';
+
+-- +goose Down
+ALTER TABLE warehouses DROP COLUMN IF EXISTS timezone;
@@ -0,0 +1,32 @@
+-- +goose Up
+CREATE TABLE prepare_stat_runs (
+    id            BIGSERIAL PRIMARY KEY,
+    warehouse_id  BIGINT NOT NULL DEFAULT 0,
+    team_id       BIGINT NOT NULL DEFAULT 0,
+    batch_size    BIGINT NOT NULL DEFAULT 0,
+    status        TEXT NOT NULL,
+    success_count BIGINT NOT NULL DEFAULT 0,
+    failed_count  BIGINT NOT NULL DEFAULT 0,
+    skipped_count BIGINT NOT NULL DEFAULT 0,
+    created_at    TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at    TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    finished_at   TIMESTAMPTZ
+);
+
+CREATE TABLE prepare_stat_checkpoints (
+    id            BIGSERIAL PRIMARY KEY,
+    run_id        BIGINT NOT NULL,
+    first_sku_id  TEXT NOT NULL,
+    last_sku_id   TEXT NOT NULL,
+    sku_count     BIGINT NOT NULL DEFAULT 0,
+    status        TEXT NOT NULL,
+    attempt_count BIGINT NOT NULL DEFAULT 0,
+    error         TEXT,
+    updated_at    TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX idx_prepare_stat_checkpoint_batch ON prepare_stat_checkpoints (run_id, first_sku_id, last_sku_id);
+
+-- +goose Down
+DROP TABLE IF EXISTS prepare_stat_checkpoints;
+DROP TABLE IF EXISTS prepare_stat_runs;
@@ -0,0 +1,15 @@
+-- +goose Up
+CREATE TABLE ware_expense_account_status_logs (
+    id           BIGSERIAL PRIMARY KEY,
+    account_id   BIGINT NOT NULL,
+    warehouse_id BIGINT NOT NULL DEFAULT 0,
+    actor_id     BIGINT NOT NULL DEFAULT 0,
+    disabled     BOOLEAN NOT NULL,
+    note         TEXT,
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_ware_expense_account_status_logs_account_id ON ware_expense_account_status_logs (account_id);
+
+-- +goose Down
+DROP TABLE IF EXISTS ware_expense_account_status_logs;
@@ -0,0 +1,16 @@
+-- +goose Up
+CREATE TABLE ware_expense_attachments (
+    id              BIGSERIAL PRIMARY KEY,
+    expense_hist_id BIGINT NOT NULL,
+    file_name       TEXT NOT NULL,
+    content_type    TEXT NOT NULL,
+    size            BIGINT NOT NULL DEFAULT 0,
+    storage_key     TEXT NOT NULL,
+    uploaded_by_id  BIGINT NOT NULL DEFAULT 0,
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_ware_expense_attachments_expense_hist_id ON ware_expense_attachments (expense_hist_id);
+
+-- +goose Down
+DROP TABLE IF EXISTS ware_expense_attachments;
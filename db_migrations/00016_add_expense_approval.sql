@@ -0,0 +1,21 @@
+-- +goose Up
+ALTER TABLE ware_expense_histories ADD COLUMN IF NOT EXISTS status TEXT NOT NULL DEFAULT 'approved';
+
+CREATE INDEX IF NOT EXISTS idx_ware_expense_histories_status ON ware_expense_histories (status);
+
+CREATE TABLE ware_expense_status_logs (
+    id              BIGSERIAL PRIMARY KEY,
+    expense_hist_id BIGINT NOT NULL,
+    from_status     TEXT NOT NULL DEFAULT '',
+    to_status       TEXT NOT NULL,
+    actor_id        BIGINT NOT NULL DEFAULT 0,
+    note            TEXT,
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_ware_expense_status_logs_expense_hist_id ON ware_expense_status_logs (expense_hist_id);
+
+-- +goose Down
+DROP TABLE IF EXISTS ware_expense_status_logs;
+DROP INDEX IF EXISTS idx_ware_expense_histories_status;
+ALTER TABLE ware_expense_histories DROP COLUMN IF EXISTS status;
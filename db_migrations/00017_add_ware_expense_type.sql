@@ -0,0 +1,48 @@
+-- +goose Up
+CREATE TABLE ware_expense_types (
+    key          TEXT PRIMARY KEY,
+    name         TEXT NOT NULL,
+    flow         TEXT NOT NULL,
+    admin_only   BOOLEAN NOT NULL DEFAULT FALSE,
+    warehouse_id BIGINT NOT NULL DEFAULT 0,
+    disabled     BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at   TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE ware_expense_type_teams (
+    id           BIGSERIAL PRIMARY KEY,
+    expense_type TEXT NOT NULL REFERENCES ware_expense_types (key) ON UPDATE CASCADE ON DELETE CASCADE,
+    team_type    TEXT NOT NULL
+);
+
+CREATE UNIQUE INDEX idx_ware_expense_type_team ON ware_expense_type_teams (expense_type, team_type);
+
+-- same as warehouse_models.DefaultExpenseTypes
+INSERT INTO ware_expense_types (key, name, flow, admin_only, disabled) VALUES
+    ('equity', 'Modal', 'income', TRUE, FALSE),
+    ('basic_salary', 'Gaji Pokok', 'outcome', TRUE, FALSE),
+    ('server', 'Server', 'outcome', TRUE, FALSE),
+    ('petty_cash', 'Kas Kecil', 'outcome', FALSE, FALSE),
+    ('transport', 'Transport', 'outcome', FALSE, FALSE),
+    ('receivable', 'Piutang', 'outcome', FALSE, FALSE),
+    ('internet', 'Internet', 'outcome', FALSE, FALSE),
+    ('packing', 'Packing', 'outcome', FALSE, FALSE),
+    ('shipping', 'Ongkir', 'outcome', FALSE, FALSE),
+    ('electricity', 'Listrik', 'outcome', FALSE, FALSE),
+    ('kitchen', 'Dapur', 'outcome', FALSE, FALSE),
+    ('equipment', 'Perlengkapan', 'outcome', FALSE, FALSE),
+    ('tools', 'Peralatan', 'outcome', FALSE, FALSE),
+    ('other', 'Lain-Lain', 'outcome', FALSE, FALSE),
+    ('bank', 'Bank', 'outcome', TRUE, TRUE),
+    ('payable', 'Hutang', 'income', TRUE, TRUE),
+    ('bonus_salary', 'Bonus', 'outcome', TRUE, TRUE)
+ON CONFLICT (key) DO NOTHING;
+
+INSERT INTO ware_expense_type_teams (expense_type, team_type)
+SELECT key, 'warehouse' FROM ware_expense_types
+ON CONFLICT DO NOTHING;
+
+-- +goose Down
+DROP TABLE IF EXISTS ware_expense_type_teams;
+DROP TABLE IF EXISTS ware_expense_types;
@@ -0,0 +1,18 @@
+-- +goose Up
+CREATE TABLE ware_expense_budgets (
+    id            BIGSERIAL PRIMARY KEY,
+    warehouse_id  BIGINT NOT NULL,
+    expense_type  TEXT NOT NULL,
+    month         TEXT NOT NULL,
+    amount        DOUBLE PRECISION NOT NULL DEFAULT 0,
+    alert_percent DOUBLE PRECISION NOT NULL DEFAULT 0,
+    enforce       TEXT NOT NULL DEFAULT 'warn',
+    updated_by_id BIGINT NOT NULL DEFAULT 0,
+    created_at    TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at    TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX idx_ware_expense_budget ON ware_expense_budgets (warehouse_id, expense_type, month);
+
+-- +goose Down
+DROP TABLE IF EXISTS ware_expense_budgets;
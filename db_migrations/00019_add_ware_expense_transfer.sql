@@ -0,0 +1,22 @@
+-- +goose Up
+CREATE TABLE ware_expense_transfers (
+    id                BIGSERIAL PRIMARY KEY,
+    from_warehouse_id BIGINT NOT NULL,
+    from_account_id   BIGINT NOT NULL,
+    to_warehouse_id   BIGINT NOT NULL,
+    to_account_id     BIGINT NOT NULL,
+    amount            DOUBLE PRECISION NOT NULL CHECK (amount > 0),
+    note              TEXT,
+    at                TIMESTAMPTZ NOT NULL,
+    created_by_id     BIGINT NOT NULL DEFAULT 0,
+    created_at        TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+ALTER TABLE ware_expense_histories ADD COLUMN IF NOT EXISTS transfer_id BIGINT NOT NULL DEFAULT 0;
+
+CREATE INDEX IF NOT EXISTS idx_ware_expense_histories_transfer_id ON ware_expense_histories (transfer_id);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_ware_expense_histories_transfer_id;
+ALTER TABLE ware_expense_histories DROP COLUMN IF EXISTS transfer_id;
+DROP TABLE IF EXISTS ware_expense_transfers;
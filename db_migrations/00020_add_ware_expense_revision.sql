@@ -0,0 +1,19 @@
+-- +goose Up
+CREATE TABLE ware_expense_revisions (
+    id              BIGSERIAL PRIMARY KEY,
+    expense_hist_id BIGINT NOT NULL,
+    editor_id       BIGINT NOT NULL DEFAULT 0,
+    editor_from     TEXT NOT NULL DEFAULT '',
+    before          JSONB NOT NULL,
+    after           JSONB NOT NULL,
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_ware_expense_revisions_expense_hist_id ON ware_expense_revisions (expense_hist_id);
+
+-- revision is append only
+CREATE RULE ware_expense_revisions_no_update AS ON UPDATE TO ware_expense_revisions DO INSTEAD NOTHING;
+CREATE RULE ware_expense_revisions_no_delete AS ON DELETE TO ware_expense_revisions DO INSTEAD NOTHING;
+
+-- +goose Down
+DROP TABLE IF EXISTS ware_expense_revisions;
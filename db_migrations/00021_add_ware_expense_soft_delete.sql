@@ -0,0 +1,20 @@
+-- +goose Up
+ALTER TABLE ware_expense_histories ADD COLUMN IF NOT EXISTS deleted BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE INDEX IF NOT EXISTS idx_ware_expense_histories_deleted ON ware_expense_histories (deleted);
+
+CREATE TABLE ware_expense_delete_logs (
+    id              BIGSERIAL PRIMARY KEY,
+    expense_hist_id BIGINT NOT NULL,
+    actor_id        BIGINT NOT NULL DEFAULT 0,
+    deleted         BOOLEAN NOT NULL,
+    reason          TEXT NOT NULL DEFAULT '',
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_ware_expense_delete_logs_expense_hist_id ON ware_expense_delete_logs (expense_hist_id);
+
+-- +goose Down
+DROP TABLE IF EXISTS ware_expense_delete_logs;
+DROP INDEX IF EXISTS idx_ware_expense_histories_deleted;
+ALTER TABLE ware_expense_histories DROP COLUMN IF EXISTS deleted;
@@ -0,0 +1,21 @@
+-- +goose Up
+CREATE TABLE stock_change_outboxes (
+    id              BIGSERIAL PRIMARY KEY,
+    message_id      TEXT NOT NULL,
+    payload         BYTEA NOT NULL,
+    status          TEXT NOT NULL,
+    attempt_count   BIGINT NOT NULL DEFAULT 0,
+    error           TEXT,
+    published_id    TEXT,
+    next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    sent_at         TIMESTAMPTZ,
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at      TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX idx_stock_change_outboxes_message_id ON stock_change_outboxes (message_id);
+CREATE INDEX idx_stock_change_outboxes_status ON stock_change_outboxes (status);
+CREATE INDEX idx_stock_change_outboxes_next_attempt_at ON stock_change_outboxes (next_attempt_at);
+
+-- +goose Down
+DROP TABLE IF EXISTS stock_change_outboxes;
@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE stock_change_outboxes ADD COLUMN IF NOT EXISTS claim_id TEXT NOT NULL DEFAULT '';
+
+-- +goose Down
+ALTER TABLE stock_change_outboxes DROP COLUMN IF EXISTS claim_id;
@@ -0,0 +1,197 @@
+package warehouse_service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pdcgo/event_source"
+	"github.com/pdcgo/shared/authorization"
+	"github.com/pdcgo/shared/db_models"
+	"github.com/pdcgo/shared/interfaces/warehouse_iface"
+	"github.com/pdcgo/shared/pkg/moretest"
+	"github.com/pdcgo/shared/pkg/moretest/moretest_mock"
+	"github.com/pdcgo/warehouse_service"
+	"github.com/pdcgo/warehouse_service/warehouse_models"
+	"github.com/pdcgo/warehouse_service/warehouse_mutations"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+func TestExpenseApproval(t *testing.T) {
+	var db gorm.DB
+
+	moretest.Suite(t, "testing expense approval",
+		moretest.SetupListFunc{
+			moretest_mock.MockSqliteDatabase(&db),
+			func(t *testing.T) func() error {
+				err := db.AutoMigrate(
+					&warehouse_models.WareExpenseAccount{},
+					&warehouse_models.WareExpenseAccountWarehouse{},
+					&warehouse_models.WareExpenseHistory{},
+					&warehouse_models.WareExpenseType{},
+					&warehouse_models.WareExpenseTypeTeam{},
+					&warehouse_models.WareExpenseBudget{},
+					&warehouse_models.WareExpenseStatusLog{},
+					&warehouse_models.WareExpenseRevision{},
+					&warehouse_models.WareBalanceAccountHistory{},
+					&warehouse_models.WarehouseTimezone{},
+				)
+				assert.Nil(t, err)
+
+				err = db.Create(warehouse_models.DefaultExpenseTypes()).Error
+				assert.Nil(t, err)
+
+				account := warehouse_models.WareExpenseAccount{Name: "Kas", NumberID: "445566", CreatedAt: time.Now()}
+				err = db.Create(&account).Error
+				assert.Nil(t, err)
+
+				err = db.Create(&warehouse_models.WareExpenseAccountWarehouse{AccountID: account.ID, WarehouseID: 1}).Error
+				assert.Nil(t, err)
+
+				return nil
+			},
+		},
+		func(t *testing.T) {
+			whCtx := context.WithValue(context.Background(), "identity", &authorization.JwtIdentity{
+				UserID: 5,
+				From:   db_models.WarehouseTeamType,
+			})
+			adminCtx := context.WithValue(context.Background(), "identity", &authorization.JwtIdentity{
+				UserID: 1,
+				From:   db_models.AdminTeamType,
+			})
+			service := warehouse_service.NewWarehouseFinanceService(&db, NewMockAuth(true), event_source.EmptySender)
+
+			addExpense := func(expenseType warehouse_models.ExpenseType, amount float64) *warehouse_models.WareExpenseHistory {
+				_, err := service.ExpenseHistoryAdd(whCtx, &warehouse_iface.ExpenseHistoryAddReq{
+					AccountId:   1,
+					WarehouseId: 1,
+					ExpenseType: string(expenseType),
+					Amount:      amount,
+					At:          timestamppb.Now(),
+				})
+				assert.Nil(t, err)
+
+				expense := warehouse_models.WareExpenseHistory{}
+				err = db.Order("id desc").First(&expense).Error
+				assert.Nil(t, err)
+				return &expense
+			}
+
+			reportIncome := func() float64 {
+				result, err := service.ExpenseReportDaily(adminCtx, &warehouse_iface.ExpenseReportDailyReq{WarehouseId: 1})
+				assert.Nil(t, err)
+
+				var income float64
+				for _, report := range result.Data {
+					income += report.Income
+				}
+				return income
+			}
+
+			kitchen := addExpense(warehouse_models.ExpenseTypeKitchen, -10_000)
+			assert.Equal(t, warehouse_models.ExpenseStatusApproved, kitchen.Status)
+
+			equity := addExpense(warehouse_models.ExpenseTypeEquity, 500_000)
+			assert.Equal(t, warehouse_models.ExpenseStatusPending, equity.Status)
+
+			t.Run("test pending not in report", func(t *testing.T) {
+				assert.Equal(t, float64(0), reportIncome())
+			})
+
+			t.Run("test review from warehouse", func(t *testing.T) {
+				_, err := service.ExpenseHistoryReview(whCtx, &warehouse_service.ExpenseHistoryReviewReq{
+					HistId:  uint64(equity.ID),
+					Approve: true,
+				})
+				assert.NotNil(t, err)
+			})
+
+			t.Run("test review checked on expense warehouse", func(t *testing.T) {
+				otherService := warehouse_service.NewWarehouseFinanceService(&db, NewMockDomainAuth(2), event_source.EmptySender)
+				_, err := otherService.ExpenseHistoryReview(adminCtx, &warehouse_service.ExpenseHistoryReviewReq{
+					HistId:  uint64(equity.ID),
+					Approve: true,
+				})
+				assert.NotNil(t, err)
+
+				_, err = service.ExpenseHistoryReview(adminCtx, &warehouse_service.ExpenseHistoryReviewReq{
+					DomainId: 2,
+					HistId:   uint64(equity.ID),
+					Approve:  true,
+				})
+				assert.ErrorIs(t, err, warehouse_service.ErrWarehouseMismatch)
+			})
+
+			t.Run("test approve", func(t *testing.T) {
+				result, err := service.ExpenseHistoryReview(adminCtx, &warehouse_service.ExpenseHistoryReviewReq{
+					HistId:  uint64(equity.ID),
+					Approve: true,
+					Note:    "modal bulan ini",
+				})
+				assert.Nil(t, err)
+				assert.Equal(t, warehouse_models.ExpenseStatusApproved, result.Status)
+				assert.Equal(t, float64(500_000), reportIncome())
+
+				_, err = service.ExpenseHistoryReview(adminCtx, &warehouse_service.ExpenseHistoryReviewReq{
+					HistId: uint64(equity.ID),
+				})
+				assert.ErrorIs(t, err, warehouse_mutations.ErrExpenseNotPending)
+			})
+
+			t.Run("test edit from warehouse need approval again", func(t *testing.T) {
+				_, err := service.ExpenseHistoryEdit(whCtx, &warehouse_iface.ExpenseHistoryEditReq{
+					HistId:      uint64(equity.ID),
+					AccountId:   1,
+					WarehouseId: 1,
+					ExpenseType: string(warehouse_models.ExpenseTypeEquity),
+					Amount:      600_000,
+					At:          timestamppb.New(equity.At),
+				})
+				assert.Nil(t, err)
+				assert.Equal(t, float64(0), reportIncome())
+
+				result, err := service.ExpenseHistoryReview(adminCtx, &warehouse_service.ExpenseHistoryReviewReq{
+					HistId: uint64(equity.ID),
+					Note:   "nominal salah",
+				})
+				assert.Nil(t, err)
+				assert.Equal(t, warehouse_models.ExpenseStatusRejected, result.Status)
+			})
+
+			t.Run("test status log", func(t *testing.T) {
+				logs := []*warehouse_models.WareExpenseStatusLog{}
+				err := db.Where("expense_hist_id = ?", equity.ID).Order("id").Find(&logs).Error
+				assert.Nil(t, err)
+				assert.Len(t, logs, 4)
+
+				transitions := [][2]warehouse_models.ExpenseStatus{}
+				for _, log := range logs {
+					transitions = append(transitions, [2]warehouse_models.ExpenseStatus{log.FromStatus, log.ToStatus})
+				}
+				assert.Equal(t, [][2]warehouse_models.ExpenseStatus{
+					{"", warehouse_models.ExpenseStatusPending},
+					{warehouse_models.ExpenseStatusPending, warehouse_models.ExpenseStatusApproved},
+					{warehouse_models.ExpenseStatusApproved, warehouse_models.ExpenseStatusPending},
+					{warehouse_models.ExpenseStatusPending, warehouse_models.ExpenseStatusRejected},
+				}, transitions)
+				assert.Equal(t, uint(1), logs[1].ActorID)
+				assert.Equal(t, "modal bulan ini", logs[1].Note)
+			})
+
+			t.Run("test list pending", func(t *testing.T) {
+				addExpense(warehouse_models.ExpenseTypeServer, -100_000)
+
+				result, err := service.ExpenseHistoryPage(adminCtx, &warehouse_service.ExpenseHistoryQuery{
+					ExpenseHistoryListReq: &warehouse_iface.ExpenseHistoryListReq{WarehouseId: 1},
+					Status:                warehouse_models.ExpenseStatusPending,
+				}, nil)
+				assert.Nil(t, err)
+				assert.Len(t, result.Data, 1)
+				assert.Equal(t, warehouse_models.ExpenseTypeServer, result.Data[0].ExpenseType)
+			})
+		},
+	)
+}
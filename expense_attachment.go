@@ -0,0 +1,234 @@
+package warehouse_service
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"path"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/pdcgo/shared/authorization"
+	"github.com/pdcgo/shared/interfaces/authorization_iface"
+	"github.com/pdcgo/warehouse_service/blob_storage"
+	"github.com/pdcgo/warehouse_service/warehouse_models"
+	"github.com/pdcgo/warehouse_service/warehouse_mutations"
+	"gorm.io/gorm"
+)
+
+const ExpenseAttachmentMaxSize = 5 << 20
+
+var ExpenseAttachmentContentTypes = []string{
+	"image/jpeg",
+	"image/png",
+	"image/webp",
+	"application/pdf",
+}
+
+var ErrAttachmentTooLarge = errors.New("attachment too large")
+var ErrAttachmentTypeNotAllowed = errors.New("attachment content type not allowed")
+var ErrAttachmentNotFound = errors.New("attachment not found")
+
+// ExpenseAttachmentService is receipt of expense history. upload need Update and list or download need Read
+// permission on expense history in warehouse of the expense. DomainId of request is warehouse of the expense,
+// optional and rejected when it is other warehouse.
+type ExpenseAttachmentService interface {
+	ExpenseAttachmentUpload(ctx context.Context, payload *ExpenseAttachmentUploadReq) (*warehouse_models.WareExpenseAttachment, error)
+	ExpenseAttachmentList(ctx context.Context, query *ExpenseAttachmentListReq) (*ExpenseAttachmentListRes, error)
+	ExpenseAttachmentDownload(ctx context.Context, query *ExpenseAttachmentDownloadReq) (*ExpenseAttachmentDownloadRes, error)
+}
+
+func NewExpenseAttachmentService(db *gorm.DB, auth authorization_iface.Authorization, storage blob_storage.Storage) ExpenseAttachmentService {
+	return &expenseAttachmentImpl{
+		db:      db,
+		auth:    auth,
+		storage: storage,
+	}
+}
+
+type expenseAttachmentImpl struct {
+	db      *gorm.DB
+	auth    authorization_iface.Authorization
+	storage blob_storage.Storage
+}
+
+type ExpenseAttachmentUploadReq struct {
+	DomainId uint64 `json:"domain_id"`
+	HistId   uint64 `json:"hist_id"`
+	FileName string `json:"file_name"`
+	Content  []byte `json:"content"`
+}
+
+type ExpenseAttachmentListReq struct {
+	DomainId uint64 `json:"domain_id"`
+	HistId   uint64 `json:"hist_id"`
+}
+
+type ExpenseAttachmentListRes struct {
+	Data []*warehouse_models.WareExpenseAttachment `json:"data"`
+}
+
+type ExpenseAttachmentDownloadReq struct {
+	DomainId     uint64 `json:"domain_id"`
+	AttachmentId uint64 `json:"attachment_id"`
+}
+
+// ExpenseAttachmentDownloadRes Content must be closed by caller.
+type ExpenseAttachmentDownloadRes struct {
+	Attachment *warehouse_models.WareExpenseAttachment
+	Content    io.ReadCloser
+}
+
+func (e *expenseAttachmentImpl) getExpense(
+	ctx context.Context,
+	tx *gorm.DB,
+	identity *authorization.JwtIdentity,
+	domainID uint64,
+	histID uint,
+	action authorization_iface.Action,
+) (*warehouse_models.WareExpenseHistory, error) {
+	histService := warehouse_mutations.NewExpenseHistService(tx, identity)
+	expense, err := histService.GetExpense(histID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkWarehouse(ctx, e.auth, identity, &warehouse_models.WareExpenseHistory{}, uint(domainID), expense.WarehouseID, action)
+	if err != nil {
+		return nil, err
+	}
+	return expense, nil
+}
+
+// sniffContentType is content type from first 512 bytes of content, type sent by client is not trusted.
+func sniffContentType(content []byte) string {
+	head := content[:min(len(content), 512)]
+	contentType, _, _ := mime.ParseMediaType(http.DetectContentType(head))
+	return contentType
+}
+
+// ExpenseAttachmentUpload content is put in storage first, removed again when saving attachment failed.
+func (e *expenseAttachmentImpl) ExpenseAttachmentUpload(ctx context.Context, payload *ExpenseAttachmentUploadReq) (*warehouse_models.WareExpenseAttachment, error) {
+	identity, err := getIdentity(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(payload.Content) > ExpenseAttachmentMaxSize {
+		return nil, ErrAttachmentTooLarge
+	}
+	contentType := sniffContentType(payload.Content)
+	if !slices.Contains(ExpenseAttachmentContentTypes, contentType) {
+		return nil, fmt.Errorf("%w: %s", ErrAttachmentTypeNotAllowed, contentType)
+	}
+
+	fileName := path.Base(strings.ReplaceAll(strings.Trim(payload.FileName, " "), "\\", "/"))
+	if fileName == "." || fileName == "/" {
+		fileName = "attachment"
+	}
+
+	db := e.db.WithContext(ctx)
+	expense, err := e.getExpense(ctx, db, identity, payload.DomainId, uint(payload.HistId), authorization_iface.Update)
+	if err != nil {
+		return nil, err
+	}
+
+	random := make([]byte, 8)
+	_, err = rand.Read(random)
+	if err != nil {
+		return nil, err
+	}
+
+	attachment := warehouse_models.WareExpenseAttachment{
+		ExpenseHistID: expense.ID,
+		FileName:      fileName,
+		ContentType:   contentType,
+		Size:          int64(len(payload.Content)),
+		StorageKey:    fmt.Sprintf("expense/%d/%d/%s", expense.WarehouseID, expense.ID, hex.EncodeToString(random)),
+		UploadedByID:  identity.UserID,
+		CreatedAt:     time.Now(),
+	}
+
+	err = e.storage.Put(ctx, attachment.StorageKey, bytes.NewReader(payload.Content))
+	if err != nil {
+		return nil, err
+	}
+
+	err = db.Create(&attachment).Error
+	if err != nil {
+		return nil, errors.Join(err, e.storage.Delete(ctx, attachment.StorageKey))
+	}
+
+	return &attachment, nil
+}
+
+func (e *expenseAttachmentImpl) ExpenseAttachmentList(ctx context.Context, query *ExpenseAttachmentListReq) (*ExpenseAttachmentListRes, error) {
+	identity, err := getIdentity(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	db := e.db.WithContext(ctx)
+	expense, err := e.getExpense(ctx, db, identity, query.DomainId, uint(query.HistId), authorization_iface.Read)
+	if err != nil {
+		return nil, err
+	}
+
+	result := ExpenseAttachmentListRes{
+		Data: []*warehouse_models.WareExpenseAttachment{},
+	}
+	err = db.
+		Model(&warehouse_models.WareExpenseAttachment{}).
+		Where("ware_expense_attachments.expense_hist_id = ?", expense.ID).
+		Order("ware_expense_attachments.id").
+		Find(&result.Data).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (e *expenseAttachmentImpl) ExpenseAttachmentDownload(ctx context.Context, query *ExpenseAttachmentDownloadReq) (*ExpenseAttachmentDownloadRes, error) {
+	identity, err := getIdentity(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	db := e.db.WithContext(ctx)
+	attachment := warehouse_models.WareExpenseAttachment{}
+	err = db.
+		Model(&warehouse_models.WareExpenseAttachment{}).
+		Where("ware_expense_attachments.id = ?", query.AttachmentId).
+		Find(&attachment).
+		Error
+	if err != nil {
+		return nil, err
+	}
+	if attachment.ID == 0 {
+		return nil, ErrAttachmentNotFound
+	}
+
+	_, err = e.getExpense(ctx, db, identity, query.DomainId, attachment.ExpenseHistID, authorization_iface.Read)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := e.storage.Get(ctx, attachment.StorageKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ExpenseAttachmentDownloadRes{
+		Attachment: &attachment,
+		Content:    content,
+	}, nil
+}
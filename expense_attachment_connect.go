@@ -0,0 +1,165 @@
+package warehouse_service
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+
+	"connectrpc.com/connect"
+	"github.com/pdcgo/shared/interfaces/authorization_iface"
+	warehouse_service_iface "github.com/pdcgo/warehouse_service/services/warehouse_service/v1"
+	"github.com/pdcgo/warehouse_service/services/warehouse_service/v1/warehouse_service_ifaceconnect"
+	"github.com/pdcgo/warehouse_service/warehouse_models"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ExpenseAttachmentChunkSize is max content size of one download stream message.
+const ExpenseAttachmentChunkSize = 32 << 10
+
+// NewExpenseAttachmentServiceHandler is connect handler of expense attachment, identity is read from
+// header with legacy authorization.
+func NewExpenseAttachmentServiceHandler(
+	auth authorization_iface.Authorization,
+	service ExpenseAttachmentService,
+	opts ...connect.HandlerOption,
+) (string, http.Handler) {
+	return warehouse_service_ifaceconnect.NewExpenseAttachmentServiceHandler(
+		&expenseAttachmentConnectImpl{
+			auth:    auth,
+			service: service,
+		},
+		opts...,
+	)
+}
+
+type expenseAttachmentConnectImpl struct {
+	auth    authorization_iface.Authorization
+	service ExpenseAttachmentService
+}
+
+func attachmentConnectErr(err error) error {
+	switch {
+	case errors.Is(err, ErrAttachmentNotFound):
+		return connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, ErrAttachmentTooLarge), errors.Is(err, ErrAttachmentTypeNotAllowed):
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	case errors.Is(err, ErrWarehouseMismatch):
+		return connect.NewError(connect.CodePermissionDenied, err)
+	}
+	return err
+}
+
+func attachmentToProto(attachment *warehouse_models.WareExpenseAttachment) *warehouse_service_iface.ExpenseAttachment {
+	return &warehouse_service_iface.ExpenseAttachment{
+		Id:            uint64(attachment.ID),
+		ExpenseHistId: uint64(attachment.ExpenseHistID),
+		FileName:      attachment.FileName,
+		ContentType:   attachment.ContentType,
+		Size:          attachment.Size,
+		UploadedById:  uint64(attachment.UploadedByID),
+		CreatedAt:     timestamppb.New(attachment.CreatedAt),
+	}
+}
+
+// ExpenseAttachmentUpload implements warehouse_service_ifaceconnect.ExpenseAttachmentServiceHandler.
+func (e *expenseAttachmentConnectImpl) ExpenseAttachmentUpload(
+	ctx context.Context,
+	req *connect.Request[warehouse_service_iface.ExpenseAttachmentUploadRequest],
+) (*connect.Response[warehouse_service_iface.ExpenseAttachmentUploadResponse], error) {
+	ctx, err := legacyIdentityContext(ctx, e.auth, req.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	pay := req.Msg
+	attachment, err := e.service.ExpenseAttachmentUpload(ctx, &ExpenseAttachmentUploadReq{
+		DomainId: pay.DomainId,
+		HistId:   pay.HistId,
+		FileName: pay.FileName,
+		Content:  pay.Content,
+	})
+	if err != nil {
+		return nil, attachmentConnectErr(err)
+	}
+
+	return connect.NewResponse(&warehouse_service_iface.ExpenseAttachmentUploadResponse{
+		Data: attachmentToProto(attachment),
+	}), nil
+}
+
+// ExpenseAttachmentList implements warehouse_service_ifaceconnect.ExpenseAttachmentServiceHandler.
+func (e *expenseAttachmentConnectImpl) ExpenseAttachmentList(
+	ctx context.Context,
+	req *connect.Request[warehouse_service_iface.ExpenseAttachmentListRequest],
+) (*connect.Response[warehouse_service_iface.ExpenseAttachmentListResponse], error) {
+	ctx, err := legacyIdentityContext(ctx, e.auth, req.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := e.service.ExpenseAttachmentList(ctx, &ExpenseAttachmentListReq{
+		DomainId: req.Msg.DomainId,
+		HistId:   req.Msg.HistId,
+	})
+	if err != nil {
+		return nil, attachmentConnectErr(err)
+	}
+
+	res := warehouse_service_iface.ExpenseAttachmentListResponse{
+		Data: make([]*warehouse_service_iface.ExpenseAttachment, len(result.Data)),
+	}
+	for i, attachment := range result.Data {
+		res.Data[i] = attachmentToProto(attachment)
+	}
+
+	return connect.NewResponse(&res), nil
+}
+
+// ExpenseAttachmentDownload implements warehouse_service_ifaceconnect.ExpenseAttachmentServiceHandler.
+// content is streamed from storage by chunk, never read whole in memory.
+func (e *expenseAttachmentConnectImpl) ExpenseAttachmentDownload(
+	ctx context.Context,
+	req *connect.Request[warehouse_service_iface.ExpenseAttachmentDownloadRequest],
+	stream *connect.ServerStream[warehouse_service_iface.ExpenseAttachmentDownloadResponse],
+) error {
+	ctx, err := legacyIdentityContext(ctx, e.auth, req.Header())
+	if err != nil {
+		return err
+	}
+
+	result, err := e.service.ExpenseAttachmentDownload(ctx, &ExpenseAttachmentDownloadReq{
+		DomainId:     req.Msg.DomainId,
+		AttachmentId: req.Msg.AttachmentId,
+	})
+	if err != nil {
+		return attachmentConnectErr(err)
+	}
+	defer result.Content.Close()
+
+	err = stream.Send(&warehouse_service_iface.ExpenseAttachmentDownloadResponse{
+		Attachment: attachmentToProto(result.Attachment),
+	})
+	if err != nil {
+		return err
+	}
+
+	buf := make([]byte, ExpenseAttachmentChunkSize)
+	for {
+		n, err := result.Content.Read(buf)
+		if n > 0 {
+			sendErr := stream.Send(&warehouse_service_iface.ExpenseAttachmentDownloadResponse{
+				Chunk: buf[:n],
+			})
+			if sendErr != nil {
+				return sendErr
+			}
+		}
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,207 @@
+package warehouse_service_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/pdcgo/shared/authorization"
+	"github.com/pdcgo/shared/db_models"
+	"github.com/pdcgo/shared/pkg/moretest"
+	"github.com/pdcgo/shared/pkg/moretest/moretest_mock"
+	"github.com/pdcgo/warehouse_service"
+	"github.com/pdcgo/warehouse_service/blob_storage"
+	warehouse_service_iface "github.com/pdcgo/warehouse_service/services/warehouse_service/v1"
+	"github.com/pdcgo/warehouse_service/services/warehouse_service/v1/warehouse_service_ifaceconnect"
+	"github.com/pdcgo/warehouse_service/warehouse_models"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestExpenseAttachment(t *testing.T) {
+	var db gorm.DB
+
+	moretest.Suite(t, "testing expense attachment",
+		moretest.SetupListFunc{
+			moretest_mock.MockSqliteDatabase(&db),
+			func(t *testing.T) func() error {
+				err := db.AutoMigrate(
+					&warehouse_models.WareExpenseHistory{},
+					&warehouse_models.WareExpenseAttachment{},
+				)
+				assert.Nil(t, err)
+
+				err = db.Create(&[]*warehouse_models.WareExpenseHistory{
+					{ID: 1, WarehouseID: 1, AccountID: 1, ExpenseType: warehouse_models.ExpenseTypeKitchen, Amount: -15_000, At: time.Now()},
+					{ID: 2, WarehouseID: 1, AccountID: 1, ExpenseType: warehouse_models.ExpenseTypeEquity, Amount: 50_000, At: time.Now()},
+				}).Error
+				assert.Nil(t, err)
+
+				return nil
+			},
+		},
+		func(t *testing.T) {
+			storage := blob_storage.NewLocalStorage(t.TempDir())
+			service := warehouse_service.NewExpenseAttachmentService(&db, NewMockAuth(true), storage)
+
+			whCtx := context.WithValue(context.Background(), "identity", &authorization.JwtIdentity{
+				UserID: 5,
+				From:   db_models.WarehouseTeamType,
+			})
+			adminCtx := context.WithValue(context.Background(), "identity", &authorization.JwtIdentity{
+				UserID: 1,
+				From:   db_models.AdminTeamType,
+			})
+
+			jpegContent := append([]byte{0xff, 0xd8, 0xff, 0xe0}, []byte("jpeg content")...)
+
+			attachment, err := service.ExpenseAttachmentUpload(whCtx, &warehouse_service.ExpenseAttachmentUploadReq{
+				HistId:   1,
+				FileName: "../nota dapur.jpg",
+				Content:  jpegContent,
+			})
+			assert.Nil(t, err)
+			assert.Equal(t, "nota dapur.jpg", attachment.FileName)
+			assert.Equal(t, int64(len(jpegContent)), attachment.Size)
+			assert.Equal(t, uint(5), attachment.UploadedByID)
+
+			t.Run("test list", func(t *testing.T) {
+				result, err := service.ExpenseAttachmentList(whCtx, &warehouse_service.ExpenseAttachmentListReq{HistId: 1})
+				assert.Nil(t, err)
+				assert.Len(t, result.Data, 1)
+				assert.Equal(t, attachment.ID, result.Data[0].ID)
+			})
+
+			t.Run("test download", func(t *testing.T) {
+				result, err := service.ExpenseAttachmentDownload(whCtx, &warehouse_service.ExpenseAttachmentDownloadReq{
+					AttachmentId: uint64(attachment.ID),
+				})
+				assert.Nil(t, err)
+				defer result.Content.Close()
+
+				content, err := io.ReadAll(result.Content)
+				assert.Nil(t, err)
+				assert.Equal(t, jpegContent, content)
+				assert.Equal(t, "image/jpeg", result.Attachment.ContentType)
+			})
+
+			t.Run("test content type not allowed", func(t *testing.T) {
+				_, err := service.ExpenseAttachmentUpload(whCtx, &warehouse_service.ExpenseAttachmentUploadReq{
+					HistId:   1,
+					FileName: "nota.jpg",
+					Content:  []byte("#!/bin/sh\necho"),
+				})
+				assert.ErrorIs(t, err, warehouse_service.ErrAttachmentTypeNotAllowed)
+			})
+
+			t.Run("test too large", func(t *testing.T) {
+				_, err := service.ExpenseAttachmentUpload(whCtx, &warehouse_service.ExpenseAttachmentUploadReq{
+					HistId:   1,
+					FileName: "big.pdf",
+					Content:  make([]byte, warehouse_service.ExpenseAttachmentMaxSize+1),
+				})
+				assert.ErrorIs(t, err, warehouse_service.ErrAttachmentTooLarge)
+			})
+
+			t.Run("test admin expense type", func(t *testing.T) {
+				payload := &warehouse_service.ExpenseAttachmentUploadReq{
+					HistId:   2,
+					FileName: "modal.pdf",
+					Content:  []byte("%PDF-1.4 modal"),
+				}
+
+				_, err := service.ExpenseAttachmentUpload(whCtx, payload)
+				assert.Nil(t, err)
+
+				_, err = service.ExpenseAttachmentUpload(adminCtx, payload)
+				assert.Nil(t, err)
+
+				result, err := service.ExpenseAttachmentList(whCtx, &warehouse_service.ExpenseAttachmentListReq{HistId: 2})
+				assert.Nil(t, err)
+				assert.Len(t, result.Data, 2)
+			})
+
+			t.Run("test checked on expense warehouse", func(t *testing.T) {
+				otherService := warehouse_service.NewExpenseAttachmentService(&db, NewMockDomainAuth(2), storage)
+
+				_, err := otherService.ExpenseAttachmentUpload(whCtx, &warehouse_service.ExpenseAttachmentUploadReq{
+					HistId:   1,
+					FileName: "nota.jpg",
+					Content:  jpegContent,
+				})
+				assert.Error(t, err)
+
+				_, err = otherService.ExpenseAttachmentList(whCtx, &warehouse_service.ExpenseAttachmentListReq{HistId: 1})
+				assert.Error(t, err)
+
+				_, err = otherService.ExpenseAttachmentDownload(whCtx, &warehouse_service.ExpenseAttachmentDownloadReq{
+					AttachmentId: uint64(attachment.ID),
+				})
+				assert.Error(t, err)
+
+				_, err = service.ExpenseAttachmentList(whCtx, &warehouse_service.ExpenseAttachmentListReq{DomainId: 2, HistId: 1})
+				assert.ErrorIs(t, err, warehouse_service.ErrWarehouseMismatch)
+			})
+
+			t.Run("test on connect", func(t *testing.T) {
+				mux := http.NewServeMux()
+				mux.Handle(warehouse_service.NewExpenseAttachmentServiceHandler(NewMockAuth(true), service))
+				server := httptest.NewServer(mux)
+				defer server.Close()
+
+				client := warehouse_service_ifaceconnect.NewExpenseAttachmentServiceClient(http.DefaultClient, server.URL)
+				authHeader := func(header http.Header) {
+					header.Set("Authorization", "Bearer token")
+				}
+
+				// bigger than one chunk
+				content := append([]byte("%PDF-1.4\n"), make([]byte, 2*warehouse_service.ExpenseAttachmentChunkSize+10)...)
+				upload := connect.NewRequest(&warehouse_service_iface.ExpenseAttachmentUploadRequest{
+					DomainId: 1,
+					HistId:   1,
+					FileName: "nota.jpg",
+					Content:  content,
+				})
+				authHeader(upload.Header())
+				uploaded, err := client.ExpenseAttachmentUpload(t.Context(), upload)
+				assert.Nil(t, err)
+				assert.Equal(t, "application/pdf", uploaded.Msg.Data.ContentType)
+
+				download := connect.NewRequest(&warehouse_service_iface.ExpenseAttachmentDownloadRequest{
+					DomainId:     1,
+					AttachmentId: uploaded.Msg.Data.Id,
+				})
+				authHeader(download.Header())
+				stream, err := client.ExpenseAttachmentDownload(t.Context(), download)
+				assert.Nil(t, err)
+
+				var first *warehouse_service_iface.ExpenseAttachment
+				downloaded := []byte{}
+				count := 0
+				for stream.Receive() {
+					if stream.Msg().Attachment != nil {
+						first = stream.Msg().Attachment
+					}
+					downloaded = append(downloaded, stream.Msg().Chunk...)
+					count++
+				}
+				assert.Nil(t, stream.Err())
+				assert.Equal(t, "nota.jpg", first.FileName)
+				assert.Equal(t, content, downloaded)
+				assert.Equal(t, 4, count)
+
+				list := connect.NewRequest(&warehouse_service_iface.ExpenseAttachmentListRequest{DomainId: 2, HistId: 1})
+				authHeader(list.Header())
+				_, err = client.ExpenseAttachmentList(t.Context(), list)
+				assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+
+				_, err = client.ExpenseAttachmentList(t.Context(), connect.NewRequest(&warehouse_service_iface.ExpenseAttachmentListRequest{HistId: 1}))
+				assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+			})
+		},
+	)
+}
@@ -0,0 +1,96 @@
+package warehouse_service
+
+import (
+	"context"
+
+	"github.com/pdcgo/event_source"
+	"github.com/pdcgo/schema/services/event_base/v1"
+	"github.com/pdcgo/warehouse_service/warehouse_mutations"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+const ExpenseBudgetTopic = "expense-budget-topic"
+
+// schema repository has no message for budget alert yet, so message descriptor is built here with same
+// event_config option as generated event. it can be replaced by generated message without changing subscriber.
+var expenseBudgetAlertDesc = func() protoreflect.MessageDescriptor {
+	options := &descriptorpb.MessageOptions{}
+	proto.SetExtension(options, event_base.E_EventConfig, &event_base.MessageEventConfig{
+		EventTopic: ExpenseBudgetTopic,
+	})
+
+	field := func(name string, number int32, kind descriptorpb.FieldDescriptorProto_Type) *descriptorpb.FieldDescriptorProto {
+		return &descriptorpb.FieldDescriptorProto{
+			Name:   proto.String(name),
+			Number: proto.Int32(number),
+			Label:  descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+			Type:   kind.Enum(),
+		}
+	}
+
+	file, err := protodesc.NewFile(&descriptorpb.FileDescriptorProto{
+		Name:    proto.String("warehouse_service/v1/expense_budget_event.proto"),
+		Package: proto.String("warehouse_service.v1"),
+		Syntax:  proto.String("proto3"),
+		MessageType: []*descriptorpb.DescriptorProto{
+			{
+				Name: proto.String("ExpenseBudgetAlert"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					field("warehouse_id", 1, descriptorpb.FieldDescriptorProto_TYPE_UINT64),
+					field("expense_type", 2, descriptorpb.FieldDescriptorProto_TYPE_STRING),
+					field("month", 3, descriptorpb.FieldDescriptorProto_TYPE_STRING),
+					field("level", 4, descriptorpb.FieldDescriptorProto_TYPE_STRING),
+					field("budget", 5, descriptorpb.FieldDescriptorProto_TYPE_DOUBLE),
+					field("spent", 6, descriptorpb.FieldDescriptorProto_TYPE_DOUBLE),
+					field("percent", 7, descriptorpb.FieldDescriptorProto_TYPE_DOUBLE),
+					field("alert_percent", 8, descriptorpb.FieldDescriptorProto_TYPE_DOUBLE),
+					field("enforce", 9, descriptorpb.FieldDescriptorProto_TYPE_STRING),
+					field("expense_hist_id", 10, descriptorpb.FieldDescriptorProto_TYPE_UINT64),
+					field("actor_id", 11, descriptorpb.FieldDescriptorProto_TYPE_UINT64),
+				},
+				Options: options,
+			},
+		},
+	}, nil)
+	if err != nil {
+		panic(err)
+	}
+
+	return file.Messages().ByName("ExpenseBudgetAlert")
+}()
+
+// NewExpenseBudgetAlertEvent is event published when expense crossing budget alert percent or budget.
+func NewExpenseBudgetAlertEvent(alert *warehouse_mutations.BudgetAlert) proto.Message {
+	event := dynamicpb.NewMessage(expenseBudgetAlertDesc)
+	fields := expenseBudgetAlertDesc.Fields()
+	set := func(name string, value protoreflect.Value) {
+		event.Set(fields.ByName(protoreflect.Name(name)), value)
+	}
+
+	set("warehouse_id", protoreflect.ValueOfUint64(uint64(alert.Budget.WarehouseID)))
+	set("expense_type", protoreflect.ValueOfString(string(alert.Budget.ExpenseType)))
+	set("month", protoreflect.ValueOfString(alert.Budget.Month))
+	set("level", protoreflect.ValueOfString(string(alert.Level)))
+	set("budget", protoreflect.ValueOfFloat64(alert.Budget.Amount))
+	set("spent", protoreflect.ValueOfFloat64(alert.Spent))
+	set("percent", protoreflect.ValueOfFloat64(alert.Percent()))
+	set("alert_percent", protoreflect.ValueOfFloat64(alert.Budget.AlertPercent))
+	set("enforce", protoreflect.ValueOfString(string(alert.Budget.Enforce)))
+	set("expense_hist_id", protoreflect.ValueOfUint64(uint64(alert.ExpenseHistID)))
+	set("actor_id", protoreflect.ValueOfUint64(uint64(alert.ActorID)))
+
+	return event
+}
+
+func sendBudgetAlert(ctx context.Context, sender event_source.EventSender, alert *warehouse_mutations.BudgetAlert) error {
+	if sender == nil || alert == nil {
+		return nil
+	}
+
+	_, err := sender(ctx, NewExpenseBudgetAlertEvent(alert))
+	return err
+}
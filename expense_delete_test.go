@@ -0,0 +1,194 @@
+package warehouse_service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pdcgo/event_source"
+	"github.com/pdcgo/shared/authorization"
+	"github.com/pdcgo/shared/db_models"
+	"github.com/pdcgo/shared/interfaces/warehouse_iface"
+	"github.com/pdcgo/shared/pkg/moretest"
+	"github.com/pdcgo/shared/pkg/moretest/moretest_mock"
+	"github.com/pdcgo/warehouse_service"
+	"github.com/pdcgo/warehouse_service/warehouse_models"
+	"github.com/pdcgo/warehouse_service/warehouse_mutations"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+func TestExpenseDelete(t *testing.T) {
+	var db gorm.DB
+
+	moretest.Suite(t, "testing expense soft delete",
+		moretest.SetupListFunc{
+			moretest_mock.MockSqliteDatabase(&db),
+			func(t *testing.T) func() error {
+				err := db.AutoMigrate(
+					&warehouse_models.WareExpenseAccount{},
+					&warehouse_models.WareExpenseAccountWarehouse{},
+					&warehouse_models.WareExpenseHistory{},
+					&warehouse_models.WareExpenseType{},
+					&warehouse_models.WareExpenseTypeTeam{},
+					&warehouse_models.WareExpenseBudget{},
+					&warehouse_models.WareExpenseStatusLog{},
+					&warehouse_models.WareExpenseRevision{},
+					&warehouse_models.WareExpenseDeleteLog{},
+					&warehouse_models.WareBalanceAccountHistory{},
+					&warehouse_models.WarehouseTimezone{},
+				)
+				assert.Nil(t, err)
+
+				err = db.Create(warehouse_models.DefaultExpenseTypes()).Error
+				assert.Nil(t, err)
+
+				account := warehouse_models.WareExpenseAccount{Name: "Kas", NumberID: "778899", CreatedAt: time.Now()}
+				err = db.Create(&account).Error
+				assert.Nil(t, err)
+
+				err = db.Create(&warehouse_models.WareExpenseAccountWarehouse{AccountID: account.ID, WarehouseID: 1}).Error
+				assert.Nil(t, err)
+
+				return nil
+			},
+		},
+		func(t *testing.T) {
+			ctx := context.WithValue(context.Background(), "identity", &authorization.JwtIdentity{
+				UserID: 5,
+				From:   db_models.WarehouseTeamType,
+			})
+			service := warehouse_service.NewWarehouseFinanceService(&db, NewMockAuth(true), event_source.EmptySender)
+
+			addExpense := func(amount float64) *warehouse_models.WareExpenseHistory {
+				_, err := service.ExpenseHistoryAdd(ctx, &warehouse_iface.ExpenseHistoryAddReq{
+					AccountId:   1,
+					WarehouseId: 1,
+					ExpenseType: string(warehouse_models.ExpenseTypeKitchen),
+					Amount:      amount,
+					At:          timestamppb.New(time.Now().Add(-time.Hour)),
+				})
+				assert.Nil(t, err)
+
+				expense := warehouse_models.WareExpenseHistory{}
+				err = db.Last(&expense).Error
+				assert.Nil(t, err)
+				return &expense
+			}
+			addExpense(-10_000)
+			wrong := addExpense(-99_000)
+
+			listed := func(includeDeleted bool) []*warehouse_service.ExpenseHistoryItem {
+				result, err := service.ExpenseHistoryPage(ctx, &warehouse_service.ExpenseHistoryQuery{
+					ExpenseHistoryListReq: &warehouse_iface.ExpenseHistoryListReq{WarehouseId: 1},
+					IncludeDeleted:        includeDeleted,
+				}, nil)
+				assert.Nil(t, err)
+				return result.Data
+			}
+			reportExpense := func() float64 {
+				result, err := service.ExpenseReportDaily(ctx, &warehouse_iface.ExpenseReportDailyReq{WarehouseId: 1})
+				assert.Nil(t, err)
+
+				var expense float64
+				for _, report := range result.Data {
+					expense += report.Expense
+				}
+				return expense
+			}
+			assert.Equal(t, float64(109_000), reportExpense())
+
+			t.Run("test delete without reason", func(t *testing.T) {
+				_, err := service.ExpenseHistoryDelete(ctx, &warehouse_service.ExpenseHistoryDeleteReq{
+					HistId: uint64(wrong.ID),
+					Reason: " ",
+				})
+				assert.ErrorIs(t, err, warehouse_mutations.ErrExpenseDeleteReasonEmpty)
+			})
+
+			t.Run("test checked on expense warehouse", func(t *testing.T) {
+				service := warehouse_service.NewWarehouseFinanceService(&db, NewMockDomainAuth(2), event_source.EmptySender)
+
+				_, err := service.ExpenseHistoryDelete(ctx, &warehouse_service.ExpenseHistoryDeleteReq{
+					HistId: uint64(wrong.ID),
+					Reason: "salah input nominal",
+				})
+				assert.ErrorIs(t, err, authorization.ErrPermission)
+
+				// domain of client is other warehouse than the expense
+				_, err = service.ExpenseHistoryDelete(ctx, &warehouse_service.ExpenseHistoryDeleteReq{
+					DomainId: 2,
+					HistId:   uint64(wrong.ID),
+					Reason:   "salah input nominal",
+				})
+				assert.ErrorIs(t, err, warehouse_service.ErrWarehouseMismatch)
+			})
+
+			t.Run("test delete", func(t *testing.T) {
+				result, err := service.ExpenseHistoryDelete(ctx, &warehouse_service.ExpenseHistoryDeleteReq{
+					HistId: uint64(wrong.ID),
+					Reason: "salah input nominal",
+				})
+				assert.Nil(t, err)
+				assert.True(t, result.Deleted)
+
+				assert.Len(t, listed(false), 1)
+				assert.Equal(t, float64(10_000), reportExpense())
+
+				data := listed(true)
+				assert.Len(t, data, 2)
+				for _, item := range data {
+					assert.Equal(t, item.ID == wrong.ID, item.Deleted)
+				}
+
+				t.Run("test delete twice", func(t *testing.T) {
+					_, err := service.ExpenseHistoryDelete(ctx, &warehouse_service.ExpenseHistoryDeleteReq{
+						HistId: uint64(wrong.ID),
+						Reason: "salah input nominal",
+					})
+					assert.ErrorIs(t, err, warehouse_mutations.ErrExpenseDeleted)
+				})
+
+				t.Run("test edit deleted", func(t *testing.T) {
+					_, err := service.ExpenseHistoryEdit(ctx, &warehouse_iface.ExpenseHistoryEditReq{
+						HistId:      uint64(wrong.ID),
+						AccountId:   1,
+						WarehouseId: 1,
+						ExpenseType: string(warehouse_models.ExpenseTypeKitchen),
+						Amount:      -9_900,
+						At:          timestamppb.New(wrong.At),
+					})
+					assert.ErrorIs(t, err, warehouse_mutations.ErrExpenseDeleted)
+				})
+			})
+
+			t.Run("test restore", func(t *testing.T) {
+				result, err := service.ExpenseHistoryRestore(ctx, &warehouse_service.ExpenseHistoryDeleteReq{
+					HistId: uint64(wrong.ID),
+					Reason: "ternyata benar",
+				})
+				assert.Nil(t, err)
+				assert.False(t, result.Deleted)
+
+				assert.Len(t, listed(false), 2)
+				assert.Equal(t, float64(109_000), reportExpense())
+
+				logs := []*warehouse_models.WareExpenseDeleteLog{}
+				err = db.Where("expense_hist_id = ?", wrong.ID).Order("id").Find(&logs).Error
+				assert.Nil(t, err)
+				assert.Len(t, logs, 2)
+				assert.True(t, logs[0].Deleted)
+				assert.Equal(t, "salah input nominal", logs[0].Reason)
+				assert.False(t, logs[1].Deleted)
+				assert.Equal(t, uint(5), logs[1].ActorID)
+
+				_, err = service.ExpenseHistoryRestore(ctx, &warehouse_service.ExpenseHistoryDeleteReq{
+					HistId: uint64(wrong.ID),
+					Reason: "ternyata benar",
+				})
+				assert.ErrorIs(t, err, warehouse_mutations.ErrExpenseNotDeleted)
+			})
+		},
+	)
+}
@@ -0,0 +1,144 @@
+package warehouse_service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pdcgo/event_source"
+	"github.com/pdcgo/shared/authorization"
+	"github.com/pdcgo/shared/db_models"
+	"github.com/pdcgo/shared/interfaces/warehouse_iface"
+	"github.com/pdcgo/shared/pkg/moretest"
+	"github.com/pdcgo/shared/pkg/moretest/moretest_mock"
+	"github.com/pdcgo/warehouse_service"
+	"github.com/pdcgo/warehouse_service/warehouse_models"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+func TestExpenseRevision(t *testing.T) {
+	var db gorm.DB
+
+	moretest.Suite(t, "testing expense revision",
+		moretest.SetupListFunc{
+			moretest_mock.MockSqliteDatabase(&db),
+			func(t *testing.T) func() error {
+				err := db.AutoMigrate(
+					&warehouse_models.WareExpenseAccount{},
+					&warehouse_models.WareExpenseAccountWarehouse{},
+					&warehouse_models.WareExpenseHistory{},
+					&warehouse_models.WareExpenseType{},
+					&warehouse_models.WareExpenseTypeTeam{},
+					&warehouse_models.WareExpenseBudget{},
+					&warehouse_models.WareExpenseStatusLog{},
+					&warehouse_models.WareExpenseRevision{},
+					&warehouse_models.WarehouseTimezone{},
+				)
+				assert.Nil(t, err)
+
+				err = db.Create(warehouse_models.DefaultExpenseTypes()).Error
+				assert.Nil(t, err)
+
+				err = db.Create(&[]*warehouse_models.WareExpenseAccount{
+					{ID: 1, Name: "Kas", NumberID: "300001", CreatedAt: time.Now()},
+					{ID: 2, Name: "Bank", NumberID: "300002", CreatedAt: time.Now()},
+				}).Error
+				assert.Nil(t, err)
+
+				err = db.Create(&[]*warehouse_models.WareExpenseAccountWarehouse{
+					{AccountID: 1, WarehouseID: 1},
+					{AccountID: 2, WarehouseID: 1},
+				}).Error
+				assert.Nil(t, err)
+
+				return nil
+			},
+		},
+		func(t *testing.T) {
+			authorCtx := context.WithValue(context.Background(), "identity", &authorization.JwtIdentity{
+				UserID: 5,
+				From:   db_models.WarehouseTeamType,
+			})
+			editorCtx := context.WithValue(context.Background(), "identity", &authorization.JwtIdentity{
+				UserID: 6,
+				From:   db_models.WarehouseTeamType,
+			})
+			service := warehouse_service.NewWarehouseFinanceService(&db, NewMockAuth(true), event_source.EmptySender)
+
+			at := time.Now().Add(-time.Hour)
+			_, err := service.ExpenseHistoryAdd(authorCtx, &warehouse_iface.ExpenseHistoryAddReq{
+				AccountId:   1,
+				WarehouseId: 1,
+				ExpenseType: string(warehouse_models.ExpenseTypeKitchen),
+				Amount:      -10_000,
+				Note:        "beli gas",
+				At:          timestamppb.New(at),
+			})
+			assert.Nil(t, err)
+
+			expense := warehouse_models.WareExpenseHistory{}
+			err = db.First(&expense).Error
+			assert.Nil(t, err)
+
+			edit := func(accountID uint64, amount float64, note string) {
+				_, err := service.ExpenseHistoryEdit(editorCtx, &warehouse_iface.ExpenseHistoryEditReq{
+					HistId:      uint64(expense.ID),
+					AccountId:   accountID,
+					WarehouseId: 1,
+					ExpenseType: string(warehouse_models.ExpenseTypeKitchen),
+					Amount:      amount,
+					Note:        note,
+					At:          timestamppb.New(at),
+				})
+				assert.Nil(t, err)
+			}
+			edit(1, -12_000, "beli gas")
+			edit(2, -12_000, "beli gas 3kg")
+
+			t.Run("test author kept", func(t *testing.T) {
+				data := warehouse_models.WareExpenseHistory{}
+				err := db.First(&data, expense.ID).Error
+				assert.Nil(t, err)
+				assert.Equal(t, uint(5), data.CreatedByID)
+				assert.Equal(t, uint(2), data.AccountID)
+			})
+
+			t.Run("test revision trail", func(t *testing.T) {
+				result, err := service.ExpenseHistoryRevision(authorCtx, &warehouse_service.ExpenseHistoryRevisionReq{
+					HistId: uint64(expense.ID),
+				})
+				assert.Nil(t, err)
+				assert.Len(t, result.Data, 2)
+
+				first := result.Data[0]
+				assert.Equal(t, uint(6), first.EditorID)
+				assert.Equal(t, db_models.WarehouseTeamType, first.EditorFrom)
+				assert.Equal(t, []string{"amount"}, first.Changes)
+				assert.Equal(t, float64(-10_000), first.Before.Data().Amount)
+				assert.Equal(t, float64(-12_000), first.After.Data().Amount)
+
+				second := result.Data[1]
+				assert.Equal(t, []string{"account_id", "note"}, second.Changes)
+				assert.Equal(t, "beli gas", second.Before.Data().Note)
+				assert.Equal(t, "beli gas 3kg", second.After.Data().Note)
+			})
+
+			t.Run("test checked on expense warehouse", func(t *testing.T) {
+				service := warehouse_service.NewWarehouseFinanceService(&db, NewMockDomainAuth(2), event_source.EmptySender)
+
+				_, err := service.ExpenseHistoryRevision(authorCtx, &warehouse_service.ExpenseHistoryRevisionReq{
+					HistId: uint64(expense.ID),
+				})
+				assert.ErrorIs(t, err, authorization.ErrPermission)
+
+				_, err = service.ExpenseHistoryRevision(authorCtx, &warehouse_service.ExpenseHistoryRevisionReq{
+					DomainId: 2,
+					HistId:   uint64(expense.ID),
+				})
+				assert.ErrorIs(t, err, warehouse_service.ErrWarehouseMismatch)
+			})
+		},
+	)
+}
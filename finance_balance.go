@@ -0,0 +1,212 @@
+package warehouse_service
+
+import (
+	"context"
+	"time"
+
+	"github.com/pdcgo/schema/services/common/v1"
+	"github.com/pdcgo/shared/interfaces/authorization_iface"
+	"github.com/pdcgo/warehouse_service/warehouse_models"
+	"github.com/pdcgo/warehouse_service/warehouse_mutations"
+	"github.com/pdcgo/warehouse_service/warehouse_query"
+	"gorm.io/gorm"
+)
+
+// balance history is daily closing balance of account recorded by cashier. legacy proto has no rpc for it.
+// DomainId of balance request is warehouse of the account, optional and rejected when it is other warehouse.
+
+type BalanceHistoryCreateReq struct {
+	DomainId  uint64    `json:"domain_id"`
+	AccountId uint64    `json:"account_id"`
+	Amount    float64   `json:"amount"`
+	At        time.Time `json:"at"`
+}
+
+type BalanceHistoryUpdateReq struct {
+	DomainId uint64    `json:"domain_id"`
+	HistId   uint64    `json:"hist_id"`
+	Amount   float64   `json:"amount"`
+	At       time.Time `json:"at"`
+}
+
+type BalanceHistoryDeleteReq struct {
+	DomainId uint64 `json:"domain_id"`
+	HistId   uint64 `json:"hist_id"`
+}
+
+// BalanceHistoryListReq StartDate, EndDate and At is unix milli, taken as day in warehouse timezone.
+type BalanceHistoryListReq struct {
+	WarehouseId uint64             `json:"warehouse_id"`
+	AccountId   uint64             `json:"account_id"`
+	CreatedById uint64             `json:"created_by_id"`
+	At          int64              `json:"at"`
+	StartDate   int64              `json:"start_date"`
+	EndDate     int64              `json:"end_date"`
+	Page        *common.PageFilter `json:"page"`
+}
+
+type BalanceHistoryListRes struct {
+	Data     []*warehouse_models.WareBalanceAccountHistory `json:"data"`
+	PageInfo *common.PageInfo                              `json:"page_info"`
+}
+
+// BalanceHistoryCreate is creating balance, or replacing amount when account already has balance at that day.
+func (w *warehouseFinImpl) BalanceHistoryCreate(ctx context.Context, payload *BalanceHistoryCreateReq) (*warehouse_models.WareBalanceAccountHistory, error) {
+	identity, err := getIdentity(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	db := w.db.WithContext(ctx)
+
+	// same account warehouse as picked by balance mutation
+	account := warehouse_models.WareExpenseAccountWarehouse{}
+	err = warehouse_query.
+		NewWarehouseExpenseAccountQuery(db, false).
+		FromAccount(uint(payload.AccountId)).
+		GetQuery().
+		Find(&account).
+		Error
+	if err != nil {
+		return nil, err
+	}
+	if account.ID == 0 {
+		return nil, warehouse_mutations.ErrExpenseAccountNotFound
+	}
+	err = checkWarehouse(ctx, w.auth, identity, &warehouse_models.WareExpenseAccount{}, uint(payload.DomainId), account.WarehouseID, authorization_iface.Create)
+	if err != nil {
+		return nil, err
+	}
+
+	var result *warehouse_models.WareBalanceAccountHistory
+	err = db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		balanceService := warehouse_mutations.NewWareBalanceHistMutation(tx, identity)
+		result, err = balanceService.Create(uint(payload.AccountId), payload.Amount, payload.At)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (w *warehouseFinImpl) BalanceHistoryUpdate(ctx context.Context, payload *BalanceHistoryUpdateReq) (*warehouse_models.WareBalanceAccountHistory, error) {
+	identity, err := getIdentity(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var result *warehouse_models.WareBalanceAccountHistory
+	db := w.db.WithContext(ctx)
+	err = db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		balanceService := warehouse_mutations.NewWareBalanceHistMutation(tx, identity)
+		result, err = balanceService.Get(uint(payload.HistId))
+		if err != nil {
+			return err
+		}
+		err = checkWarehouse(ctx, w.auth, identity, &warehouse_models.WareExpenseAccount{}, uint(payload.DomainId), result.WarehouseID, authorization_iface.Update)
+		if err != nil {
+			return err
+		}
+
+		return balanceService.Update(payload.Amount, payload.At)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (w *warehouseFinImpl) BalanceHistoryDelete(ctx context.Context, payload *BalanceHistoryDeleteReq) error {
+	identity, err := getIdentity(ctx)
+	if err != nil {
+		return err
+	}
+
+	db := w.db.WithContext(ctx)
+	return db.Transaction(func(tx *gorm.DB) error {
+		balanceService := warehouse_mutations.NewWareBalanceHistMutation(tx, identity)
+		data, err := balanceService.Get(uint(payload.HistId))
+		if err != nil {
+			return err
+		}
+		err = checkWarehouse(ctx, w.auth, identity, &warehouse_models.WareExpenseAccount{}, uint(payload.DomainId), data.WarehouseID, authorization_iface.Delete)
+		if err != nil {
+			return err
+		}
+
+		return balanceService.Delete()
+	})
+}
+
+// balanceHistorySqlQuery is filter of balance history list.
+func balanceHistorySqlQuery(db *gorm.DB, query *BalanceHistoryListReq) (*gorm.DB, error) {
+	loc, err := warehouse_query.GetWarehouseLocation(db, uint(query.WarehouseId))
+	if err != nil {
+		return nil, err
+	}
+
+	var at, startDay, endDay time.Time
+	if query.At != 0 {
+		at = time.UnixMilli(query.At)
+	}
+	if query.StartDate != 0 {
+		unixMilli := time.UnixMilli(query.StartDate).In(loc)
+		startDay = time.Date(unixMilli.Year(), unixMilli.Month(), unixMilli.Day(), 0, 0, 0, 0, loc)
+	}
+	if query.EndDate != 0 {
+		unixMilli := time.UnixMilli(query.EndDate).In(loc)
+		endDay = time.Date(unixMilli.Year(), unixMilli.Month(), unixMilli.Day()+1, 0, 0, 0, -1, loc)
+	}
+
+	sqlQuery := warehouse_query.
+		NewWarehouseBalanceHistQuery(db, false).
+		FromWarehouse(uint(query.WarehouseId)).
+		FromAccount(uint(query.AccountId)).
+		CreatedBy(uint(query.CreatedById)).
+		BalanceAt(at, loc).
+		BalanceTime(startDay, endDay).
+		GetQuery()
+
+	return sqlQuery, nil
+}
+
+// BalanceHistoryList default sort is newest at first.
+func (w *warehouseFinImpl) BalanceHistoryList(ctx context.Context, query *BalanceHistoryListReq) (*BalanceHistoryListRes, error) {
+	err := w.checkRead(ctx, &warehouse_models.WareExpenseAccount{}, query.WarehouseId)
+	if err != nil {
+		return nil, err
+	}
+
+	db := w.db.WithContext(ctx)
+	sqlQuery, err := balanceHistorySqlQuery(db, query)
+	if err != nil {
+		return nil, err
+	}
+
+	result := BalanceHistoryListRes{
+		Data: []*warehouse_models.WareBalanceAccountHistory{},
+	}
+	sqlQuery, result.PageInfo, err = paginate(db, sqlQuery, query.Page)
+	if err != nil {
+		return nil, err
+	}
+
+	err = sqlQuery.
+		Order("ware_balance_account_histories.at desc").
+		Order("ware_balance_account_histories.id desc").
+		Find(&result.Data).Error
+	if err != nil {
+		return nil, err
+	}
+
+	if query.Page == nil {
+		result.PageInfo.TotalItems = int64(len(result.Data))
+	}
+
+	return &result, nil
+}
@@ -0,0 +1,176 @@
+package warehouse_service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pdcgo/event_source"
+	"github.com/pdcgo/schema/services/common/v1"
+	"github.com/pdcgo/shared/authorization"
+	"github.com/pdcgo/shared/db_models"
+	"github.com/pdcgo/shared/pkg/moretest"
+	"github.com/pdcgo/shared/pkg/moretest/moretest_mock"
+	"github.com/pdcgo/warehouse_service"
+	"github.com/pdcgo/warehouse_service/warehouse_models"
+	"github.com/pdcgo/warehouse_service/warehouse_mutations"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestBalanceHistory(t *testing.T) {
+	var db gorm.DB
+
+	moretest.Suite(t, "testing balance history",
+		moretest.SetupListFunc{
+			moretest_mock.MockSqliteDatabase(&db),
+			func(t *testing.T) func() error {
+				err := db.AutoMigrate(
+					&warehouse_models.WareExpenseAccount{},
+					&warehouse_models.WareExpenseAccountWarehouse{},
+					&warehouse_models.WareBalanceAccountHistory{},
+					&warehouse_models.WarehouseTimezone{},
+				)
+				assert.Nil(t, err)
+
+				account := warehouse_models.WareExpenseAccount{Name: "Kas", NumberID: "112233", CreatedAt: time.Now()}
+				err = db.Create(&account).Error
+				assert.Nil(t, err)
+
+				err = db.Create(&warehouse_models.WareExpenseAccountWarehouse{AccountID: account.ID, WarehouseID: 1}).Error
+				assert.Nil(t, err)
+
+				return nil
+			},
+		},
+		func(t *testing.T) {
+			loc, err := time.LoadLocation(warehouse_models.DefaultWarehouseTimezone)
+			assert.Nil(t, err)
+
+			day1 := time.Date(2025, 1, 1, 21, 0, 0, 0, loc)
+			day2 := day1.AddDate(0, 0, 1)
+
+			ctx := context.WithValue(context.Background(), "identity", &authorization.JwtIdentity{
+				UserID: 3,
+				From:   db_models.WarehouseTeamType,
+			})
+			service := warehouse_service.NewWarehouseFinanceService(&db, NewMockAuth(true), event_source.EmptySender)
+
+			first, err := service.BalanceHistoryCreate(ctx, &warehouse_service.BalanceHistoryCreateReq{
+				AccountId: 1,
+				Amount:    100_000,
+				At:        day1,
+			})
+			assert.Nil(t, err)
+			assert.True(t, first.At.Equal(time.Date(2025, 1, 1, 0, 0, 0, 0, loc)))
+
+			t.Run("create same day replacing amount", func(t *testing.T) {
+				result, err := service.BalanceHistoryCreate(ctx, &warehouse_service.BalanceHistoryCreateReq{
+					AccountId: 1,
+					Amount:    120_000,
+					At:        day1.Add(time.Hour),
+				})
+				assert.Nil(t, err)
+				assert.Equal(t, first.ID, result.ID)
+				assert.Equal(t, float64(120_000), result.Amount)
+			})
+
+			second, err := service.BalanceHistoryCreate(ctx, &warehouse_service.BalanceHistoryCreateReq{
+				AccountId: 1,
+				Amount:    90_000,
+				At:        day2,
+			})
+			assert.Nil(t, err)
+
+			t.Run("list", func(t *testing.T) {
+				result, err := service.BalanceHistoryList(ctx, &warehouse_service.BalanceHistoryListReq{
+					WarehouseId: 1,
+					CreatedById: 3,
+					Page:        &common.PageFilter{Page: 1, Limit: 1},
+				})
+				assert.Nil(t, err)
+				assert.Len(t, result.Data, 1)
+				assert.Equal(t, second.ID, result.Data[0].ID)
+				assert.Equal(t, int64(2), result.PageInfo.TotalItems)
+
+				result, err = service.BalanceHistoryList(ctx, &warehouse_service.BalanceHistoryListReq{
+					WarehouseId: 1,
+					At:          day1.UnixMilli(),
+				})
+				assert.Nil(t, err)
+				assert.Len(t, result.Data, 1)
+				assert.Equal(t, first.ID, result.Data[0].ID)
+
+				result, err = service.BalanceHistoryList(ctx, &warehouse_service.BalanceHistoryListReq{
+					WarehouseId: 1,
+					StartDate:   day2.UnixMilli(),
+				})
+				assert.Nil(t, err)
+				assert.Len(t, result.Data, 1)
+				assert.Equal(t, second.ID, result.Data[0].ID)
+			})
+
+			t.Run("update to day already has balance", func(t *testing.T) {
+				_, err := service.BalanceHistoryUpdate(ctx, &warehouse_service.BalanceHistoryUpdateReq{
+					HistId: uint64(second.ID),
+					Amount: 90_000,
+					At:     day1,
+				})
+				assert.ErrorIs(t, err, warehouse_mutations.ErrBalanceHistExist)
+			})
+
+			t.Run("update", func(t *testing.T) {
+				result, err := service.BalanceHistoryUpdate(ctx, &warehouse_service.BalanceHistoryUpdateReq{
+					HistId: uint64(second.ID),
+					Amount: 95_000,
+					At:     day2.AddDate(0, 0, 1),
+				})
+				assert.Nil(t, err)
+				assert.Equal(t, float64(95_000), result.Amount)
+			})
+
+			t.Run("checked on account warehouse", func(t *testing.T) {
+				otherService := warehouse_service.NewWarehouseFinanceService(&db, NewMockDomainAuth(2), event_source.EmptySender)
+
+				_, err := otherService.BalanceHistoryCreate(ctx, &warehouse_service.BalanceHistoryCreateReq{
+					AccountId: 1,
+					Amount:    1,
+					At:        day1,
+				})
+				assert.Error(t, err)
+
+				_, err = otherService.BalanceHistoryUpdate(ctx, &warehouse_service.BalanceHistoryUpdateReq{
+					HistId: uint64(second.ID),
+					Amount: 1,
+					At:     day2,
+				})
+				assert.Error(t, err)
+
+				_, err = otherService.BalanceHistoryList(ctx, &warehouse_service.BalanceHistoryListReq{
+					WarehouseId: 1,
+				})
+				assert.Error(t, err)
+
+				err = service.BalanceHistoryDelete(ctx, &warehouse_service.BalanceHistoryDeleteReq{
+					DomainId: 2,
+					HistId:   uint64(second.ID),
+				})
+				assert.ErrorIs(t, err, warehouse_service.ErrWarehouseMismatch)
+			})
+
+			t.Run("delete", func(t *testing.T) {
+				err := service.BalanceHistoryDelete(ctx, &warehouse_service.BalanceHistoryDeleteReq{
+					HistId: uint64(second.ID),
+				})
+				assert.Nil(t, err)
+
+				_, err = service.BalanceHistoryUpdate(ctx, &warehouse_service.BalanceHistoryUpdateReq{
+					HistId: uint64(second.ID),
+					Amount: 1,
+					At:     day2,
+				})
+				assert.ErrorIs(t, err, warehouse_mutations.ErrBalanceHistNotFound)
+			})
+		},
+	)
+}
@@ -0,0 +1,152 @@
+package warehouse_service
+
+import (
+	"context"
+	"time"
+
+	"github.com/pdcgo/shared/interfaces/authorization_iface"
+	"github.com/pdcgo/warehouse_service/warehouse_models"
+	"github.com/pdcgo/warehouse_service/warehouse_mutations"
+	"github.com/pdcgo/warehouse_service/warehouse_query"
+	"gorm.io/gorm"
+)
+
+// ExpenseBudgetSetReq is creating or replacing monthly budget of expense type, zero Amount is removing budget.
+// Month is "2006-01", Enforce "warn" (default) or "reject".
+type ExpenseBudgetSetReq struct {
+	DomainId     uint64                         `json:"domain_id"`
+	WarehouseId  uint64                         `json:"warehouse_id"`
+	ExpenseType  warehouse_models.ExpenseType   `json:"expense_type"`
+	Month        string                         `json:"month"`
+	Amount       float64                        `json:"amount"`
+	AlertPercent float64                        `json:"alert_percent"`
+	Enforce      warehouse_models.BudgetEnforce `json:"enforce"`
+}
+
+// ExpenseBudgetReportReq empty Month is current month in warehouse timezone.
+type ExpenseBudgetReportReq struct {
+	WarehouseId uint64 `json:"warehouse_id"`
+	Month       string `json:"month"`
+}
+
+type ExpenseBudgetUsage struct {
+	ExpenseType  warehouse_models.ExpenseType   `json:"expense_type"`
+	Budget       float64                        `json:"budget"`
+	AlertPercent float64                        `json:"alert_percent"`
+	Enforce      warehouse_models.BudgetEnforce `json:"enforce"`
+	Spent        float64                        `json:"spent"`
+	Remaining    float64                        `json:"remaining"`
+	Utilisation  float64                        `json:"utilisation"` // percent of budget spent
+	Alert        bool                           `json:"alert"`
+	Exceeded     bool                           `json:"exceeded"`
+}
+
+type ExpenseBudgetReportRes struct {
+	WarehouseID uint                  `json:"warehouse_id"`
+	Month       string                `json:"month"`
+	Data        []*ExpenseBudgetUsage `json:"data"`
+}
+
+func (w *warehouseFinImpl) ExpenseBudgetSet(ctx context.Context, payload *ExpenseBudgetSetReq) (*warehouse_models.WareExpenseBudget, error) {
+	identity, err := getIdentity(ctx)
+	if err != nil {
+		return nil, err
+	}
+	err = checkWarehouse(ctx, w.auth, identity, &warehouse_models.WareExpenseBudget{}, uint(payload.DomainId), uint(payload.WarehouseId), authorization_iface.Update)
+	if err != nil {
+		return nil, err
+	}
+
+	var result *warehouse_models.WareExpenseBudget
+	err = w.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result, err = warehouse_mutations.SetExpenseBudget(tx, identity.UserID, &warehouse_mutations.ExpenseBudgetPayload{
+			WarehouseID:  uint(payload.WarehouseId),
+			ExpenseType:  payload.ExpenseType,
+			Month:        payload.Month,
+			Amount:       payload.Amount,
+			AlertPercent: payload.AlertPercent,
+			Enforce:      payload.Enforce,
+		})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// ExpenseBudgetReport is budget versus actual outcome of every budgeted expense type in month.
+func (w *warehouseFinImpl) ExpenseBudgetReport(ctx context.Context, query *ExpenseBudgetReportReq) (*ExpenseBudgetReportRes, error) {
+	err := w.checkRead(ctx, &warehouse_models.WareExpenseBudget{}, query.WarehouseId)
+	if err != nil {
+		return nil, err
+	}
+
+	db := w.db.WithContext(ctx)
+	warehouseID := uint(query.WarehouseId)
+
+	loc, err := warehouse_query.GetWarehouseLocation(db, warehouseID)
+	if err != nil {
+		return nil, err
+	}
+
+	month := query.Month
+	if month == "" {
+		month = warehouse_query.BudgetMonth(time.Now(), loc)
+	}
+	start, end, err := warehouse_query.BudgetMonthRange(month, loc)
+	if err != nil {
+		return nil, err
+	}
+
+	budgets := []*warehouse_models.WareExpenseBudget{}
+	err = db.
+		Model(&warehouse_models.WareExpenseBudget{}).
+		Where("warehouse_id = ?", warehouseID).
+		Where("month = ?", month).
+		Order("expense_type").
+		Find(&budgets).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	result := ExpenseBudgetReportRes{
+		WarehouseID: warehouseID,
+		Month:       month,
+		Data:        make([]*ExpenseBudgetUsage, len(budgets)),
+	}
+	if len(budgets) == 0 {
+		return &result, nil
+	}
+
+	expenseTypes := make([]warehouse_models.ExpenseType, len(budgets))
+	for i, budget := range budgets {
+		expenseTypes[i] = budget.ExpenseType
+	}
+	spent, err := warehouse_query.GetExpenseBudgetSpent(db, warehouseID, start, end, expenseTypes...)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, budget := range budgets {
+		usage := ExpenseBudgetUsage{
+			ExpenseType:  budget.ExpenseType,
+			Budget:       budget.Amount,
+			AlertPercent: budget.AlertPercent,
+			Enforce:      budget.Enforce,
+			Spent:        spent[budget.ExpenseType],
+			Remaining:    budget.Amount - spent[budget.ExpenseType],
+		}
+		if budget.Amount != 0 {
+			usage.Utilisation = usage.Spent / budget.Amount * 100
+		}
+		usage.Exceeded = usage.Spent > budget.Amount
+		usage.Alert = usage.Exceeded || (budget.AlertPercent > 0 && usage.Utilisation >= budget.AlertPercent)
+
+		result.Data[i] = &usage
+	}
+
+	return &result, nil
+}
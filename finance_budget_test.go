@@ -0,0 +1,227 @@
+package warehouse_service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pdcgo/event_source"
+	"github.com/pdcgo/shared/authorization"
+	"github.com/pdcgo/shared/db_models"
+	"github.com/pdcgo/shared/interfaces/warehouse_iface"
+	"github.com/pdcgo/shared/pkg/moretest"
+	"github.com/pdcgo/shared/pkg/moretest/moretest_mock"
+	"github.com/pdcgo/warehouse_service"
+	"github.com/pdcgo/warehouse_service/warehouse_models"
+	"github.com/pdcgo/warehouse_service/warehouse_mutations"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+func TestExpenseBudget(t *testing.T) {
+	var db gorm.DB
+
+	moretest.Suite(t, "testing expense budget",
+		moretest.SetupListFunc{
+			moretest_mock.MockSqliteDatabase(&db),
+			func(t *testing.T) func() error {
+				err := db.AutoMigrate(
+					&warehouse_models.WareExpenseAccount{},
+					&warehouse_models.WareExpenseAccountWarehouse{},
+					&warehouse_models.WareExpenseHistory{},
+					&warehouse_models.WareExpenseType{},
+					&warehouse_models.WareExpenseTypeTeam{},
+					&warehouse_models.WareExpenseStatusLog{},
+					&warehouse_models.WareExpenseRevision{},
+					&warehouse_models.WareExpenseBudget{},
+					&warehouse_models.WarehouseTimezone{},
+				)
+				assert.Nil(t, err)
+
+				err = db.Create(warehouse_models.DefaultExpenseTypes()).Error
+				assert.Nil(t, err)
+
+				account := warehouse_models.WareExpenseAccount{Name: "Kas", NumberID: "112233", CreatedAt: time.Now()}
+				err = db.Create(&account).Error
+				assert.Nil(t, err)
+
+				err = db.Create(&warehouse_models.WareExpenseAccountWarehouse{AccountID: account.ID, WarehouseID: 1}).Error
+				assert.Nil(t, err)
+
+				return nil
+			},
+		},
+		func(t *testing.T) {
+			ctx := context.WithValue(context.Background(), "identity", &authorization.JwtIdentity{
+				UserID: 5,
+				From:   db_models.WarehouseTeamType,
+			})
+
+			events := []proto.Message{}
+			sender := func(ctx context.Context, event proto.Message) (string, error) {
+				events = append(events, event)
+				return event_source.EmptySender(ctx, event)
+			}
+			service := warehouse_service.NewWarehouseFinanceService(&db, NewMockAuth(true), sender)
+
+			loc, err := time.LoadLocation(warehouse_models.DefaultWarehouseTimezone)
+			assert.Nil(t, err)
+			at := time.Date(2025, 3, 15, 10, 0, 0, 0, loc)
+
+			addExpense := func(expenseType warehouse_models.ExpenseType, amount float64) (*warehouse_iface.ExpenseHistoryAddRes, error) {
+				return service.ExpenseHistoryAdd(ctx, &warehouse_iface.ExpenseHistoryAddReq{
+					AccountId:   1,
+					WarehouseId: 1,
+					ExpenseType: string(expenseType),
+					Amount:      amount,
+					At:          timestamppb.New(at),
+				})
+			}
+
+			for _, budget := range []*warehouse_service.ExpenseBudgetSetReq{
+				{WarehouseId: 1, ExpenseType: warehouse_models.ExpenseTypeKitchen, Month: "2025-03", Amount: 100_000, AlertPercent: 80},
+				{WarehouseId: 1, ExpenseType: warehouse_models.ExpenseTypeTransport, Month: "2025-03", Amount: 10_000, Enforce: warehouse_models.BudgetEnforceReject},
+			} {
+				_, err := service.ExpenseBudgetSet(ctx, budget)
+				assert.Nil(t, err)
+			}
+
+			t.Run("test invalid budget", func(t *testing.T) {
+				_, err := service.ExpenseBudgetSet(ctx, &warehouse_service.ExpenseBudgetSetReq{
+					WarehouseId: 1, ExpenseType: warehouse_models.ExpenseTypeKitchen, Month: "03-2025", Amount: 1,
+				})
+				assert.NotNil(t, err)
+			})
+
+			t.Run("test warn", func(t *testing.T) {
+				res, err := addExpense(warehouse_models.ExpenseTypeKitchen, -50_000)
+				assert.Nil(t, err)
+				assert.Empty(t, res.Message)
+				assert.Len(t, events, 0)
+
+				res, err = addExpense(warehouse_models.ExpenseTypeKitchen, -35_000)
+				assert.Nil(t, err)
+				assert.NotEmpty(t, res.Message)
+				assert.Len(t, events, 1)
+
+				res, err = addExpense(warehouse_models.ExpenseTypeKitchen, -20_000)
+				assert.Nil(t, err)
+				assert.NotEmpty(t, res.Message)
+				assert.Len(t, events, 2)
+
+				// already over budget, no new alert
+				res, err = addExpense(warehouse_models.ExpenseTypeKitchen, -1_000)
+				assert.Nil(t, err)
+				assert.Empty(t, res.Message)
+				assert.Len(t, events, 2)
+
+				assert.Equal(t, warehouse_service.ExpenseBudgetTopic, event_source.GetTopicName(events[1]))
+				raw, err := protojson.Marshal(events[1])
+				assert.Nil(t, err)
+				assert.Contains(t, string(raw), `"level":"exceeded"`)
+				assert.Contains(t, string(raw), `"month":"2025-03"`)
+			})
+
+			t.Run("test reject", func(t *testing.T) {
+				_, err := addExpense(warehouse_models.ExpenseTypeTransport, -8_000)
+				assert.Nil(t, err)
+
+				_, err = addExpense(warehouse_models.ExpenseTypeTransport, -3_000)
+				assert.ErrorIs(t, err, warehouse_mutations.ErrExpenseBudgetExceeded)
+
+				// positive amount on outcome type is correction, reducing spent
+				_, err = addExpense(warehouse_models.ExpenseTypeTransport, 3_000)
+				assert.Nil(t, err)
+
+				_, err = addExpense(warehouse_models.ExpenseTypeTransport, -3_000)
+				assert.Nil(t, err)
+			})
+
+			t.Run("test edit", func(t *testing.T) {
+				april := time.Date(2025, 4, 10, 10, 0, 0, 0, loc)
+				_, err := service.ExpenseBudgetSet(ctx, &warehouse_service.ExpenseBudgetSetReq{
+					WarehouseId: 1, ExpenseType: warehouse_models.ExpenseTypeKitchen, Month: "2025-04", Amount: 10_000, AlertPercent: 50, Enforce: warehouse_models.BudgetEnforceReject,
+				})
+				assert.Nil(t, err)
+
+				_, err = service.ExpenseHistoryAdd(ctx, &warehouse_iface.ExpenseHistoryAddReq{
+					AccountId:   1,
+					WarehouseId: 1,
+					ExpenseType: string(warehouse_models.ExpenseTypeKitchen),
+					Amount:      -4_000,
+					At:          timestamppb.New(april),
+				})
+				assert.Nil(t, err)
+
+				expense := warehouse_models.WareExpenseHistory{}
+				err = db.Order("id desc").First(&expense).Error
+				assert.Nil(t, err)
+
+				edit := func(amount float64) (*warehouse_iface.ExpenseHistoryEditRes, error) {
+					return service.ExpenseHistoryEdit(ctx, &warehouse_iface.ExpenseHistoryEditReq{
+						HistId:      uint64(expense.ID),
+						AccountId:   1,
+						WarehouseId: 1,
+						ExpenseType: string(warehouse_models.ExpenseTypeKitchen),
+						Amount:      amount,
+						At:          timestamppb.New(april),
+					})
+				}
+
+				sent := len(events)
+
+				// only difference to saved amount is added to spent
+				res, err := edit(-6_000)
+				assert.Nil(t, err)
+				assert.NotEmpty(t, res.Message)
+				assert.Len(t, events, sent+1)
+
+				res, err = edit(-6_000)
+				assert.Nil(t, err)
+				assert.Empty(t, res.Message)
+				assert.Len(t, events, sent+1)
+
+				_, err = edit(-12_000)
+				assert.ErrorIs(t, err, warehouse_mutations.ErrExpenseBudgetExceeded)
+
+				saved := warehouse_models.WareExpenseHistory{}
+				err = db.First(&saved, expense.ID).Error
+				assert.Nil(t, err)
+				assert.Equal(t, float64(-6_000), saved.Amount)
+			})
+
+			t.Run("test report", func(t *testing.T) {
+				result, err := service.ExpenseBudgetReport(ctx, &warehouse_service.ExpenseBudgetReportReq{
+					WarehouseId: 1,
+					Month:       "2025-03",
+				})
+				assert.Nil(t, err)
+				assert.Len(t, result.Data, 2)
+
+				kitchen := result.Data[0]
+				assert.Equal(t, warehouse_models.ExpenseTypeKitchen, kitchen.ExpenseType)
+				assert.Equal(t, float64(106_000), kitchen.Spent)
+				assert.Equal(t, float64(-6_000), kitchen.Remaining)
+				assert.True(t, kitchen.Exceeded)
+
+				transport := result.Data[1]
+				assert.Equal(t, float64(8_000), transport.Spent)
+				assert.Equal(t, float64(80), transport.Utilisation)
+				assert.False(t, transport.Alert)
+			})
+
+			t.Run("test remove budget", func(t *testing.T) {
+				_, err := service.ExpenseBudgetSet(ctx, &warehouse_service.ExpenseBudgetSetReq{
+					WarehouseId: 1, ExpenseType: warehouse_models.ExpenseTypeTransport, Month: "2025-03",
+				})
+				assert.Nil(t, err)
+
+				_, err = addExpense(warehouse_models.ExpenseTypeTransport, -3_000)
+				assert.Nil(t, err)
+			})
+		},
+	)
+}
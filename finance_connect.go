@@ -0,0 +1,66 @@
+package warehouse_service
+
+import (
+	"context"
+	"net/http"
+
+	"connectrpc.com/connect"
+	"github.com/pdcgo/shared/interfaces/authorization_iface"
+	"github.com/pdcgo/shared/interfaces/warehouse_iface"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// WarehouseFinanceServiceName is same service name as legacy grpc, so legacy client keep working on grpc protocol.
+const WarehouseFinanceServiceName = "warehouse_iface.WarehouseFinanceService"
+
+// NewWarehouseFinanceServiceHandler is connect handler of legacy finance rpc, same as generated handler.
+// identity is read from header with legacy authorization, permission is checked in service method.
+func NewWarehouseFinanceServiceHandler(
+	auth authorization_iface.Authorization,
+	service WarehouseFinanceService,
+	opts ...connect.HandlerOption,
+) (string, http.Handler) {
+	serviceDesc := warehouse_iface.File_proto_warehouse_service_warehouse_proto.Services().ByName("WarehouseFinanceService")
+
+	mux := http.NewServeMux()
+	handle := func(method string, handler func(desc protoreflect.MethodDescriptor, opts ...connect.HandlerOption) *connect.Handler) {
+		desc := serviceDesc.Methods().ByName(protoreflect.Name(method))
+		mux.Handle("/"+WarehouseFinanceServiceName+"/"+method, handler(desc, opts...))
+	}
+
+	handle("ExpenseAccountGet", financeUnary(auth, service.ExpenseAccountGet))
+	handle("ExpenseAccountCreate", financeUnary(auth, service.ExpenseAccountCreate))
+	handle("ExpenseAccountEdit", financeUnary(auth, service.ExpenseAccountEdit))
+	handle("ExpenseAccountList", financeUnary(auth, service.ExpenseAccountList))
+	handle("ExpenseHistoryAdd", financeUnary(auth, service.ExpenseHistoryAdd))
+	handle("ExpenseHistoryEdit", financeUnary(auth, service.ExpenseHistoryEdit))
+	handle("ExpenseHistoryList", financeUnary(auth, service.ExpenseHistoryList))
+	handle("ExpenseReportDaily", financeUnary(auth, service.ExpenseReportDaily))
+
+	return "/" + WarehouseFinanceServiceName + "/", mux
+}
+
+func financeUnary[Req, Res any](
+	auth authorization_iface.Authorization,
+	call func(ctx context.Context, payload *Req) (*Res, error),
+) func(desc protoreflect.MethodDescriptor, opts ...connect.HandlerOption) *connect.Handler {
+	return func(desc protoreflect.MethodDescriptor, opts ...connect.HandlerOption) *connect.Handler {
+		return connect.NewUnaryHandler(
+			"/"+string(desc.Parent().FullName())+"/"+string(desc.Name()),
+			func(ctx context.Context, req *connect.Request[Req]) (*connect.Response[Res], error) {
+				ctx, err := legacyIdentityContext(ctx, auth, req.Header())
+				if err != nil {
+					return nil, err
+				}
+
+				res, err := call(ctx, req.Msg)
+				if err != nil {
+					return nil, financeConnectErr(err)
+				}
+				return connect.NewResponse(res), nil
+			},
+			connect.WithSchema(desc),
+			connect.WithHandlerOptions(opts...),
+		)
+	}
+}
@@ -0,0 +1,196 @@
+package warehouse_service_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/pdcgo/event_source"
+	"github.com/pdcgo/shared/interfaces/warehouse_iface"
+	"github.com/pdcgo/shared/pkg/moretest"
+	"github.com/pdcgo/shared/pkg/moretest/moretest_mock"
+	"github.com/pdcgo/warehouse_service"
+	warehouse_service_iface "github.com/pdcgo/warehouse_service/services/warehouse_service/v1"
+	"github.com/pdcgo/warehouse_service/services/warehouse_service/v1/warehouse_service_ifaceconnect"
+	"github.com/pdcgo/warehouse_service/warehouse_models"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+func TestFinanceConnectHandler(t *testing.T) {
+	var db gorm.DB
+
+	moretest.Suite(t, "testing finance connect handler",
+		moretest.SetupListFunc{
+			moretest_mock.MockSqliteDatabase(&db),
+			func(t *testing.T) func() error {
+				err := db.AutoMigrate(
+					&warehouse_models.WareExpenseAccount{},
+					&warehouse_models.WareExpenseAccountWarehouse{},
+					&warehouse_models.WareExpenseHistory{},
+					&warehouse_models.WareExpenseType{},
+					&warehouse_models.WareExpenseTypeTeam{},
+					&warehouse_models.WareExpenseBudget{},
+					&warehouse_models.WareExpenseStatusLog{},
+					&warehouse_models.WarehouseTimezone{},
+				)
+				assert.Nil(t, err)
+
+				err = db.Create(warehouse_models.DefaultExpenseTypes()).Error
+				assert.Nil(t, err)
+
+				err = db.Create(&warehouse_models.WareExpenseAccount{ID: 1, Name: "Kas", NumberID: "100200", CreatedAt: time.Now()}).Error
+				assert.Nil(t, err)
+				err = db.Create(&warehouse_models.WareExpenseAccountWarehouse{AccountID: 1, WarehouseID: 2}).Error
+				assert.Nil(t, err)
+
+				return nil
+			},
+		},
+		func(t *testing.T) {
+			// caller only has role in warehouse 2
+			auth := NewMockDomainAuth(2)
+			service := warehouse_service.NewWarehouseFinanceService(&db, auth, event_source.EmptySender)
+			mux := http.NewServeMux()
+			mux.Handle(warehouse_service.NewWarehouseFinanceServiceHandler(auth, service))
+			mux.Handle(warehouse_service.NewWarehouseFinanceExtServiceHandler(auth, service))
+			server := httptest.NewServer(mux)
+			defer server.Close()
+
+			procedure := func(method string) string {
+				return server.URL + "/" + warehouse_service.WarehouseFinanceServiceName + "/" + method
+			}
+			withToken := func(req connect.AnyRequest) {
+				req.Header().Set("Authorization", "Bearer token")
+			}
+
+			accountList := connect.NewClient[warehouse_iface.ExpenseAccountListReq, warehouse_iface.ExpenseAccountListRes](
+				server.Client(), procedure("ExpenseAccountList"),
+			)
+
+			t.Run("test without token", func(t *testing.T) {
+				req := connect.NewRequest(&warehouse_iface.ExpenseAccountListReq{WarehouseId: 2})
+				_, err := accountList.CallUnary(context.Background(), req)
+				assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+			})
+
+			t.Run("test list own warehouse", func(t *testing.T) {
+				req := connect.NewRequest(&warehouse_iface.ExpenseAccountListReq{WarehouseId: 2})
+				withToken(req)
+				res, err := accountList.CallUnary(context.Background(), req)
+				assert.Nil(t, err)
+				assert.Len(t, res.Msg.Data, 1)
+			})
+
+			t.Run("test list other warehouse", func(t *testing.T) {
+				req := connect.NewRequest(&warehouse_iface.ExpenseAccountListReq{WarehouseId: 3})
+				withToken(req)
+				_, err := accountList.CallUnary(context.Background(), req)
+				assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+			})
+
+			t.Run("test report daily other warehouse", func(t *testing.T) {
+				client := connect.NewClient[warehouse_iface.ExpenseReportDailyReq, warehouse_iface.ExpenseReportDailyRes](
+					server.Client(), procedure("ExpenseReportDaily"),
+				)
+				req := connect.NewRequest(&warehouse_iface.ExpenseReportDailyReq{WarehouseId: 3})
+				withToken(req)
+				_, err := client.CallUnary(context.Background(), req)
+				assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+			})
+
+			t.Run("test add expense", func(t *testing.T) {
+				client := connect.NewClient[warehouse_iface.ExpenseHistoryAddReq, warehouse_iface.ExpenseHistoryAddRes](
+					server.Client(), procedure("ExpenseHistoryAdd"),
+				)
+				req := connect.NewRequest(&warehouse_iface.ExpenseHistoryAddReq{
+					AccountId:   1,
+					WarehouseId: 2,
+					ExpenseType: string(warehouse_models.ExpenseTypeKitchen),
+					Amount:      -20_000,
+					At:          timestamppb.Now(),
+				})
+				withToken(req)
+				_, err := client.CallUnary(context.Background(), req)
+				assert.Nil(t, err)
+
+				expense := warehouse_models.WareExpenseHistory{}
+				err = db.First(&expense).Error
+				assert.Nil(t, err)
+				assert.Equal(t, uint(1), expense.CreatedByID)
+				assert.Equal(t, float64(-20_000), expense.Amount)
+			})
+
+			t.Run("test ext service", func(t *testing.T) {
+				client := warehouse_service_ifaceconnect.NewWarehouseFinanceExtServiceClient(server.Client(), server.URL)
+
+				t.Run("test history page", func(t *testing.T) {
+					req := connect.NewRequest(&warehouse_service_iface.ExpenseHistoryPageRequest{
+						WarehouseId: 2,
+						Page: &warehouse_service_iface.ExpenseListPage{
+							SortBy: string(warehouse_service.ExpenseSortAmount),
+						},
+					})
+					withToken(req)
+					res, err := client.ExpenseHistoryPage(context.Background(), req)
+					assert.Nil(t, err)
+					assert.Len(t, res.Msg.Data, 1)
+					assert.Equal(t, float64(-20_000), res.Msg.Data[0].Amount)
+
+					req = connect.NewRequest(&warehouse_service_iface.ExpenseHistoryPageRequest{WarehouseId: 3})
+					withToken(req)
+					_, err = client.ExpenseHistoryPage(context.Background(), req)
+					assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+				})
+
+				t.Run("test without token", func(t *testing.T) {
+					req := connect.NewRequest(&warehouse_service_iface.ExpenseHistoryPageRequest{WarehouseId: 2})
+					_, err := client.ExpenseHistoryPage(context.Background(), req)
+					assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+				})
+
+				t.Run("test budget set", func(t *testing.T) {
+					req := connect.NewRequest(&warehouse_service_iface.ExpenseBudgetSetRequest{
+						DomainId:    2,
+						WarehouseId: 2,
+						ExpenseType: string(warehouse_models.ExpenseTypeKitchen),
+						Month:       "2025-03",
+						Amount:      100_000,
+					})
+					withToken(req)
+					res, err := client.ExpenseBudgetSet(context.Background(), req)
+					assert.Nil(t, err)
+					assert.Equal(t, "2025-03", res.Msg.Data.Month)
+
+					// domain is other than warehouse of budget
+					req.Msg.DomainId = 3
+					_, err = client.ExpenseBudgetSet(context.Background(), req)
+					assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+				})
+
+				t.Run("test expense type create from warehouse team", func(t *testing.T) {
+					req := connect.NewRequest(&warehouse_service_iface.ExpenseTypeCreateRequest{
+						DomainId: 2,
+						Data: &warehouse_service_iface.ExpenseType{
+							Key:  "laundry",
+							Name: "Laundry",
+							Flow: string(warehouse_models.ExpenseFlowOutcome),
+						},
+					})
+					withToken(req)
+					_, err := client.ExpenseTypeCreate(context.Background(), req)
+					assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+				})
+			})
+
+			t.Run("test legacy call without identity", func(t *testing.T) {
+				_, err := service.ExpenseHistoryList(context.Background(), &warehouse_iface.ExpenseHistoryListReq{WarehouseId: 2})
+				assert.ErrorIs(t, err, warehouse_service.ErrIdentityNotFound)
+			})
+		},
+	)
+}
@@ -0,0 +1,146 @@
+package warehouse_service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/pdcgo/shared/authorization"
+	"github.com/pdcgo/shared/db_models"
+	"github.com/pdcgo/shared/interfaces/authorization_iface"
+	"github.com/pdcgo/warehouse_service/warehouse_models"
+	"github.com/pdcgo/warehouse_service/warehouse_mutations"
+	"github.com/pdcgo/warehouse_service/warehouse_query"
+	"gorm.io/gorm"
+)
+
+// ExpenseTypeListReq is listing type usable at warehouse by team, zero WarehouseId and empty TeamType is not filtering.
+type ExpenseTypeListReq struct {
+	WarehouseId     uint64             `json:"warehouse_id"`
+	TeamType        db_models.TeamType `json:"team_type"`
+	IncludeDisabled bool               `json:"include_disabled"`
+}
+
+type ExpenseTypeListRes struct {
+	Data []*warehouse_models.WareExpenseType `json:"data"`
+}
+
+// ExpenseTypeSaveReq is creating or updating expense type. zero WarehouseId is type for all warehouse,
+// TeamTypes is replacing team allowed using the type.
+type ExpenseTypeSaveReq struct {
+	DomainId    uint64                       `json:"domain_id"`
+	Key         warehouse_models.ExpenseType `json:"key"`
+	Name        string                       `json:"name"`
+	Flow        warehouse_models.ExpenseFlow `json:"flow"`
+	AdminOnly   bool                         `json:"admin_only"`
+	WarehouseId uint64                       `json:"warehouse_id"`
+	Disabled    bool                         `json:"disabled"`
+	TeamTypes   []db_models.TeamType         `json:"team_types"`
+}
+
+func (req *ExpenseTypeSaveReq) payload() *warehouse_mutations.ExpenseTypePayload {
+	return &warehouse_mutations.ExpenseTypePayload{
+		Name:        req.Name,
+		Flow:        req.Flow,
+		AdminOnly:   req.AdminOnly,
+		WarehouseID: uint(req.WarehouseId),
+		Disabled:    req.Disabled,
+		TeamTypes:   req.TeamTypes,
+	}
+}
+
+var ErrExpenseTypeNeedAdmin = errors.New("need admin permission for managing expense type")
+
+func (w *warehouseFinImpl) checkExpenseTypeAdmin(ctx context.Context, domainID uint64, action authorization_iface.Action) (*authorization.JwtIdentity, error) {
+	identity, err := getIdentity(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if identity.From != db_models.AdminTeamType {
+		return nil, ErrExpenseTypeNeedAdmin
+	}
+
+	err = w.hasPermission(ctx, identity, authorization_iface.CheckPermissionGroup{
+		&warehouse_models.WareExpenseType{}: &authorization_iface.CheckPermission{
+			DomainID: uint(domainID),
+			Actions:  []authorization_iface.Action{action},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return identity, nil
+}
+
+func (w *warehouseFinImpl) ExpenseTypeList(ctx context.Context, query *ExpenseTypeListReq) (*ExpenseTypeListRes, error) {
+	err := w.checkRead(ctx, &warehouse_models.WareExpenseType{}, query.WarehouseId)
+	if err != nil {
+		return nil, err
+	}
+
+	typeQuery := warehouse_query.
+		NewExpenseTypeQuery(w.db.WithContext(ctx)).
+		AvailableAt(uint(query.WarehouseId)).
+		ForTeam(query.TeamType)
+	if !query.IncludeDisabled {
+		typeQuery = typeQuery.IsDisabled(false)
+	}
+
+	result := ExpenseTypeListRes{
+		Data: []*warehouse_models.WareExpenseType{},
+	}
+	err = typeQuery.
+		GetQuery().
+		Preload("Teams").
+		Order("ware_expense_types.key").
+		Find(&result.Data).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// ExpenseTypeCreate adding new expense type, only from admin team.
+func (w *warehouseFinImpl) ExpenseTypeCreate(ctx context.Context, payload *ExpenseTypeSaveReq) (*warehouse_models.WareExpenseType, error) {
+	_, err := w.checkExpenseTypeAdmin(ctx, payload.DomainId, authorization_iface.Create)
+	if err != nil {
+		return nil, err
+	}
+
+	var result *warehouse_models.WareExpenseType
+	err = w.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result, err = warehouse_mutations.
+			NewExpenseTypeMutation(tx).
+			Create(payload.Key, payload.payload())
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// ExpenseTypeUpdate changing expense type, only from admin team. existing expense history keep its type
+// even when type is disabled.
+func (w *warehouseFinImpl) ExpenseTypeUpdate(ctx context.Context, payload *ExpenseTypeSaveReq) (*warehouse_models.WareExpenseType, error) {
+	_, err := w.checkExpenseTypeAdmin(ctx, payload.DomainId, authorization_iface.Update)
+	if err != nil {
+		return nil, err
+	}
+
+	var result *warehouse_models.WareExpenseType
+	err = w.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result, err = warehouse_mutations.
+			NewExpenseTypeMutation(tx).
+			Update(payload.Key, payload.payload())
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
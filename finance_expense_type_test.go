@@ -0,0 +1,156 @@
+package warehouse_service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pdcgo/event_source"
+	"github.com/pdcgo/shared/authorization"
+	"github.com/pdcgo/shared/db_models"
+	"github.com/pdcgo/shared/interfaces/warehouse_iface"
+	"github.com/pdcgo/shared/pkg/moretest"
+	"github.com/pdcgo/shared/pkg/moretest/moretest_mock"
+	"github.com/pdcgo/warehouse_service"
+	"github.com/pdcgo/warehouse_service/warehouse_models"
+	"github.com/pdcgo/warehouse_service/warehouse_mutations"
+	"github.com/pdcgo/warehouse_service/warehouse_query"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+func TestExpenseType(t *testing.T) {
+	var db gorm.DB
+
+	moretest.Suite(t, "testing expense type",
+		moretest.SetupListFunc{
+			moretest_mock.MockSqliteDatabase(&db),
+			func(t *testing.T) func() error {
+				err := db.AutoMigrate(
+					&warehouse_models.WareExpenseAccount{},
+					&warehouse_models.WareExpenseAccountWarehouse{},
+					&warehouse_models.WareExpenseHistory{},
+					&warehouse_models.WareExpenseType{},
+					&warehouse_models.WareExpenseTypeTeam{},
+					&warehouse_models.WareExpenseBudget{},
+					&warehouse_models.WareExpenseStatusLog{},
+					&warehouse_models.WareExpenseRevision{},
+					&warehouse_models.WarehouseTimezone{},
+				)
+				assert.Nil(t, err)
+
+				err = db.Create(warehouse_models.DefaultExpenseTypes()).Error
+				assert.Nil(t, err)
+
+				account := warehouse_models.WareExpenseAccount{Name: "Kas", NumberID: "778899", CreatedAt: time.Now()}
+				err = db.Create(&account).Error
+				assert.Nil(t, err)
+
+				err = db.Create(&[]*warehouse_models.WareExpenseAccountWarehouse{
+					{AccountID: account.ID, WarehouseID: 1},
+					{AccountID: account.ID, WarehouseID: 2},
+				}).Error
+				assert.Nil(t, err)
+
+				return nil
+			},
+		},
+		func(t *testing.T) {
+			whCtx := context.WithValue(context.Background(), "identity", &authorization.JwtIdentity{
+				UserID: 5,
+				From:   db_models.WarehouseTeamType,
+			})
+			adminCtx := context.WithValue(context.Background(), "identity", &authorization.JwtIdentity{
+				UserID: 1,
+				From:   db_models.AdminTeamType,
+			})
+			service := warehouse_service.NewWarehouseFinanceService(&db, NewMockAuth(true), event_source.EmptySender)
+
+			addExpense := func(warehouseID uint64, expenseType warehouse_models.ExpenseType) error {
+				_, err := service.ExpenseHistoryAdd(whCtx, &warehouse_iface.ExpenseHistoryAddReq{
+					AccountId:   1,
+					WarehouseId: warehouseID,
+					ExpenseType: string(expenseType),
+					Amount:      -10_000,
+					At:          timestamppb.Now(),
+				})
+				return err
+			}
+
+			t.Run("test create from warehouse", func(t *testing.T) {
+				_, err := service.ExpenseTypeCreate(whCtx, &warehouse_service.ExpenseTypeSaveReq{
+					Key:  "laundry",
+					Name: "Laundry",
+					Flow: warehouse_models.ExpenseFlowOutcome,
+				})
+				assert.ErrorIs(t, err, warehouse_service.ErrExpenseTypeNeedAdmin)
+			})
+
+			t.Run("test create for one warehouse", func(t *testing.T) {
+				result, err := service.ExpenseTypeCreate(adminCtx, &warehouse_service.ExpenseTypeSaveReq{
+					Key:         "laundry",
+					Name:        "Laundry",
+					Flow:        warehouse_models.ExpenseFlowOutcome,
+					WarehouseId: 1,
+					TeamTypes:   []db_models.TeamType{db_models.WarehouseTeamType, db_models.WarehouseTeamType},
+				})
+				assert.Nil(t, err)
+				assert.Len(t, result.Teams, 1)
+
+				assert.Nil(t, addExpense(1, "laundry"))
+				assert.ErrorIs(t, addExpense(2, "laundry"), warehouse_mutations.ErrExpenseTypeNotAllowed)
+
+				_, err = service.ExpenseTypeCreate(adminCtx, &warehouse_service.ExpenseTypeSaveReq{
+					Key:  "laundry",
+					Name: "Laundry",
+					Flow: warehouse_models.ExpenseFlowOutcome,
+				})
+				assert.ErrorIs(t, err, warehouse_mutations.ErrExpenseTypeExist)
+
+				_, err = service.ExpenseTypeCreate(adminCtx, &warehouse_service.ExpenseTypeSaveReq{
+					Key:  "Laundry Room",
+					Name: "Laundry",
+					Flow: warehouse_models.ExpenseFlowOutcome,
+				})
+				assert.ErrorIs(t, err, warehouse_mutations.ErrExpenseTypeKeyInvalid)
+			})
+
+			t.Run("test list", func(t *testing.T) {
+				result, err := service.ExpenseTypeList(whCtx, &warehouse_service.ExpenseTypeListReq{
+					WarehouseId: 2,
+					TeamType:    db_models.WarehouseTeamType,
+				})
+				assert.Nil(t, err)
+				for _, item := range result.Data {
+					assert.NotEqual(t, warehouse_models.ExpenseType("laundry"), item.Key)
+					assert.NotEqual(t, warehouse_models.ExpenseTypeBank, item.Key)
+				}
+
+				all, err := service.ExpenseTypeList(adminCtx, &warehouse_service.ExpenseTypeListReq{IncludeDisabled: true})
+				assert.Nil(t, err)
+				assert.Len(t, all.Data, len(result.Data)+4)
+			})
+
+			t.Run("test disable and team", func(t *testing.T) {
+				_, err := service.ExpenseTypeUpdate(adminCtx, &warehouse_service.ExpenseTypeSaveReq{
+					Key:      "laundry",
+					Name:     "Laundry",
+					Flow:     warehouse_models.ExpenseFlowOutcome,
+					Disabled: true,
+				})
+				assert.Nil(t, err)
+				assert.ErrorIs(t, addExpense(1, "laundry"), warehouse_query.ErrExpenseTypeNotFound)
+
+				_, err = service.ExpenseTypeUpdate(adminCtx, &warehouse_service.ExpenseTypeSaveReq{
+					Key:       "laundry",
+					Name:      "Laundry",
+					Flow:      warehouse_models.ExpenseFlowOutcome,
+					TeamTypes: []db_models.TeamType{db_models.SellingTeamType},
+				})
+				assert.Nil(t, err)
+				assert.ErrorIs(t, addExpense(2, "laundry"), warehouse_mutations.ErrExpenseTypeNotAllowed)
+			})
+		},
+	)
+}
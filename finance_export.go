@@ -0,0 +1,316 @@
+package warehouse_service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/pdcgo/shared/authorization"
+	"github.com/pdcgo/shared/interfaces/authorization_iface"
+	"github.com/pdcgo/shared/interfaces/warehouse_iface"
+	"github.com/pdcgo/warehouse_service/table_export"
+	"github.com/pdcgo/warehouse_service/warehouse_models"
+	"github.com/pdcgo/warehouse_service/warehouse_query"
+	"gorm.io/gorm"
+)
+
+// ExpenseHistoryExportReq is same filter as ExpenseHistoryPage, empty Format is csv.
+type ExpenseHistoryExportReq struct {
+	*ExpenseHistoryQuery
+	Format table_export.Format `json:"format"`
+}
+
+// BalanceHistoryExportReq is same filter as BalanceHistoryList, Page is ignored.
+type BalanceHistoryExportReq struct {
+	*BalanceHistoryListReq
+	Format table_export.Format `json:"format"`
+}
+
+type expenseExportRow struct {
+	warehouse_models.WareExpenseHistory
+	IsOpsAccount    bool
+	AccountName     string
+	AccountNumberID string
+}
+
+type balanceExportRow struct {
+	warehouse_models.WareBalanceAccountHistory
+	AccountName     string
+	AccountNumberID string
+}
+
+var ErrExportFilterInvalid = errors.New("export filter invalid")
+
+// exportRows is streaming query row to writer one by one, time is written in warehouse timezone.
+// query is run before anything written, so error of query or format is returned with empty out.
+func exportRows[T any](db *gorm.DB, sqlQuery *gorm.DB, format table_export.Format, out io.Writer, sheetName string, header []string, cells func(row *T) []any) error {
+	rows, err := sqlQuery.Rows()
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	writer, err := table_export.NewWriter(format, out, sheetName)
+	if err != nil {
+		return err
+	}
+	err = writer.WriteHeader(header...)
+	if err != nil {
+		return err
+	}
+
+	for rows.Next() {
+		var row T
+		err = db.ScanRows(rows, &row)
+		if err != nil {
+			return err
+		}
+
+		err = writer.WriteRow(cells(&row)...)
+		if err != nil {
+			return err
+		}
+	}
+	err = rows.Err()
+	if err != nil {
+		return err
+	}
+
+	return writer.Close()
+}
+
+// ExpenseHistoryExport writing expense history with account name and number id as csv or xlsx, oldest at first.
+func (w *warehouseFinImpl) ExpenseHistoryExport(ctx context.Context, query *ExpenseHistoryExportReq, out io.Writer) error {
+	err := w.checkRead(ctx, &warehouse_models.WareExpenseHistory{}, query.WarehouseId)
+	if err != nil {
+		return err
+	}
+	if query.TimeType != "" && !slices.Contains(query.TimeType.EnumList(), string(query.TimeType)) {
+		return fmt.Errorf("%w: time_type %s", ErrExportFilterInvalid, query.TimeType)
+	}
+	if query.Status != "" && !slices.Contains(query.Status.EnumList(), string(query.Status)) {
+		return fmt.Errorf("%w: status %s", ErrExportFilterInvalid, query.Status)
+	}
+
+	db := w.db.WithContext(ctx)
+	sqlQuery, err := expenseHistorySqlQuery(db, query.ExpenseHistoryQuery)
+	if err != nil {
+		return err
+	}
+	loc, err := warehouse_query.GetWarehouseLocation(db, uint(query.WarehouseId))
+	if err != nil {
+		return err
+	}
+
+	sqlQuery = sqlQuery.
+		Joins("LEFT JOIN ware_expense_accounts ON ware_expense_accounts.id = ware_expense_histories.account_id").
+		Select([]string{
+			"ware_expense_histories.*",
+			"ware_expense_account_warehouses.is_ops_account",
+			"ware_expense_accounts.name as account_name",
+			"ware_expense_accounts.number_id as account_number_id",
+		}).
+		Order("ware_expense_histories.at").
+		Order("ware_expense_histories.id")
+
+	header := []string{
+		"id", "at", "warehouse_id", "account_id", "account_name", "account_number_id", "is_ops_account",
+		"expense_type", "status", "amount", "note", "created_by_id", "created_at", "deleted",
+	}
+	return exportRows(db, sqlQuery, query.Format, out, "expense_history", header, func(row *expenseExportRow) []any {
+		return []any{
+			row.ID,
+			row.At.In(loc),
+			row.WarehouseID,
+			row.AccountID,
+			row.AccountName,
+			row.AccountNumberID,
+			row.IsOpsAccount,
+			string(row.ExpenseType),
+			string(row.Status),
+			row.Amount,
+			row.Note,
+			row.CreatedByID,
+			row.CreatedAt.In(loc),
+			row.Deleted,
+		}
+	})
+}
+
+// BalanceHistoryExport writing balance history with account name and number id as csv or xlsx, oldest at first.
+func (w *warehouseFinImpl) BalanceHistoryExport(ctx context.Context, query *BalanceHistoryExportReq, out io.Writer) error {
+	err := w.checkRead(ctx, &warehouse_models.WareExpenseAccount{}, query.WarehouseId)
+	if err != nil {
+		return err
+	}
+
+	db := w.db.WithContext(ctx)
+	sqlQuery, err := balanceHistorySqlQuery(db, query.BalanceHistoryListReq)
+	if err != nil {
+		return err
+	}
+	loc, err := warehouse_query.GetWarehouseLocation(db, uint(query.WarehouseId))
+	if err != nil {
+		return err
+	}
+
+	sqlQuery = sqlQuery.
+		Joins("LEFT JOIN ware_expense_accounts ON ware_expense_accounts.id = ware_balance_account_histories.account_id").
+		Select([]string{
+			"ware_balance_account_histories.*",
+			"ware_expense_accounts.name as account_name",
+			"ware_expense_accounts.number_id as account_number_id",
+		}).
+		Order("ware_balance_account_histories.at").
+		Order("ware_balance_account_histories.id")
+
+	header := []string{
+		"id", "at", "warehouse_id", "account_id", "account_name", "account_number_id", "amount", "created_by_id", "created_at",
+	}
+	return exportRows(db, sqlQuery, query.Format, out, "balance_history", header, func(row *balanceExportRow) []any {
+		return []any{
+			row.ID,
+			row.At.In(loc),
+			row.WarehouseID,
+			row.AccountID,
+			row.AccountName,
+			row.AccountNumberID,
+			row.Amount,
+			row.CreatedByID,
+			row.CreatedAt.In(loc),
+		}
+	})
+}
+
+type FinanceExportHttpHandler http.Handler
+
+// exportResponse is setting download header on first write, error before any row is still sent as http error.
+type exportResponse struct {
+	http.ResponseWriter
+	header  func(header http.Header)
+	written bool
+}
+
+func (res *exportResponse) Write(data []byte) (int, error) {
+	if !res.written {
+		res.written = true
+		res.header(res.Header())
+	}
+	return res.ResponseWriter.Write(data)
+}
+
+func exportErrStatus(err error) int {
+	switch {
+	case errors.Is(err, ErrIdentityNotFound),
+		errors.Is(err, authorization.ErrPermission),
+		errors.Is(err, ErrWarehouseMismatch):
+		return http.StatusForbidden
+	case errors.Is(err, ErrExportFilterInvalid),
+		errors.Is(err, table_export.ErrFormatNotSupported):
+		return http.StatusBadRequest
+	}
+	return http.StatusInternalServerError
+}
+
+// NewFinanceExportHttpHandler serving expense history and balance history download. filter is query parameter
+// with same name as list request json field, plus format csv or xlsx.
+func NewFinanceExportHttpHandler(auth authorization_iface.Authorization, service WarehouseFinanceService) FinanceExportHttpHandler {
+	mux := http.NewServeMux()
+
+	identityContext := func(r *http.Request) (context.Context, error) {
+		identity := auth.AuthIdentityFromHeader(r.Header)
+		err := identity.Err()
+		if err != nil {
+			return nil, err
+		}
+
+		return context.WithValue(r.Context(), "identity", identity.Identity()), nil
+	}
+
+	uintParam := func(r *http.Request, name string) uint64 {
+		val, _ := strconv.ParseUint(r.URL.Query().Get(name), 10, 64)
+		return val
+	}
+	intParam := func(r *http.Request, name string) int64 {
+		val, _ := strconv.ParseInt(r.URL.Query().Get(name), 10, 64)
+		return val
+	}
+
+	serve := func(w http.ResponseWriter, r *http.Request, name string, export func(ctx context.Context, format table_export.Format, out io.Writer) error) {
+		ctx, err := identityContext(r)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusForbidden)
+			return
+		}
+
+		format := table_export.Format(r.URL.Query().Get("format"))
+		if format == "" {
+			format = table_export.FormatCSV
+		}
+
+		res := &exportResponse{
+			ResponseWriter: w,
+			header: func(header http.Header) {
+				header.Set("Content-Type", format.ContentType())
+				header.Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s_%s.%s"`, name, time.Now().Format("20060102150405"), format))
+			},
+		}
+		err = export(ctx, format, res)
+		if err == nil {
+			return
+		}
+		if res.written {
+			// status already sent, client get truncated file
+			slog.Error("finance export failed", "export", name, "err", err.Error())
+			return
+		}
+		http.Error(w, err.Error(), exportErrStatus(err))
+	}
+
+	mux.HandleFunc("GET /finance_exports/expense_histories", func(w http.ResponseWriter, r *http.Request) {
+		serve(w, r, "expense_history", func(ctx context.Context, format table_export.Format, out io.Writer) error {
+			isOpsAccount, _ := strconv.ParseBool(r.URL.Query().Get("is_ops_account"))
+			includeDeleted, _ := strconv.ParseBool(r.URL.Query().Get("include_deleted"))
+			return service.ExpenseHistoryExport(ctx, &ExpenseHistoryExportReq{
+				ExpenseHistoryQuery: &ExpenseHistoryQuery{
+					ExpenseHistoryListReq: &warehouse_iface.ExpenseHistoryListReq{
+						WarehouseId:  uintParam(r, "warehouse_id"),
+						AccountId:    uintParam(r, "account_id"),
+						IsOpsAccount: isOpsAccount,
+						ExpenseType:  r.URL.Query().Get("expense_type"),
+						StartDate:    intParam(r, "start_date"),
+						EndDate:      intParam(r, "end_date"),
+					},
+					TimeType:       warehouse_query.WareExpenseTimeType(r.URL.Query().Get("time_type")),
+					Status:         warehouse_models.ExpenseStatus(r.URL.Query().Get("status")),
+					IncludeDeleted: includeDeleted,
+				},
+				Format: format,
+			}, out)
+		})
+	})
+
+	mux.HandleFunc("GET /finance_exports/balance_histories", func(w http.ResponseWriter, r *http.Request) {
+		serve(w, r, "balance_history", func(ctx context.Context, format table_export.Format, out io.Writer) error {
+			return service.BalanceHistoryExport(ctx, &BalanceHistoryExportReq{
+				BalanceHistoryListReq: &BalanceHistoryListReq{
+					WarehouseId: uintParam(r, "warehouse_id"),
+					AccountId:   uintParam(r, "account_id"),
+					CreatedById: uintParam(r, "created_by_id"),
+					At:          intParam(r, "at"),
+					StartDate:   intParam(r, "start_date"),
+					EndDate:     intParam(r, "end_date"),
+				},
+				Format: format,
+			}, out)
+		})
+	})
+
+	return mux
+}
@@ -0,0 +1,186 @@
+package warehouse_service_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/pdcgo/event_source"
+	"github.com/pdcgo/shared/authorization"
+	"github.com/pdcgo/shared/db_models"
+	"github.com/pdcgo/shared/interfaces/warehouse_iface"
+	"github.com/pdcgo/shared/pkg/moretest"
+	"github.com/pdcgo/shared/pkg/moretest/moretest_mock"
+	"github.com/pdcgo/warehouse_service"
+	"github.com/pdcgo/warehouse_service/table_export"
+	"github.com/pdcgo/warehouse_service/warehouse_models"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestFinanceExport(t *testing.T) {
+	var db gorm.DB
+
+	moretest.Suite(t, "testing finance export",
+		moretest.SetupListFunc{
+			moretest_mock.MockSqliteDatabase(&db),
+			func(t *testing.T) func() error {
+				err := db.AutoMigrate(
+					&warehouse_models.WareExpenseAccount{},
+					&warehouse_models.WareExpenseAccountWarehouse{},
+					&warehouse_models.WareExpenseHistory{},
+					&warehouse_models.WareBalanceAccountHistory{},
+					&warehouse_models.WarehouseTimezone{},
+				)
+				assert.Nil(t, err)
+
+				err = db.Create(&[]*warehouse_models.WareExpenseAccount{
+					{ID: 1, Name: "Kas Gudang", NumberID: "123456", CreatedAt: time.Now()},
+					{ID: 2, Name: "Bank", NumberID: "987654", CreatedAt: time.Now()},
+				}).Error
+				assert.Nil(t, err)
+
+				err = db.Create(&[]*warehouse_models.WareExpenseAccountWarehouse{
+					{AccountID: 1, WarehouseID: 1},
+					{AccountID: 2, WarehouseID: 1},
+				}).Error
+				assert.Nil(t, err)
+
+				at := time.Date(2025, 2, 1, 3, 0, 0, 0, time.UTC)
+				err = db.Create(&[]*warehouse_models.WareExpenseHistory{
+					{WarehouseID: 1, AccountID: 1, ExpenseType: warehouse_models.ExpenseTypeKitchen, Amount: -12_500, Note: "gas, air", Status: warehouse_models.ExpenseStatusApproved, At: at},
+					{WarehouseID: 1, AccountID: 2, ExpenseType: warehouse_models.ExpenseTypeEquity, Amount: 500_000, Status: warehouse_models.ExpenseStatusApproved, At: at.Add(time.Hour)},
+					{WarehouseID: 1, AccountID: 1, ExpenseType: warehouse_models.ExpenseTypeKitchen, Amount: -7_000, Status: warehouse_models.ExpenseStatusApproved, At: at.Add(2 * time.Hour)},
+				}).Error
+				assert.Nil(t, err)
+
+				err = db.Create(&[]*warehouse_models.WareBalanceAccountHistory{
+					{WarehouseID: 1, AccountID: 1, Amount: 80_500, At: at},
+					{WarehouseID: 1, AccountID: 2, Amount: 500_000, At: at},
+				}).Error
+				assert.Nil(t, err)
+
+				return nil
+			},
+		},
+		func(t *testing.T) {
+			service := warehouse_service.NewWarehouseFinanceService(&db, NewMockAuth(true), event_source.EmptySender)
+			ctx := context.WithValue(context.Background(), "identity", &authorization.JwtIdentity{
+				UserID: 1,
+				From:   db_models.WarehouseTeamType,
+			})
+
+			t.Run("test expense history csv", func(t *testing.T) {
+				out := bytes.Buffer{}
+				err := service.ExpenseHistoryExport(ctx, &warehouse_service.ExpenseHistoryExportReq{
+					ExpenseHistoryQuery: &warehouse_service.ExpenseHistoryQuery{
+						ExpenseHistoryListReq: &warehouse_iface.ExpenseHistoryListReq{
+							WarehouseId: 1,
+							ExpenseType: string(warehouse_models.ExpenseTypeKitchen),
+						},
+					},
+					Format: table_export.FormatCSV,
+				}, &out)
+				assert.Nil(t, err)
+
+				records, err := csv.NewReader(&out).ReadAll()
+				assert.Nil(t, err)
+				assert.Len(t, records, 3)
+				assert.Equal(t, "account_name", records[0][4])
+				assert.Equal(t, "Kas Gudang", records[1][4])
+				assert.Equal(t, "123456", records[1][5])
+				assert.Equal(t, "-12500", records[1][9])
+				assert.Equal(t, "gas, air", records[1][10])
+				// at in warehouse timezone
+				assert.Equal(t, "2025-02-01 10:00:00", records[1][1])
+			})
+
+			t.Run("test balance history xlsx", func(t *testing.T) {
+				out := bytes.Buffer{}
+				err := service.BalanceHistoryExport(ctx, &warehouse_service.BalanceHistoryExportReq{
+					BalanceHistoryListReq: &warehouse_service.BalanceHistoryListReq{WarehouseId: 1, AccountId: 2},
+					Format:                table_export.FormatXLSX,
+				}, &out)
+				assert.Nil(t, err)
+
+				archive, err := zip.NewReader(bytes.NewReader(out.Bytes()), int64(out.Len()))
+				assert.Nil(t, err)
+				assert.Len(t, archive.File, 6)
+			})
+
+			t.Run("test balance history csv", func(t *testing.T) {
+				out := bytes.Buffer{}
+				err := service.BalanceHistoryExport(ctx, &warehouse_service.BalanceHistoryExportReq{
+					BalanceHistoryListReq: &warehouse_service.BalanceHistoryListReq{WarehouseId: 1},
+				}, &out)
+				assert.Nil(t, err)
+
+				records, err := csv.NewReader(&out).ReadAll()
+				assert.Nil(t, err)
+				assert.Len(t, records, 3)
+				assert.Equal(t, "Bank", records[2][4])
+				assert.Equal(t, "987654", records[2][5])
+			})
+
+			t.Run("test format not supported", func(t *testing.T) {
+				err := service.BalanceHistoryExport(ctx, &warehouse_service.BalanceHistoryExportReq{
+					BalanceHistoryListReq: &warehouse_service.BalanceHistoryListReq{WarehouseId: 1},
+					Format:                "pdf",
+				}, &bytes.Buffer{})
+				assert.ErrorIs(t, err, table_export.ErrFormatNotSupported)
+			})
+
+			t.Run("test checked on warehouse", func(t *testing.T) {
+				service := warehouse_service.NewWarehouseFinanceService(&db, NewMockDomainAuth(2), event_source.EmptySender)
+
+				out := bytes.Buffer{}
+				err := service.BalanceHistoryExport(ctx, &warehouse_service.BalanceHistoryExportReq{
+					BalanceHistoryListReq: &warehouse_service.BalanceHistoryListReq{WarehouseId: 1},
+				}, &out)
+				assert.ErrorIs(t, err, authorization.ErrPermission)
+				assert.Zero(t, out.Len())
+			})
+
+			t.Run("test http handler", func(t *testing.T) {
+				server := httptest.NewServer(warehouse_service.NewFinanceExportHttpHandler(NewMockAuth(true), service))
+				defer server.Close()
+
+				get := func(path string) *http.Response {
+					req, err := http.NewRequest(http.MethodGet, server.URL+path, nil)
+					assert.Nil(t, err)
+					req.Header.Set("Authorization", "Bearer token")
+
+					res, err := http.DefaultClient.Do(req)
+					assert.Nil(t, err)
+					return res
+				}
+
+				res := get("/finance_exports/expense_histories?warehouse_id=1&expense_type=kitchen")
+				defer res.Body.Close()
+				assert.Equal(t, http.StatusOK, res.StatusCode)
+				assert.Equal(t, table_export.FormatCSV.ContentType(), res.Header.Get("Content-Type"))
+				assert.Contains(t, res.Header.Get("Content-Disposition"), "expense_history_")
+
+				records, err := csv.NewReader(res.Body).ReadAll()
+				assert.Nil(t, err)
+				assert.Len(t, records, 3)
+
+				// error before streaming is http error without download header
+				res = get("/finance_exports/expense_histories?warehouse_id=1&status=unknown")
+				defer res.Body.Close()
+				assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+				assert.Empty(t, res.Header.Get("Content-Disposition"))
+
+				res = get("/finance_exports/balance_histories?warehouse_id=1&format=pdf")
+				defer res.Body.Close()
+				assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+				assert.Empty(t, res.Header.Get("Content-Disposition"))
+			})
+		},
+	)
+}
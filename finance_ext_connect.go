@@ -0,0 +1,599 @@
+package warehouse_service
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"connectrpc.com/connect"
+	"github.com/pdcgo/shared/authorization"
+	"github.com/pdcgo/shared/db_models"
+	"github.com/pdcgo/shared/interfaces/authorization_iface"
+	"github.com/pdcgo/shared/interfaces/warehouse_iface"
+	warehouse_service_iface "github.com/pdcgo/warehouse_service/services/warehouse_service/v1"
+	"github.com/pdcgo/warehouse_service/services/warehouse_service/v1/warehouse_service_ifaceconnect"
+	"github.com/pdcgo/warehouse_service/warehouse_models"
+	"github.com/pdcgo/warehouse_service/warehouse_mutations"
+	"github.com/pdcgo/warehouse_service/warehouse_query"
+	"gorm.io/gorm"
+)
+
+// NewWarehouseFinanceExtServiceHandler is connect handler of finance method that legacy proto has no rpc
+// for. identity is read from header with legacy authorization, permission is checked in service method.
+func NewWarehouseFinanceExtServiceHandler(
+	auth authorization_iface.Authorization,
+	service WarehouseFinanceService,
+	opts ...connect.HandlerOption,
+) (string, http.Handler) {
+	return warehouse_service_ifaceconnect.NewWarehouseFinanceExtServiceHandler(
+		&financeExtConnectImpl{
+			auth:    auth,
+			service: service,
+		},
+		opts...,
+	)
+}
+
+type financeExtConnectImpl struct {
+	auth    authorization_iface.Authorization
+	service WarehouseFinanceService
+}
+
+func financeConnectErr(err error) error {
+	switch {
+	case errors.Is(err, authorization.ErrPermission),
+		errors.Is(err, ErrWarehouseMismatch),
+		errors.Is(err, ErrExpenseTypeNeedAdmin),
+		errors.Is(err, warehouse_mutations.ErrTransferCrossWarehouse):
+		return connect.NewError(connect.CodePermissionDenied, err)
+	case errors.Is(err, gorm.ErrRecordNotFound),
+		errors.Is(err, warehouse_mutations.ErrExpenseAccountNotFound):
+		return connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, ErrExpenseSortNotSupported):
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	case errors.Is(err, warehouse_mutations.ErrExpenseBudgetExceeded):
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+	return err
+}
+
+// ExpenseReportDailyDetail implements warehouse_service_ifaceconnect.WarehouseFinanceExtServiceHandler.
+func (f *financeExtConnectImpl) ExpenseReportDailyDetail(
+	ctx context.Context,
+	req *connect.Request[warehouse_service_iface.ExpenseReportDailyDetailRequest],
+) (*connect.Response[warehouse_service_iface.ExpenseReportDailyDetailResponse], error) {
+	ctx, err := legacyIdentityContext(ctx, f.auth, req.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	pay := req.Msg
+	result, err := f.service.ExpenseReportDailyDetail(ctx, &ExpenseReportDailyQuery{
+		ExpenseReportDailyReq: &warehouse_iface.ExpenseReportDailyReq{
+			AccountId:   pay.AccountId,
+			WarehouseId: pay.WarehouseId,
+		},
+		StartDate: pay.StartDate,
+		EndDate:   pay.EndDate,
+	})
+	if err != nil {
+		return nil, financeConnectErr(err)
+	}
+
+	res := warehouse_service_iface.ExpenseReportDailyDetailResponse{
+		Data: make([]*warehouse_service_iface.ExpenseReportDay, len(result.Data)),
+	}
+	for i, day := range result.Data {
+		res.Data[i] = expenseReportDayToProto(day)
+	}
+
+	return connect.NewResponse(&res), nil
+}
+
+// ExpenseAccountPage implements warehouse_service_ifaceconnect.WarehouseFinanceExtServiceHandler.
+func (f *financeExtConnectImpl) ExpenseAccountPage(
+	ctx context.Context,
+	req *connect.Request[warehouse_service_iface.ExpenseAccountPageRequest],
+) (*connect.Response[warehouse_service_iface.ExpenseAccountPageResponse], error) {
+	ctx, err := legacyIdentityContext(ctx, f.auth, req.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	pay := req.Msg
+	result, err := f.service.ExpenseAccountPage(ctx, &ExpenseAccountQuery{
+		ExpenseAccountListReq: &warehouse_iface.ExpenseAccountListReq{
+			WarehouseId:  pay.WarehouseId,
+			NumberId:     pay.NumberId,
+			Name:         pay.Name,
+			IsOpsAccount: pay.IsOpsAccount,
+		},
+		Status: warehouse_query.AccountStatus(pay.Status),
+	}, expenseListPageFromProto(pay.Page))
+	if err != nil {
+		return nil, financeConnectErr(err)
+	}
+
+	res := warehouse_service_iface.ExpenseAccountPageResponse{
+		Data:     make([]*warehouse_service_iface.ExpenseAccount, len(result.Data)),
+		PageInfo: result.PageInfo,
+	}
+	for i, account := range result.Data {
+		res.Data[i] = expenseAccountToProto(account)
+	}
+
+	return connect.NewResponse(&res), nil
+}
+
+// ExpenseAccountSetDisabled implements warehouse_service_ifaceconnect.WarehouseFinanceExtServiceHandler.
+func (f *financeExtConnectImpl) ExpenseAccountSetDisabled(
+	ctx context.Context,
+	req *connect.Request[warehouse_service_iface.ExpenseAccountSetDisabledRequest],
+) (*connect.Response[warehouse_service_iface.ExpenseAccountSetDisabledResponse], error) {
+	ctx, err := legacyIdentityContext(ctx, f.auth, req.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	pay := req.Msg
+	account, err := f.service.ExpenseAccountSetDisabled(ctx, &ExpenseAccountDisabledReq{
+		DomainId:    pay.DomainId,
+		AccountId:   pay.AccountId,
+		WarehouseId: pay.WarehouseId,
+		Disabled:    pay.Disabled,
+		Note:        pay.Note,
+	})
+	if err != nil {
+		return nil, financeConnectErr(err)
+	}
+
+	return connect.NewResponse(&warehouse_service_iface.ExpenseAccountSetDisabledResponse{
+		Data: expenseAccountToProto(account),
+	}), nil
+}
+
+// ExpenseHistoryPage implements warehouse_service_ifaceconnect.WarehouseFinanceExtServiceHandler.
+func (f *financeExtConnectImpl) ExpenseHistoryPage(
+	ctx context.Context,
+	req *connect.Request[warehouse_service_iface.ExpenseHistoryPageRequest],
+) (*connect.Response[warehouse_service_iface.ExpenseHistoryPageResponse], error) {
+	ctx, err := legacyIdentityContext(ctx, f.auth, req.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	pay := req.Msg
+	result, err := f.service.ExpenseHistoryPage(ctx, &ExpenseHistoryQuery{
+		ExpenseHistoryListReq: &warehouse_iface.ExpenseHistoryListReq{
+			AccountId:    pay.AccountId,
+			WarehouseId:  pay.WarehouseId,
+			IsOpsAccount: pay.IsOpsAccount,
+			ExpenseType:  pay.ExpenseType,
+			StartDate:    pay.StartDate,
+			EndDate:      pay.EndDate,
+		},
+		TimeType:       warehouse_query.WareExpenseTimeType(pay.TimeType),
+		Status:         warehouse_models.ExpenseStatus(pay.Status),
+		IncludeDeleted: pay.IncludeDeleted,
+	}, expenseListPageFromProto(pay.Page))
+	if err != nil {
+		return nil, financeConnectErr(err)
+	}
+
+	res := warehouse_service_iface.ExpenseHistoryPageResponse{
+		Data:     make([]*warehouse_service_iface.ExpenseHistory, len(result.Data)),
+		PageInfo: result.PageInfo,
+		Totals:   make([]*warehouse_service_iface.ExpenseTotal, len(result.Totals)),
+	}
+	for i, item := range result.Data {
+		res.Data[i] = expenseHistoryItemToProto(item)
+	}
+	for i, total := range result.Totals {
+		res.Totals[i] = expenseTotalToProto(total.ExpenseType, string(total.FlowType), total.Count, total.Amount)
+	}
+
+	return connect.NewResponse(&res), nil
+}
+
+// ExpenseHistoryReview implements warehouse_service_ifaceconnect.WarehouseFinanceExtServiceHandler.
+func (f *financeExtConnectImpl) ExpenseHistoryReview(
+	ctx context.Context,
+	req *connect.Request[warehouse_service_iface.ExpenseHistoryReviewRequest],
+) (*connect.Response[warehouse_service_iface.ExpenseHistoryReviewResponse], error) {
+	ctx, err := legacyIdentityContext(ctx, f.auth, req.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	pay := req.Msg
+	expense, err := f.service.ExpenseHistoryReview(ctx, &ExpenseHistoryReviewReq{
+		DomainId: pay.DomainId,
+		HistId:   pay.HistId,
+		Approve:  pay.Approve,
+		Note:     pay.Note,
+	})
+	if err != nil {
+		return nil, financeConnectErr(err)
+	}
+
+	return connect.NewResponse(&warehouse_service_iface.ExpenseHistoryReviewResponse{
+		Data: expenseHistoryToProto(expense),
+	}), nil
+}
+
+// ExpenseHistoryRevision implements warehouse_service_ifaceconnect.WarehouseFinanceExtServiceHandler.
+func (f *financeExtConnectImpl) ExpenseHistoryRevision(
+	ctx context.Context,
+	req *connect.Request[warehouse_service_iface.ExpenseHistoryRevisionRequest],
+) (*connect.Response[warehouse_service_iface.ExpenseHistoryRevisionResponse], error) {
+	ctx, err := legacyIdentityContext(ctx, f.auth, req.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := f.service.ExpenseHistoryRevision(ctx, &ExpenseHistoryRevisionReq{
+		DomainId: req.Msg.DomainId,
+		HistId:   req.Msg.HistId,
+	})
+	if err != nil {
+		return nil, financeConnectErr(err)
+	}
+
+	res := warehouse_service_iface.ExpenseHistoryRevisionResponse{
+		Data: make([]*warehouse_service_iface.ExpenseRevision, len(result.Data)),
+	}
+	for i, item := range result.Data {
+		res.Data[i] = expenseRevisionToProto(item)
+	}
+
+	return connect.NewResponse(&res), nil
+}
+
+// ExpenseHistoryDelete implements warehouse_service_ifaceconnect.WarehouseFinanceExtServiceHandler.
+func (f *financeExtConnectImpl) ExpenseHistoryDelete(
+	ctx context.Context,
+	req *connect.Request[warehouse_service_iface.ExpenseHistoryDeleteRequest],
+) (*connect.Response[warehouse_service_iface.ExpenseHistoryDeleteResponse], error) {
+	ctx, err := legacyIdentityContext(ctx, f.auth, req.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	expense, err := f.service.ExpenseHistoryDelete(ctx, &ExpenseHistoryDeleteReq{
+		DomainId: req.Msg.DomainId,
+		HistId:   req.Msg.HistId,
+		Reason:   req.Msg.Reason,
+	})
+	if err != nil {
+		return nil, financeConnectErr(err)
+	}
+
+	return connect.NewResponse(&warehouse_service_iface.ExpenseHistoryDeleteResponse{
+		Data: expenseHistoryToProto(expense),
+	}), nil
+}
+
+// ExpenseHistoryRestore implements warehouse_service_ifaceconnect.WarehouseFinanceExtServiceHandler.
+func (f *financeExtConnectImpl) ExpenseHistoryRestore(
+	ctx context.Context,
+	req *connect.Request[warehouse_service_iface.ExpenseHistoryRestoreRequest],
+) (*connect.Response[warehouse_service_iface.ExpenseHistoryRestoreResponse], error) {
+	ctx, err := legacyIdentityContext(ctx, f.auth, req.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	expense, err := f.service.ExpenseHistoryRestore(ctx, &ExpenseHistoryDeleteReq{
+		DomainId: req.Msg.DomainId,
+		HistId:   req.Msg.HistId,
+		Reason:   req.Msg.Reason,
+	})
+	if err != nil {
+		return nil, financeConnectErr(err)
+	}
+
+	return connect.NewResponse(&warehouse_service_iface.ExpenseHistoryRestoreResponse{
+		Data: expenseHistoryToProto(expense),
+	}), nil
+}
+
+// ExpenseTransferCreate implements warehouse_service_ifaceconnect.WarehouseFinanceExtServiceHandler.
+func (f *financeExtConnectImpl) ExpenseTransferCreate(
+	ctx context.Context,
+	req *connect.Request[warehouse_service_iface.ExpenseTransferCreateRequest],
+) (*connect.Response[warehouse_service_iface.ExpenseTransferCreateResponse], error) {
+	ctx, err := legacyIdentityContext(ctx, f.auth, req.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	pay := req.Msg
+	transfer, err := f.service.ExpenseTransferCreate(ctx, &ExpenseTransferCreateReq{
+		FromWarehouseId: pay.FromWarehouseId,
+		FromAccountId:   pay.FromAccountId,
+		ToWarehouseId:   pay.ToWarehouseId,
+		ToAccountId:     pay.ToAccountId,
+		Amount:          pay.Amount,
+		Note:            pay.Note,
+		At:              protoTime(pay.At),
+	})
+	if err != nil {
+		return nil, financeConnectErr(err)
+	}
+
+	return connect.NewResponse(&warehouse_service_iface.ExpenseTransferCreateResponse{
+		Data: expenseTransferToProto(transfer),
+	}), nil
+}
+
+// BalanceHistoryCreate implements warehouse_service_ifaceconnect.WarehouseFinanceExtServiceHandler.
+func (f *financeExtConnectImpl) BalanceHistoryCreate(
+	ctx context.Context,
+	req *connect.Request[warehouse_service_iface.BalanceHistoryCreateRequest],
+) (*connect.Response[warehouse_service_iface.BalanceHistoryCreateResponse], error) {
+	ctx, err := legacyIdentityContext(ctx, f.auth, req.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	pay := req.Msg
+	hist, err := f.service.BalanceHistoryCreate(ctx, &BalanceHistoryCreateReq{
+		DomainId:  pay.DomainId,
+		AccountId: pay.AccountId,
+		Amount:    pay.Amount,
+		At:        protoTime(pay.At),
+	})
+	if err != nil {
+		return nil, financeConnectErr(err)
+	}
+
+	return connect.NewResponse(&warehouse_service_iface.BalanceHistoryCreateResponse{
+		Data: balanceHistoryToProto(hist),
+	}), nil
+}
+
+// BalanceHistoryUpdate implements warehouse_service_ifaceconnect.WarehouseFinanceExtServiceHandler.
+func (f *financeExtConnectImpl) BalanceHistoryUpdate(
+	ctx context.Context,
+	req *connect.Request[warehouse_service_iface.BalanceHistoryUpdateRequest],
+) (*connect.Response[warehouse_service_iface.BalanceHistoryUpdateResponse], error) {
+	ctx, err := legacyIdentityContext(ctx, f.auth, req.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	pay := req.Msg
+	hist, err := f.service.BalanceHistoryUpdate(ctx, &BalanceHistoryUpdateReq{
+		DomainId: pay.DomainId,
+		HistId:   pay.HistId,
+		Amount:   pay.Amount,
+		At:       protoTime(pay.At),
+	})
+	if err != nil {
+		return nil, financeConnectErr(err)
+	}
+
+	return connect.NewResponse(&warehouse_service_iface.BalanceHistoryUpdateResponse{
+		Data: balanceHistoryToProto(hist),
+	}), nil
+}
+
+// BalanceHistoryDelete implements warehouse_service_ifaceconnect.WarehouseFinanceExtServiceHandler.
+func (f *financeExtConnectImpl) BalanceHistoryDelete(
+	ctx context.Context,
+	req *connect.Request[warehouse_service_iface.BalanceHistoryDeleteRequest],
+) (*connect.Response[warehouse_service_iface.BalanceHistoryDeleteResponse], error) {
+	ctx, err := legacyIdentityContext(ctx, f.auth, req.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	err = f.service.BalanceHistoryDelete(ctx, &BalanceHistoryDeleteReq{
+		DomainId: req.Msg.DomainId,
+		HistId:   req.Msg.HistId,
+	})
+	if err != nil {
+		return nil, financeConnectErr(err)
+	}
+
+	return connect.NewResponse(&warehouse_service_iface.BalanceHistoryDeleteResponse{}), nil
+}
+
+// BalanceHistoryList implements warehouse_service_ifaceconnect.WarehouseFinanceExtServiceHandler.
+func (f *financeExtConnectImpl) BalanceHistoryList(
+	ctx context.Context,
+	req *connect.Request[warehouse_service_iface.BalanceHistoryListRequest],
+) (*connect.Response[warehouse_service_iface.BalanceHistoryListResponse], error) {
+	ctx, err := legacyIdentityContext(ctx, f.auth, req.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	pay := req.Msg
+	result, err := f.service.BalanceHistoryList(ctx, &BalanceHistoryListReq{
+		WarehouseId: pay.WarehouseId,
+		AccountId:   pay.AccountId,
+		CreatedById: pay.CreatedById,
+		At:          pay.At,
+		StartDate:   pay.StartDate,
+		EndDate:     pay.EndDate,
+		Page:        pay.Page,
+	})
+	if err != nil {
+		return nil, financeConnectErr(err)
+	}
+
+	res := warehouse_service_iface.BalanceHistoryListResponse{
+		Data:     make([]*warehouse_service_iface.BalanceHistory, len(result.Data)),
+		PageInfo: result.PageInfo,
+	}
+	for i, hist := range result.Data {
+		res.Data[i] = balanceHistoryToProto(hist)
+	}
+
+	return connect.NewResponse(&res), nil
+}
+
+// AccountBalanceReconciliation implements warehouse_service_ifaceconnect.WarehouseFinanceExtServiceHandler.
+func (f *financeExtConnectImpl) AccountBalanceReconciliation(
+	ctx context.Context,
+	req *connect.Request[warehouse_service_iface.AccountBalanceReconciliationRequest],
+) (*connect.Response[warehouse_service_iface.AccountBalanceReconciliationResponse], error) {
+	ctx, err := legacyIdentityContext(ctx, f.auth, req.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	pay := req.Msg
+	result, err := f.service.AccountBalanceReconciliation(ctx, &AccountReconciliationReq{
+		WarehouseId:  pay.WarehouseId,
+		AccountId:    pay.AccountId,
+		StartDate:    pay.StartDate,
+		EndDate:      pay.EndDate,
+		MismatchOnly: pay.MismatchOnly,
+	})
+	if err != nil {
+		return nil, financeConnectErr(err)
+	}
+
+	res := warehouse_service_iface.AccountBalanceReconciliationResponse{
+		WarehouseId:   uint64(result.WarehouseID),
+		Timezone:      result.Timezone,
+		CheckedCount:  int64(result.CheckedCount),
+		MismatchCount: int64(result.MismatchCount),
+		Data:          make([]*warehouse_service_iface.AccountReconciliation, len(result.Data)),
+	}
+	for i, item := range result.Data {
+		res.Data[i] = accountReconciliationToProto(item)
+	}
+
+	return connect.NewResponse(&res), nil
+}
+
+// ExpenseTypeList implements warehouse_service_ifaceconnect.WarehouseFinanceExtServiceHandler.
+func (f *financeExtConnectImpl) ExpenseTypeList(
+	ctx context.Context,
+	req *connect.Request[warehouse_service_iface.ExpenseTypeListRequest],
+) (*connect.Response[warehouse_service_iface.ExpenseTypeListResponse], error) {
+	ctx, err := legacyIdentityContext(ctx, f.auth, req.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := f.service.ExpenseTypeList(ctx, &ExpenseTypeListReq{
+		WarehouseId:     req.Msg.WarehouseId,
+		TeamType:        db_models.TeamType(req.Msg.TeamType),
+		IncludeDisabled: req.Msg.IncludeDisabled,
+	})
+	if err != nil {
+		return nil, financeConnectErr(err)
+	}
+
+	res := warehouse_service_iface.ExpenseTypeListResponse{
+		Data: make([]*warehouse_service_iface.ExpenseType, len(result.Data)),
+	}
+	for i, expenseType := range result.Data {
+		res.Data[i] = expenseTypeToProto(expenseType)
+	}
+
+	return connect.NewResponse(&res), nil
+}
+
+// ExpenseTypeCreate implements warehouse_service_ifaceconnect.WarehouseFinanceExtServiceHandler.
+func (f *financeExtConnectImpl) ExpenseTypeCreate(
+	ctx context.Context,
+	req *connect.Request[warehouse_service_iface.ExpenseTypeCreateRequest],
+) (*connect.Response[warehouse_service_iface.ExpenseTypeCreateResponse], error) {
+	ctx, err := legacyIdentityContext(ctx, f.auth, req.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	expenseType, err := f.service.ExpenseTypeCreate(ctx, expenseTypeSaveFromProto(req.Msg.DomainId, req.Msg.Data))
+	if err != nil {
+		return nil, financeConnectErr(err)
+	}
+
+	return connect.NewResponse(&warehouse_service_iface.ExpenseTypeCreateResponse{
+		Data: expenseTypeToProto(expenseType),
+	}), nil
+}
+
+// ExpenseTypeUpdate implements warehouse_service_ifaceconnect.WarehouseFinanceExtServiceHandler.
+func (f *financeExtConnectImpl) ExpenseTypeUpdate(
+	ctx context.Context,
+	req *connect.Request[warehouse_service_iface.ExpenseTypeUpdateRequest],
+) (*connect.Response[warehouse_service_iface.ExpenseTypeUpdateResponse], error) {
+	ctx, err := legacyIdentityContext(ctx, f.auth, req.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	expenseType, err := f.service.ExpenseTypeUpdate(ctx, expenseTypeSaveFromProto(req.Msg.DomainId, req.Msg.Data))
+	if err != nil {
+		return nil, financeConnectErr(err)
+	}
+
+	return connect.NewResponse(&warehouse_service_iface.ExpenseTypeUpdateResponse{
+		Data: expenseTypeToProto(expenseType),
+	}), nil
+}
+
+// ExpenseBudgetSet implements warehouse_service_ifaceconnect.WarehouseFinanceExtServiceHandler.
+func (f *financeExtConnectImpl) ExpenseBudgetSet(
+	ctx context.Context,
+	req *connect.Request[warehouse_service_iface.ExpenseBudgetSetRequest],
+) (*connect.Response[warehouse_service_iface.ExpenseBudgetSetResponse], error) {
+	ctx, err := legacyIdentityContext(ctx, f.auth, req.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	pay := req.Msg
+	budget, err := f.service.ExpenseBudgetSet(ctx, &ExpenseBudgetSetReq{
+		DomainId:     pay.DomainId,
+		WarehouseId:  pay.WarehouseId,
+		ExpenseType:  warehouse_models.ExpenseType(pay.ExpenseType),
+		Month:        pay.Month,
+		Amount:       pay.Amount,
+		AlertPercent: pay.AlertPercent,
+		Enforce:      warehouse_models.BudgetEnforce(pay.Enforce),
+	})
+	if err != nil {
+		return nil, financeConnectErr(err)
+	}
+
+	return connect.NewResponse(&warehouse_service_iface.ExpenseBudgetSetResponse{
+		Data: expenseBudgetToProto(budget),
+	}), nil
+}
+
+// ExpenseBudgetReport implements warehouse_service_ifaceconnect.WarehouseFinanceExtServiceHandler.
+func (f *financeExtConnectImpl) ExpenseBudgetReport(
+	ctx context.Context,
+	req *connect.Request[warehouse_service_iface.ExpenseBudgetReportRequest],
+) (*connect.Response[warehouse_service_iface.ExpenseBudgetReportResponse], error) {
+	ctx, err := legacyIdentityContext(ctx, f.auth, req.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := f.service.ExpenseBudgetReport(ctx, &ExpenseBudgetReportReq{
+		WarehouseId: req.Msg.WarehouseId,
+		Month:       req.Msg.Month,
+	})
+	if err != nil {
+		return nil, financeConnectErr(err)
+	}
+
+	res := warehouse_service_iface.ExpenseBudgetReportResponse{
+		WarehouseId: uint64(result.WarehouseID),
+		Month:       result.Month,
+		Data:        make([]*warehouse_service_iface.ExpenseBudgetUsage, len(result.Data)),
+	}
+	for i, usage := range result.Data {
+		res.Data[i] = expenseBudgetUsageToProto(usage)
+	}
+
+	return connect.NewResponse(&res), nil
+}
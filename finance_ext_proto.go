@@ -0,0 +1,234 @@
+package warehouse_service
+
+import (
+	"time"
+
+	"github.com/pdcgo/shared/db_models"
+	"github.com/pdcgo/shared/interfaces/warehouse_iface"
+	warehouse_service_iface "github.com/pdcgo/warehouse_service/services/warehouse_service/v1"
+	"github.com/pdcgo/warehouse_service/warehouse_models"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// conversion between finance service type and warehouse_service.v1 finance ext message.
+
+// protoTime is zero time when timestamp not sent, instead of unix epoch of AsTime.
+func protoTime(ts *timestamppb.Timestamp) time.Time {
+	if ts == nil {
+		return time.Time{}
+	}
+	return ts.AsTime()
+}
+
+func expenseListPageFromProto(page *warehouse_service_iface.ExpenseListPage) *ExpenseListPage {
+	if page == nil {
+		return nil
+	}
+	return &ExpenseListPage{
+		Page:     page.Page,
+		SortBy:   ExpenseSortField(page.SortBy),
+		SortDesc: page.SortDesc,
+	}
+}
+
+func expenseAccountToProto(account *warehouse_iface.WarehouseExpenseAccount) *warehouse_service_iface.ExpenseAccount {
+	return &warehouse_service_iface.ExpenseAccount{
+		Id:            account.Id,
+		WarehouseId:   account.WarehouseId,
+		AccountTypeId: account.AccountTypeId,
+		Name:          account.Name,
+		NumberId:      account.NumberId,
+		IsOpsAccount:  account.IsOpsAccount,
+		Disabled:      account.Disabled,
+		CreatedAt:     account.CreatedAt,
+	}
+}
+
+func expenseHistoryToProto(expense *warehouse_models.WareExpenseHistory) *warehouse_service_iface.ExpenseHistory {
+	return &warehouse_service_iface.ExpenseHistory{
+		Id:          uint64(expense.ID),
+		WarehouseId: uint64(expense.WarehouseID),
+		AccountId:   uint64(expense.AccountID),
+		CreatedById: uint64(expense.CreatedByID),
+		ExpenseType: string(expense.ExpenseType),
+		Amount:      expense.Amount,
+		Note:        expense.Note,
+		Status:      string(expense.Status),
+		TransferId:  uint64(expense.TransferID),
+		Deleted:     expense.Deleted,
+		At:          timestamppb.New(expense.At),
+		CreatedAt:   timestamppb.New(expense.CreatedAt),
+	}
+}
+
+func expenseHistoryItemToProto(item *ExpenseHistoryItem) *warehouse_service_iface.ExpenseHistory {
+	result := expenseHistoryToProto(&item.WareExpenseHistory)
+	result.IsOpsAccount = item.IsOpsAccount
+	result.TransferAccountId = uint64(item.TransferAccountID)
+	return result
+}
+
+func expenseTotalToProto(expenseType warehouse_models.ExpenseType, flowType string, count int64, amount float64) *warehouse_service_iface.ExpenseTotal {
+	return &warehouse_service_iface.ExpenseTotal{
+		ExpenseType: string(expenseType),
+		FlowType:    flowType,
+		Count:       count,
+		Amount:      amount,
+	}
+}
+
+func expenseReportDayToProto(day *ExpenseReportDay) *warehouse_service_iface.ExpenseReportDay {
+	result := &warehouse_service_iface.ExpenseReportDay{
+		StartDate:        day.StartDate,
+		EndDate:          day.EndDate,
+		Expense:          day.Expense,
+		Income:           day.Income,
+		SystemDiffAmount: day.SystemDiffAmount,
+		ActualDiffAmount: day.ActualDiffAmount,
+		ErrDiffAmount:    day.ErrDiffAmount,
+		OpeningAmount:    day.OpeningAmount,
+		ClosingAmount:    day.ClosingAmount,
+		Types:            make([]*warehouse_service_iface.ExpenseTotal, len(day.Types)),
+	}
+	for i, flow := range day.Types {
+		result.Types[i] = expenseTotalToProto(flow.ExpenseType, string(flow.FlowType), flow.Count, flow.Amount)
+	}
+	return result
+}
+
+func expenseRevisionValueToProto(value *warehouse_models.ExpenseRevisionValue) *warehouse_service_iface.ExpenseRevisionValue {
+	return &warehouse_service_iface.ExpenseRevisionValue{
+		WarehouseId: uint64(value.WarehouseID),
+		AccountId:   uint64(value.AccountID),
+		ExpenseType: string(value.ExpenseType),
+		Amount:      value.Amount,
+		Note:        value.Note,
+		Status:      string(value.Status),
+		At:          timestamppb.New(value.At),
+	}
+}
+
+func expenseRevisionToProto(item *ExpenseRevisionItem) *warehouse_service_iface.ExpenseRevision {
+	before := item.Before.Data()
+	after := item.After.Data()
+	return &warehouse_service_iface.ExpenseRevision{
+		Id:            uint64(item.ID),
+		ExpenseHistId: uint64(item.ExpenseHistID),
+		EditorId:      uint64(item.EditorID),
+		EditorFrom:    string(item.EditorFrom),
+		Before:        expenseRevisionValueToProto(&before),
+		After:         expenseRevisionValueToProto(&after),
+		CreatedAt:     timestamppb.New(item.CreatedAt),
+		Changes:       item.Changes,
+	}
+}
+
+func expenseTransferToProto(transfer *warehouse_models.WareExpenseTransfer) *warehouse_service_iface.ExpenseTransfer {
+	return &warehouse_service_iface.ExpenseTransfer{
+		Id:              uint64(transfer.ID),
+		FromWarehouseId: uint64(transfer.FromWarehouseID),
+		FromAccountId:   uint64(transfer.FromAccountID),
+		ToWarehouseId:   uint64(transfer.ToWarehouseID),
+		ToAccountId:     uint64(transfer.ToAccountID),
+		Amount:          transfer.Amount,
+		Note:            transfer.Note,
+		At:              timestamppb.New(transfer.At),
+		CreatedById:     uint64(transfer.CreatedByID),
+		CreatedAt:       timestamppb.New(transfer.CreatedAt),
+	}
+}
+
+func balanceHistoryToProto(hist *warehouse_models.WareBalanceAccountHistory) *warehouse_service_iface.BalanceHistory {
+	return &warehouse_service_iface.BalanceHistory{
+		Id:          uint64(hist.ID),
+		WarehouseId: uint64(hist.WarehouseID),
+		AccountId:   uint64(hist.AccountID),
+		CreatedById: uint64(hist.CreatedByID),
+		Amount:      hist.Amount,
+		At:          timestamppb.New(hist.At),
+		CreatedAt:   timestamppb.New(hist.CreatedAt),
+	}
+}
+
+func accountReconciliationToProto(item *AccountReconciliation) *warehouse_service_iface.AccountReconciliation {
+	return &warehouse_service_iface.AccountReconciliation{
+		AccountId:       uint64(item.AccountID),
+		Day:             item.Day,
+		PreviousDay:     item.PreviousDay,
+		PreviousBalance: item.PreviousBalance,
+		Income:          item.Income,
+		Outcome:         item.Outcome,
+		ExpectedBalance: item.ExpectedBalance,
+		RecordedBalance: item.RecordedBalance,
+		Difference:      item.Difference,
+		Mismatch:        item.Mismatch,
+	}
+}
+
+func expenseTypeToProto(expenseType *warehouse_models.WareExpenseType) *warehouse_service_iface.ExpenseType {
+	result := &warehouse_service_iface.ExpenseType{
+		Key:         string(expenseType.Key),
+		Name:        expenseType.Name,
+		Flow:        string(expenseType.Flow),
+		AdminOnly:   expenseType.AdminOnly,
+		WarehouseId: uint64(expenseType.WarehouseID),
+		Disabled:    expenseType.Disabled,
+		TeamTypes:   make([]string, len(expenseType.Teams)),
+		CreatedAt:   timestamppb.New(expenseType.CreatedAt),
+		UpdatedAt:   timestamppb.New(expenseType.UpdatedAt),
+	}
+	for i, team := range expenseType.Teams {
+		result.TeamTypes[i] = string(team.TeamType)
+	}
+	return result
+}
+
+func expenseTypeSaveFromProto(domainID uint64, expenseType *warehouse_service_iface.ExpenseType) *ExpenseTypeSaveReq {
+	if expenseType == nil {
+		expenseType = &warehouse_service_iface.ExpenseType{}
+	}
+
+	result := &ExpenseTypeSaveReq{
+		DomainId:    domainID,
+		Key:         warehouse_models.ExpenseType(expenseType.Key),
+		Name:        expenseType.Name,
+		Flow:        warehouse_models.ExpenseFlow(expenseType.Flow),
+		AdminOnly:   expenseType.AdminOnly,
+		WarehouseId: expenseType.WarehouseId,
+		Disabled:    expenseType.Disabled,
+		TeamTypes:   make([]db_models.TeamType, len(expenseType.TeamTypes)),
+	}
+	for i, teamType := range expenseType.TeamTypes {
+		result.TeamTypes[i] = db_models.TeamType(teamType)
+	}
+	return result
+}
+
+func expenseBudgetToProto(budget *warehouse_models.WareExpenseBudget) *warehouse_service_iface.ExpenseBudget {
+	return &warehouse_service_iface.ExpenseBudget{
+		Id:           uint64(budget.ID),
+		WarehouseId:  uint64(budget.WarehouseID),
+		ExpenseType:  string(budget.ExpenseType),
+		Month:        budget.Month,
+		Amount:       budget.Amount,
+		AlertPercent: budget.AlertPercent,
+		Enforce:      string(budget.Enforce),
+		UpdatedById:  uint64(budget.UpdatedByID),
+		CreatedAt:    timestamppb.New(budget.CreatedAt),
+		UpdatedAt:    timestamppb.New(budget.UpdatedAt),
+	}
+}
+
+func expenseBudgetUsageToProto(usage *ExpenseBudgetUsage) *warehouse_service_iface.ExpenseBudgetUsage {
+	return &warehouse_service_iface.ExpenseBudgetUsage{
+		ExpenseType:  string(usage.ExpenseType),
+		Budget:       usage.Budget,
+		AlertPercent: usage.AlertPercent,
+		Enforce:      string(usage.Enforce),
+		Spent:        usage.Spent,
+		Remaining:    usage.Remaining,
+		Utilisation:  usage.Utilisation,
+		Alert:        usage.Alert,
+		Exceeded:     usage.Exceeded,
+	}
+}
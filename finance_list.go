@@ -0,0 +1,360 @@
+package warehouse_service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/pdcgo/schema/services/common/v1"
+	"github.com/pdcgo/shared/db_connect"
+	"github.com/pdcgo/shared/interfaces/warehouse_iface"
+	"github.com/pdcgo/warehouse_service/warehouse_models"
+	"github.com/pdcgo/warehouse_service/warehouse_query"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+// WarehouseFinanceService is legacy finance grpc server plus paginated list, account status, expense approval,
+// expense revision, expense soft delete, balance history, balance reconciliation, expense type, expense budget, export and account transfer.
+// legacy proto has no field or rpc for these, so these are served as separate method.
+type WarehouseFinanceService interface {
+	warehouse_iface.WarehouseFinanceServiceServer
+	ExpenseReportDailyDetail(ctx context.Context, query *ExpenseReportDailyQuery) (*ExpenseReportDailyDetailRes, error)
+	ExpenseAccountPage(ctx context.Context, query *ExpenseAccountQuery, page *ExpenseListPage) (*ExpenseAccountPageRes, error)
+	ExpenseAccountSetDisabled(ctx context.Context, payload *ExpenseAccountDisabledReq) (*warehouse_iface.WarehouseExpenseAccount, error)
+	ExpenseHistoryPage(ctx context.Context, query *ExpenseHistoryQuery, page *ExpenseListPage) (*ExpenseHistoryPageRes, error)
+	BalanceHistoryCreate(ctx context.Context, payload *BalanceHistoryCreateReq) (*warehouse_models.WareBalanceAccountHistory, error)
+	BalanceHistoryUpdate(ctx context.Context, payload *BalanceHistoryUpdateReq) (*warehouse_models.WareBalanceAccountHistory, error)
+	BalanceHistoryDelete(ctx context.Context, payload *BalanceHistoryDeleteReq) error
+	BalanceHistoryList(ctx context.Context, query *BalanceHistoryListReq) (*BalanceHistoryListRes, error)
+	ExpenseHistoryReview(ctx context.Context, payload *ExpenseHistoryReviewReq) (*warehouse_models.WareExpenseHistory, error)
+	ExpenseHistoryRevision(ctx context.Context, query *ExpenseHistoryRevisionReq) (*ExpenseHistoryRevisionRes, error)
+	ExpenseHistoryDelete(ctx context.Context, payload *ExpenseHistoryDeleteReq) (*warehouse_models.WareExpenseHistory, error)
+	ExpenseHistoryRestore(ctx context.Context, payload *ExpenseHistoryDeleteReq) (*warehouse_models.WareExpenseHistory, error)
+	AccountBalanceReconciliation(ctx context.Context, query *AccountReconciliationReq) (*AccountReconciliationRes, error)
+	ExpenseTypeList(ctx context.Context, query *ExpenseTypeListReq) (*ExpenseTypeListRes, error)
+	ExpenseTypeCreate(ctx context.Context, payload *ExpenseTypeSaveReq) (*warehouse_models.WareExpenseType, error)
+	ExpenseTypeUpdate(ctx context.Context, payload *ExpenseTypeSaveReq) (*warehouse_models.WareExpenseType, error)
+	ExpenseBudgetSet(ctx context.Context, payload *ExpenseBudgetSetReq) (*warehouse_models.WareExpenseBudget, error)
+	ExpenseBudgetReport(ctx context.Context, query *ExpenseBudgetReportReq) (*ExpenseBudgetReportRes, error)
+	ExpenseHistoryExport(ctx context.Context, query *ExpenseHistoryExportReq, out io.Writer) error
+	BalanceHistoryExport(ctx context.Context, query *BalanceHistoryExportReq, out io.Writer) error
+	ExpenseTransferCreate(ctx context.Context, payload *ExpenseTransferCreateReq) (*warehouse_models.WareExpenseTransfer, error)
+}
+
+type ExpenseSortField string
+
+const (
+	ExpenseSortAt        ExpenseSortField = "at"
+	ExpenseSortAmount    ExpenseSortField = "amount"
+	ExpenseSortCreatedAt ExpenseSortField = "created_at"
+)
+
+func (ExpenseSortField) EnumList() []string {
+	return []string{
+		"at",
+		"amount",
+		"created_at",
+	}
+}
+
+var ErrExpenseSortNotSupported = errors.New("expense sort not supported")
+
+// ExpenseListPage is paging and sorting of expense list. nil Page is returning all row.
+type ExpenseListPage struct {
+	Page     *common.PageFilter `json:"page"`
+	SortBy   ExpenseSortField   `json:"sort_by"`
+	SortDesc bool               `json:"sort_desc"`
+}
+
+type ExpenseTotal struct {
+	ExpenseType warehouse_models.ExpenseType `json:"expense_type"`
+	FlowType    warehouse_query.FlowType     `json:"flow_type"`
+	Count       int64                        `json:"count"`
+	Amount      float64                      `json:"amount"`
+}
+
+// ExpenseAccountQuery is legacy list request plus account status filter, empty Status is returning all account.
+type ExpenseAccountQuery struct {
+	*warehouse_iface.ExpenseAccountListReq
+	Status warehouse_query.AccountStatus `json:"status"`
+}
+
+// ExpenseHistoryQuery is legacy list request plus which timestamp StartDate and EndDate filtering,
+// default is business date at, approval status, empty is all status, and soft deleted expense, hidden by default.
+type ExpenseHistoryQuery struct {
+	*warehouse_iface.ExpenseHistoryListReq
+	TimeType       warehouse_query.WareExpenseTimeType `json:"time_type"`
+	Status         warehouse_models.ExpenseStatus      `json:"status"`
+	IncludeDeleted bool                                `json:"include_deleted"`
+}
+
+// ExpenseHistoryItem is full expense history row. legacy proto WarehouseExpenseHistory has no field
+// for note, at, created_by_id and created_at.
+type ExpenseHistoryItem struct {
+	warehouse_models.WareExpenseHistory
+	IsOpsAccount bool `json:"is_ops_account"`
+	// TransferAccountID is other account of transfer leg
+	TransferAccountID uint `json:"transfer_account_id,omitempty"`
+}
+
+func (item *ExpenseHistoryItem) ToProto() *warehouse_iface.WarehouseExpenseHistory {
+	return &warehouse_iface.WarehouseExpenseHistory{
+		Id:           uint64(item.ID),
+		AccountId:    uint64(item.AccountID),
+		WarehouseId:  uint64(item.WarehouseID),
+		IsOpsAccount: item.IsOpsAccount,
+		ExpenseType:  string(item.ExpenseType),
+		Amount:       item.Amount,
+	}
+}
+
+type ExpenseHistoryPageRes struct {
+	Data     []*ExpenseHistoryItem `json:"data"`
+	PageInfo *common.PageInfo      `json:"page_info"`
+	// Totals is over all matching row, not only current page
+	Totals []*ExpenseTotal `json:"totals"`
+}
+
+type ExpenseAccountPageRes struct {
+	Data     []*warehouse_iface.WarehouseExpenseAccount `json:"data"`
+	PageInfo *common.PageInfo                           `json:"page_info"`
+}
+
+func paginate(db *gorm.DB, query *gorm.DB, page *common.PageFilter) (*gorm.DB, *common.PageInfo, error) {
+	if page == nil {
+		return query, &common.PageInfo{CurrentPage: 1, TotalPage: 1}, nil
+	}
+
+	if page.Page < 1 {
+		page.Page = 1
+	}
+	if page.Limit < 1 {
+		page.Limit = 20
+	}
+
+	return db_connect.SetPaginationQuery(db, func() (*gorm.DB, error) {
+		return query.Session(&gorm.Session{}), nil
+	}, page)
+}
+
+// ExpenseAccountPage is ExpenseAccountList with paging, sortable only by created_at.
+func (w *warehouseFinImpl) ExpenseAccountPage(ctx context.Context, query *ExpenseAccountQuery, page *ExpenseListPage) (*ExpenseAccountPageRes, error) {
+	err := w.checkRead(ctx, &warehouse_models.WareExpenseAccount{}, query.WarehouseId)
+	if err != nil {
+		return nil, err
+	}
+	if page == nil {
+		page = &ExpenseListPage{}
+	}
+
+	db := w.db.WithContext(ctx)
+	accountQuery := warehouse_query.
+		NewWarehouseExpenseAccountQuery(db, false).
+		FromWarehouse(uint(query.WarehouseId))
+	if query.IsOpsAccount {
+		accountQuery = accountQuery.IsOpsAccount(query.IsOpsAccount)
+	}
+	sqlQuery := accountQuery.
+		JoinWareExpenseAccount("").
+		SearchNumberID(query.NumberId).
+		SearchName(query.Name).
+		Disabled(query.Status).
+		GetQuery()
+
+	order := "ware_expense_account_warehouses.id"
+	switch page.SortBy {
+	case "":
+	case ExpenseSortCreatedAt:
+		order = "ware_expense_accounts.created_at"
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrExpenseSortNotSupported, page.SortBy)
+	}
+	if page.SortDesc {
+		order += " desc"
+	}
+
+	result := ExpenseAccountPageRes{}
+	sqlQuery, result.PageInfo, err = paginate(db, sqlQuery, page.Page)
+	if err != nil {
+		return nil, err
+	}
+
+	data := []*warehouse_models.WareExpenseAccountWarehouse{}
+	err = sqlQuery.
+		Order(order).
+		Order("ware_expense_account_warehouses.id").
+		Preload("Account").
+		Find(&data).Error
+	if err != nil {
+		return nil, err
+	}
+
+	result.Data = make([]*warehouse_iface.WarehouseExpenseAccount, len(data))
+	for i, v := range data {
+		if v.Account == nil {
+			return nil, errors.New("account not found")
+		}
+
+		result.Data[i] = &warehouse_iface.WarehouseExpenseAccount{
+			Id:            uint64(v.AccountID),
+			WarehouseId:   uint64(v.WarehouseID),
+			AccountTypeId: uint64(v.Account.AccountTypeID),
+			Name:          v.Account.Name,
+			NumberId:      v.Account.NumberID,
+			Disabled:      v.Account.Disabled,
+			IsOpsAccount:  v.IsOpsAccount,
+			CreatedAt:     timestamppb.New(v.Account.CreatedAt),
+		}
+	}
+
+	if page.Page == nil {
+		result.PageInfo.TotalItems = int64(len(data))
+	}
+
+	return &result, nil
+}
+
+const transferAccountField = `COALESCE((
+	SELECT transfer_pair.account_id FROM ware_expense_histories transfer_pair
+	WHERE ware_expense_histories.transfer_id <> 0
+	AND transfer_pair.transfer_id = ware_expense_histories.transfer_id
+	AND transfer_pair.id <> ware_expense_histories.id
+), 0) as transfer_account_id`
+
+// expenseHistorySqlQuery is filter of expense history list, joined with account warehouse.
+func expenseHistorySqlQuery(db *gorm.DB, query *ExpenseHistoryQuery) (*gorm.DB, error) {
+	timeType := query.TimeType
+	if timeType == "" {
+		timeType = warehouse_query.WareExpenseTimeTypeAt
+	}
+
+	// day boundary is in warehouse timezone, not server
+	loc, err := warehouse_query.GetWarehouseLocation(db, uint(query.WarehouseId))
+	if err != nil {
+		return nil, err
+	}
+
+	var startDay, endDay time.Time
+	if query.StartDate != 0 {
+		unixMilli := time.UnixMilli(query.StartDate).In(loc)
+		startDay = time.Date(unixMilli.Year(), unixMilli.Month(), unixMilli.Day(), 0, 0, 0, 0, loc)
+	}
+	if query.EndDate != 0 {
+		unixMilli := time.UnixMilli(query.EndDate).In(loc)
+		endDay = time.Date(unixMilli.Year(), unixMilli.Month(), unixMilli.Day()+1, 0, 0, 0, -1, loc)
+	}
+
+	sqlQuery := warehouse_query.
+		NewWarehouseExpenseQuery(db, false).
+		FromWarehouse(uint(query.WarehouseId)).
+		FromAccount(uint(query.AccountId)).
+		WithType(warehouse_models.ExpenseType(query.ExpenseType)).
+		FilterTime(timeType, startDay, endDay).
+		WithStatus(query.Status).
+		IncludeDeleted(query.IncludeDeleted).
+		GetQuery().
+		Joins("JOIN ware_expense_account_warehouses ON ware_expense_account_warehouses.account_id = ware_expense_histories.account_id AND ware_expense_account_warehouses.warehouse_id = ware_expense_histories.warehouse_id").
+		Where("ware_expense_account_warehouses.is_ops_account = ?", query.IsOpsAccount)
+
+	// transfer with both leg in listing is shown once as its outcome leg
+	if query.AccountId == 0 {
+		sqlQuery = sqlQuery.Where(`NOT (ware_expense_histories.transfer_id <> 0 AND ware_expense_histories.amount > 0 AND EXISTS (
+			SELECT 1 FROM ware_expense_histories transfer_pair
+			JOIN ware_expense_account_warehouses transfer_pair_account ON transfer_pair_account.account_id = transfer_pair.account_id AND transfer_pair_account.warehouse_id = transfer_pair.warehouse_id
+			WHERE transfer_pair.transfer_id = ware_expense_histories.transfer_id
+			AND transfer_pair.id <> ware_expense_histories.id
+			AND transfer_pair.warehouse_id = ware_expense_histories.warehouse_id
+			AND transfer_pair_account.is_ops_account = ware_expense_account_warehouses.is_ops_account
+		))`)
+	}
+
+	return sqlQuery, nil
+}
+
+// ExpenseHistoryPage is ExpenseHistoryList with paging, sorting and totals per expense type and flow type.
+// default sort is newest at first.
+func (w *warehouseFinImpl) ExpenseHistoryPage(ctx context.Context, query *ExpenseHistoryQuery, page *ExpenseListPage) (*ExpenseHistoryPageRes, error) {
+	err := w.checkRead(ctx, &warehouse_models.WareExpenseHistory{}, query.WarehouseId)
+	if err != nil {
+		return nil, err
+	}
+	if page == nil {
+		page = &ExpenseListPage{
+			SortBy:   ExpenseSortAt,
+			SortDesc: true,
+		}
+	}
+
+	db := w.db.WithContext(ctx)
+	sqlQuery, err := expenseHistorySqlQuery(db, query)
+	if err != nil {
+		return nil, err
+	}
+
+	order := "ware_expense_histories.at"
+	switch page.SortBy {
+	case "", ExpenseSortAt:
+	case ExpenseSortAmount:
+		order = "ware_expense_histories.amount"
+	case ExpenseSortCreatedAt:
+		order = "ware_expense_histories.created_at"
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrExpenseSortNotSupported, page.SortBy)
+	}
+	idOrder := "ware_expense_histories.id"
+	if page.SortDesc {
+		order += " desc"
+		idOrder += " desc"
+	}
+
+	result := ExpenseHistoryPageRes{
+		Data:   []*ExpenseHistoryItem{},
+		Totals: []*ExpenseTotal{},
+	}
+
+	err = sqlQuery.
+		Session(&gorm.Session{}).
+		Joins(warehouse_query.ExpenseFlowJoin).
+		Select([]string{
+			"ware_expense_histories.expense_type",
+			warehouse_query.ExpenseFlowField + " as flow_type",
+			"COUNT(1) as count",
+			"SUM(ware_expense_histories.amount) as amount",
+		}).
+		Group("ware_expense_histories.expense_type").
+		Group(warehouse_query.ExpenseFlowField).
+		Order("ware_expense_histories.expense_type").
+		Order("flow_type").
+		Find(&result.Totals).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	sqlQuery, result.PageInfo, err = paginate(db, sqlQuery, page.Page)
+	if err != nil {
+		return nil, err
+	}
+
+	err = sqlQuery.
+		Select([]string{
+			"ware_expense_histories.*",
+			"ware_expense_account_warehouses.is_ops_account",
+			transferAccountField,
+		}).
+		Order(order).
+		Order(idOrder).
+		Find(&result.Data).Error
+	if err != nil {
+		return nil, err
+	}
+
+	if page.Page == nil {
+		result.PageInfo.TotalItems = int64(len(result.Data))
+	}
+
+	return &result, nil
+}
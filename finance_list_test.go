@@ -0,0 +1,197 @@
+package warehouse_service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pdcgo/event_source"
+	"github.com/pdcgo/schema/services/common/v1"
+	"github.com/pdcgo/shared/authorization"
+	"github.com/pdcgo/shared/db_models"
+	"github.com/pdcgo/shared/interfaces/warehouse_iface"
+	"github.com/pdcgo/shared/pkg/moretest"
+	"github.com/pdcgo/shared/pkg/moretest/moretest_mock"
+	"github.com/pdcgo/warehouse_service"
+	"github.com/pdcgo/warehouse_service/warehouse_models"
+	"github.com/pdcgo/warehouse_service/warehouse_query"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func testExpenseHistoryPage(t *testing.T, tx *gorm.DB) {
+	loc, err := time.LoadLocation(warehouse_models.DefaultWarehouseTimezone)
+	assert.NoError(t, err)
+
+	day1 := time.Date(2025, 1, 1, 10, 0, 0, 0, loc)
+	day2 := day1.AddDate(0, 0, 1)
+	day3 := day1.AddDate(0, 0, 2)
+
+	err = tx.AutoMigrate(
+		&warehouse_models.WareExpenseAccountWarehouse{},
+		&warehouse_models.WareExpenseHistory{},
+		&warehouse_models.WarehouseTimezone{},
+		&warehouse_models.WareExpenseType{},
+		&warehouse_models.WareExpenseTypeTeam{},
+	)
+	assert.NoError(t, err)
+
+	err = tx.Create(warehouse_models.DefaultExpenseTypes()).Error
+	assert.NoError(t, err)
+
+	err = tx.Create(&[]*warehouse_models.WareExpenseAccountWarehouse{
+		{AccountID: 1, WarehouseID: 1, IsOpsAccount: true},
+		{AccountID: 2, WarehouseID: 1, IsOpsAccount: false},
+	}).Error
+	assert.NoError(t, err)
+
+	err = tx.Create(&[]*warehouse_models.WareExpenseHistory{
+		{WarehouseID: 1, AccountID: 1, CreatedByID: 7, ExpenseType: warehouse_models.ExpenseTypeKitchen, Amount: -15_000, Note: "makan", At: day1, CreatedAt: day3},
+		{WarehouseID: 1, AccountID: 1, CreatedByID: 7, ExpenseType: warehouse_models.ExpenseTypeEquity, Amount: 50_000, Note: "modal", At: day2, CreatedAt: day2},
+		{WarehouseID: 1, AccountID: 1, CreatedByID: 8, ExpenseType: warehouse_models.ExpenseTypeKitchen, Amount: -5_000, At: day2, CreatedAt: day2},
+		{WarehouseID: 1, AccountID: 2, CreatedByID: 8, ExpenseType: warehouse_models.ExpenseTypeKitchen, Amount: -1_000, At: day2, CreatedAt: day2},
+	}).Error
+	assert.NoError(t, err)
+
+	service := warehouse_service.NewWarehouseFinanceService(tx, NewMockAuth(true), event_source.EmptySender)
+	ctx := context.WithValue(context.Background(), "identity", &authorization.JwtIdentity{
+		UserID: 7,
+		From:   db_models.WarehouseTeamType,
+	})
+
+	t.Run("returning every field", func(t *testing.T) {
+		result, err := service.ExpenseHistoryPage(ctx, &warehouse_service.ExpenseHistoryQuery{
+			ExpenseHistoryListReq: &warehouse_iface.ExpenseHistoryListReq{
+				WarehouseId:  1,
+				IsOpsAccount: true,
+			},
+		}, nil)
+		assert.NoError(t, err)
+		assert.Len(t, result.Data, 3)
+		assert.Equal(t, int64(3), result.PageInfo.TotalItems)
+
+		first := result.Data[len(result.Data)-1]
+		assert.Equal(t, "makan", first.Note)
+		assert.Equal(t, uint(7), first.CreatedByID)
+		assert.True(t, first.At.Equal(day1))
+		assert.True(t, first.CreatedAt.Equal(day3))
+		assert.True(t, first.IsOpsAccount)
+
+		assert.Equal(t, []*warehouse_service.ExpenseTotal{
+			{ExpenseType: warehouse_models.ExpenseTypeEquity, FlowType: warehouse_query.FlowTypeIncome, Count: 1, Amount: 50_000},
+			{ExpenseType: warehouse_models.ExpenseTypeKitchen, FlowType: warehouse_query.FlowTypeOutcome, Count: 2, Amount: -20_000},
+		}, result.Totals)
+	})
+
+	t.Run("filter by at", func(t *testing.T) {
+		result, err := service.ExpenseHistoryPage(ctx, &warehouse_service.ExpenseHistoryQuery{
+			ExpenseHistoryListReq: &warehouse_iface.ExpenseHistoryListReq{
+				WarehouseId:  1,
+				IsOpsAccount: true,
+				StartDate:    day2.UnixMilli(),
+				EndDate:      day2.UnixMilli(),
+			},
+			TimeType: warehouse_query.WareExpenseTimeTypeAt,
+		}, nil)
+		assert.NoError(t, err)
+		assert.Len(t, result.Data, 2)
+	})
+
+	t.Run("filter by created_at", func(t *testing.T) {
+		result, err := service.ExpenseHistoryPage(ctx, &warehouse_service.ExpenseHistoryQuery{
+			ExpenseHistoryListReq: &warehouse_iface.ExpenseHistoryListReq{
+				WarehouseId:  1,
+				IsOpsAccount: true,
+				StartDate:    day3.UnixMilli(),
+				EndDate:      day3.UnixMilli(),
+			},
+			TimeType: warehouse_query.WareExpenseTimeTypeCreatedAt,
+		}, nil)
+		assert.NoError(t, err)
+		assert.Len(t, result.Data, 1)
+		assert.Equal(t, "makan", result.Data[0].Note)
+	})
+
+	t.Run("paginated sort by amount", func(t *testing.T) {
+		result, err := service.ExpenseHistoryPage(ctx, &warehouse_service.ExpenseHistoryQuery{
+			ExpenseHistoryListReq: &warehouse_iface.ExpenseHistoryListReq{
+				WarehouseId:  1,
+				IsOpsAccount: true,
+			},
+		}, &warehouse_service.ExpenseListPage{
+			Page:   &common.PageFilter{Page: 1, Limit: 2},
+			SortBy: warehouse_service.ExpenseSortAmount,
+		})
+		assert.NoError(t, err)
+		assert.Len(t, result.Data, 2)
+		assert.Equal(t, float64(-15_000), result.Data[0].Amount)
+		assert.Equal(t, int64(3), result.PageInfo.TotalItems)
+		assert.Len(t, result.Totals, 2)
+
+		result, err = service.ExpenseHistoryPage(ctx, &warehouse_service.ExpenseHistoryQuery{
+			ExpenseHistoryListReq: &warehouse_iface.ExpenseHistoryListReq{
+				WarehouseId:  1,
+				IsOpsAccount: true,
+			},
+		}, &warehouse_service.ExpenseListPage{
+			Page:     &common.PageFilter{Page: 2, Limit: 2},
+			SortBy:   warehouse_service.ExpenseSortAmount,
+			SortDesc: true,
+		})
+		assert.NoError(t, err)
+		assert.Len(t, result.Data, 1)
+		assert.Equal(t, float64(-15_000), result.Data[0].Amount)
+		assert.Equal(t, int64(2), result.PageInfo.TotalPage)
+		assert.Len(t, result.Totals, 2)
+	})
+
+	t.Run("unsupported sort", func(t *testing.T) {
+		_, err := service.ExpenseHistoryPage(ctx, &warehouse_service.ExpenseHistoryQuery{
+			ExpenseHistoryListReq: &warehouse_iface.ExpenseHistoryListReq{
+				WarehouseId:  1,
+				IsOpsAccount: true,
+			},
+		}, &warehouse_service.ExpenseListPage{
+			SortBy: "note",
+		})
+		assert.ErrorIs(t, err, warehouse_service.ErrExpenseSortNotSupported)
+	})
+
+	t.Run("legacy list", func(t *testing.T) {
+		result, err := service.ExpenseHistoryList(ctx, &warehouse_iface.ExpenseHistoryListReq{
+			WarehouseId:  1,
+			IsOpsAccount: false,
+		})
+		assert.NoError(t, err)
+		assert.Len(t, result.Data, 1)
+		assert.Equal(t, uint64(2), result.Data[0].AccountId)
+	})
+}
+
+func TestExpenseHistoryPage(t *testing.T) {
+	var dbScenario moretest_mock.DbScenario
+
+	moretest.Suite(t, "testing expense history page",
+		moretest.SetupListFunc{
+			moretest_mock.MockPostgresDatabase(&dbScenario),
+		},
+		func(t *testing.T) {
+			dbScenario(t, func(tx *gorm.DB) {
+				testExpenseHistoryPage(t, tx)
+			})
+		},
+	)
+}
+
+func TestExpenseHistoryPageSqlite(t *testing.T) {
+	var db gorm.DB
+
+	moretest.Suite(t, "testing expense history page sqlite",
+		moretest.SetupListFunc{
+			moretest_mock.MockSqliteDatabase(&db),
+		},
+		func(t *testing.T) {
+			testExpenseHistoryPage(t, &db)
+		},
+	)
+}
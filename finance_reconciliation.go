@@ -0,0 +1,209 @@
+package warehouse_service
+
+import (
+	"cmp"
+	"context"
+	"math"
+	"slices"
+	"time"
+
+	"github.com/pdcgo/warehouse_service/warehouse_models"
+	"github.com/pdcgo/warehouse_service/warehouse_query"
+	"gorm.io/gorm"
+)
+
+// difference under this is float rounding, not mismatch
+const balanceReconciliationTolerance = 0.01
+
+// AccountReconciliationReq StartDate and EndDate is unix milli, taken as day in warehouse timezone.
+// empty AccountId is every account of warehouse.
+type AccountReconciliationReq struct {
+	WarehouseId  uint64 `json:"warehouse_id"`
+	AccountId    uint64 `json:"account_id"`
+	StartDate    int64  `json:"start_date"`
+	EndDate      int64  `json:"end_date"`
+	MismatchOnly bool   `json:"mismatch_only"`
+}
+
+// AccountReconciliation is recorded balance of account at one day compared with previous recorded balance
+// plus approved expense flow since then. first recorded balance of account has no previous, so it is not checked.
+type AccountReconciliation struct {
+	AccountID       uint    `json:"account_id"`
+	Day             string  `json:"day"`
+	PreviousDay     string  `json:"previous_day"`
+	PreviousBalance float64 `json:"previous_balance"`
+	Income          float64 `json:"income"`
+	Outcome         float64 `json:"outcome"`
+	ExpectedBalance float64 `json:"expected_balance"`
+	RecordedBalance float64 `json:"recorded_balance"`
+	Difference      float64 `json:"difference"`
+	Mismatch        bool    `json:"mismatch"`
+}
+
+type AccountReconciliationRes struct {
+	WarehouseID   uint                     `json:"warehouse_id"`
+	Timezone      string                   `json:"timezone"`
+	CheckedCount  int                      `json:"checked_count"`
+	MismatchCount int                      `json:"mismatch_count"`
+	Data          []*AccountReconciliation `json:"data"`
+}
+
+type accountDailyFlow struct {
+	Day       string
+	AccountID uint
+	Amount    float64
+}
+
+// AccountBalanceReconciliation is reconciliation of account recorded balance against expense flow.
+func (w *warehouseFinImpl) AccountBalanceReconciliation(ctx context.Context, query *AccountReconciliationReq) (*AccountReconciliationRes, error) {
+	err := w.checkRead(ctx, &warehouse_models.WareExpenseAccount{}, query.WarehouseId)
+	if err != nil {
+		return nil, err
+	}
+
+	return ReconcileAccountBalance(w.db.WithContext(ctx), query)
+}
+
+// ReconcileAccountBalance compute expected closing balance of each recorded balance day from previous recorded
+// balance plus income minus outcome in between, and flag difference against recorded balance.
+func ReconcileAccountBalance(db *gorm.DB, query *AccountReconciliationReq) (*AccountReconciliationRes, error) {
+	var err error
+	loc, err := warehouse_query.GetWarehouseLocation(db, uint(query.WarehouseId))
+	if err != nil {
+		return nil, err
+	}
+
+	var startDay, endDay string
+	var endTime time.Time
+	if query.StartDate != 0 {
+		startDay = time.UnixMilli(query.StartDate).In(loc).Format("2006-01-02")
+	}
+	if query.EndDate != 0 {
+		unixMilli := time.UnixMilli(query.EndDate).In(loc)
+		endDay = unixMilli.Format("2006-01-02")
+		endTime = time.Date(unixMilli.Year(), unixMilli.Month(), unixMilli.Day()+1, 0, 0, 0, -1, loc)
+	}
+
+	// every balance up to end is needed, previous balance of first day in range can be before start
+	balances := []*balanceDaily{}
+	err = warehouse_query.
+		NewWarehouseBalanceHistQuery(db, false).
+		FromWarehouse(uint(query.WarehouseId)).
+		FromAccount(uint(query.AccountId)).
+		BalanceTime(time.Time{}, endTime).
+		GetQuery().
+		Select([]string{
+			warehouse_query.DayField(db, "ware_balance_account_histories.at", loc) + " as day",
+			"ware_balance_account_histories.account_id",
+			"ware_balance_account_histories.amount",
+		}).
+		Order("ware_balance_account_histories.account_id asc").
+		Order("ware_balance_account_histories.at asc").
+		Find(&balances).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	// flow per account per day, outcome negated so spending is positive
+	flows := map[warehouse_query.FlowType]map[uint]map[string]float64{}
+	for _, flowType := range []warehouse_query.FlowType{
+		warehouse_query.FlowTypeIncome,
+		warehouse_query.FlowTypeOutcome,
+	} {
+		dayField := warehouse_query.DayField(db, "ware_expense_histories.at", loc)
+		dailyFlows := []*accountDailyFlow{}
+
+		err = warehouse_query.
+			NewWarehouseExpenseQuery(db, false).
+			FromWarehouse(uint(query.WarehouseId)).
+			FromAccount(uint(query.AccountId)).
+			FlowType(flowType).
+			WithStatus(warehouse_models.ExpenseStatusApproved).
+			IncludeDeleted(false).
+			GetQuery().
+			Select([]string{
+				dayField + " as day",
+				"ware_expense_histories.account_id",
+				"SUM(" + warehouse_query.ExpenseFlowAmountField + ") as amount",
+			}).
+			Group(dayField).
+			Group("ware_expense_histories.account_id").
+			Find(&dailyFlows).
+			Error
+		if err != nil {
+			return nil, err
+		}
+
+		flows[flowType] = map[uint]map[string]float64{}
+		for _, flow := range dailyFlows {
+			if flows[flowType][flow.AccountID] == nil {
+				flows[flowType][flow.AccountID] = map[string]float64{}
+			}
+			flows[flowType][flow.AccountID][flow.Day] += flow.Amount
+		}
+	}
+
+	// sum of flow of account in day range (fromDay, toDay]
+	sumFlow := func(flowType warehouse_query.FlowType, accountID uint, fromDay, toDay string) float64 {
+		var amount float64
+		for day, dayAmount := range flows[flowType][accountID] {
+			if day > fromDay && day <= toDay {
+				amount += dayAmount
+			}
+		}
+		return amount
+	}
+
+	result := AccountReconciliationRes{
+		WarehouseID: uint(query.WarehouseId),
+		Timezone:    loc.String(),
+		Data:        []*AccountReconciliation{},
+	}
+
+	var last *balanceDaily
+	for _, balance := range balances {
+		previous := last
+		last = balance
+		if previous == nil || previous.AccountID != balance.AccountID {
+			continue
+		}
+		if startDay != "" && balance.Day < startDay {
+			continue
+		}
+		if endDay != "" && balance.Day > endDay {
+			continue
+		}
+
+		item := AccountReconciliation{
+			AccountID:       balance.AccountID,
+			Day:             balance.Day,
+			PreviousDay:     previous.Day,
+			PreviousBalance: previous.Amount,
+			Income:          sumFlow(warehouse_query.FlowTypeIncome, balance.AccountID, previous.Day, balance.Day),
+			Outcome:         sumFlow(warehouse_query.FlowTypeOutcome, balance.AccountID, previous.Day, balance.Day),
+			RecordedBalance: balance.Amount,
+		}
+		item.ExpectedBalance = item.PreviousBalance + item.Income - item.Outcome
+		item.Difference = item.RecordedBalance - item.ExpectedBalance
+		item.Mismatch = math.Abs(item.Difference) >= balanceReconciliationTolerance
+
+		result.CheckedCount++
+		if item.Mismatch {
+			result.MismatchCount++
+		}
+		if query.MismatchOnly && !item.Mismatch {
+			continue
+		}
+		result.Data = append(result.Data, &item)
+	}
+
+	slices.SortStableFunc(result.Data, func(a, b *AccountReconciliation) int {
+		return cmp.Or(
+			cmp.Compare(a.Day, b.Day),
+			cmp.Compare(a.AccountID, b.AccountID),
+		)
+	})
+
+	return &result, nil
+}
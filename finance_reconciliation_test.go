@@ -0,0 +1,115 @@
+package warehouse_service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pdcgo/event_source"
+	"github.com/pdcgo/shared/authorization"
+	"github.com/pdcgo/shared/db_models"
+	"github.com/pdcgo/shared/pkg/moretest"
+	"github.com/pdcgo/shared/pkg/moretest/moretest_mock"
+	"github.com/pdcgo/warehouse_service"
+	"github.com/pdcgo/warehouse_service/warehouse_models"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestAccountBalanceReconciliation(t *testing.T) {
+	var db gorm.DB
+
+	moretest.Suite(t, "testing account balance reconciliation",
+		moretest.SetupListFunc{
+			moretest_mock.MockSqliteDatabase(&db),
+			func(t *testing.T) func() error {
+				err := db.AutoMigrate(
+					&warehouse_models.WareExpenseHistory{},
+					&warehouse_models.WareBalanceAccountHistory{},
+					&warehouse_models.WarehouseTimezone{},
+					&warehouse_models.WareExpenseType{},
+					&warehouse_models.WareExpenseTypeTeam{},
+				)
+				assert.Nil(t, err)
+
+				err = db.Create(warehouse_models.DefaultExpenseTypes()).Error
+				assert.Nil(t, err)
+
+				return nil
+			},
+		},
+		func(t *testing.T) {
+			loc, err := time.LoadLocation(warehouse_models.DefaultWarehouseTimezone)
+			assert.Nil(t, err)
+
+			day1 := time.Date(2025, 1, 1, 0, 0, 0, 0, loc)
+			day2 := day1.AddDate(0, 0, 1)
+			day3 := day1.AddDate(0, 0, 2)
+			day4 := day1.AddDate(0, 0, 3)
+
+			err = db.Create(&[]*warehouse_models.WareBalanceAccountHistory{
+				{WarehouseID: 1, AccountID: 1, Amount: 100_000, At: day1},
+				{WarehouseID: 1, AccountID: 1, Amount: 130_000, At: day2},
+				// day3 has no balance, flow carried to day4
+				{WarehouseID: 1, AccountID: 1, Amount: 120_000, At: day4},
+				{WarehouseID: 1, AccountID: 2, Amount: 10_000, At: day1},
+			}).Error
+			assert.Nil(t, err)
+
+			err = db.Create(&[]*warehouse_models.WareExpenseHistory{
+				{WarehouseID: 1, AccountID: 1, ExpenseType: warehouse_models.ExpenseTypeEquity, Amount: 50_000, At: day2.Add(9 * time.Hour)},
+				{WarehouseID: 1, AccountID: 1, ExpenseType: warehouse_models.ExpenseTypeKitchen, Amount: -20_000, At: day2.Add(10 * time.Hour)},
+				{WarehouseID: 1, AccountID: 1, ExpenseType: warehouse_models.ExpenseTypeKitchen, Amount: -5_000, At: day3.Add(10 * time.Hour)},
+				{WarehouseID: 1, AccountID: 1, ExpenseType: warehouse_models.ExpenseTypeTransport, Amount: -3_000, At: day4.Add(10 * time.Hour)},
+			}).Error
+			assert.Nil(t, err)
+
+			service := warehouse_service.NewWarehouseFinanceService(&db, NewMockAuth(true), event_source.EmptySender)
+			ctx := context.WithValue(context.Background(), "identity", &authorization.JwtIdentity{
+				UserID: 1,
+				From:   db_models.WarehouseTeamType,
+			})
+
+			result, err := service.AccountBalanceReconciliation(ctx, &warehouse_service.AccountReconciliationReq{
+				WarehouseId: 1,
+			})
+			assert.Nil(t, err)
+			assert.Equal(t, 2, result.CheckedCount)
+			assert.Equal(t, 1, result.MismatchCount)
+			assert.Len(t, result.Data, 2)
+
+			t.Run("balance explained by flow", func(t *testing.T) {
+				item := result.Data[0]
+				assert.Equal(t, "2025-01-02", item.Day)
+				assert.Equal(t, "2025-01-01", item.PreviousDay)
+				assert.Equal(t, float64(50_000), item.Income)
+				assert.Equal(t, float64(20_000), item.Outcome)
+				assert.Equal(t, float64(130_000), item.ExpectedBalance)
+				assert.False(t, item.Mismatch)
+			})
+
+			t.Run("balance not explained by flow", func(t *testing.T) {
+				item := result.Data[1]
+				assert.Equal(t, "2025-01-04", item.Day)
+				assert.Equal(t, float64(8_000), item.Outcome)
+				assert.Equal(t, float64(122_000), item.ExpectedBalance)
+				assert.Equal(t, float64(-2_000), item.Difference)
+				assert.True(t, item.Mismatch)
+			})
+
+			t.Run("mismatch only in range", func(t *testing.T) {
+				result, err := service.AccountBalanceReconciliation(ctx, &warehouse_service.AccountReconciliationReq{
+					WarehouseId:  1,
+					AccountId:    1,
+					StartDate:    day3.UnixMilli(),
+					EndDate:      day4.UnixMilli(),
+					MismatchOnly: true,
+				})
+				assert.Nil(t, err)
+				assert.Equal(t, 1, result.CheckedCount)
+				assert.Len(t, result.Data, 1)
+				assert.Equal(t, "2025-01-02", result.Data[0].PreviousDay)
+			})
+		},
+	)
+}
@@ -3,36 +3,96 @@ package warehouse_service
 import (
 	"context"
 	"errors"
+	"log/slog"
+	"slices"
 	"strings"
 	"time"
 
+	"github.com/pdcgo/event_source"
 	"github.com/pdcgo/shared/authorization"
 	"github.com/pdcgo/shared/interfaces/authorization_iface"
 	"github.com/pdcgo/shared/interfaces/warehouse_iface"
-
 	"github.com/pdcgo/warehouse_service/warehouse_models"
 	"github.com/pdcgo/warehouse_service/warehouse_mutations"
+	"github.com/pdcgo/warehouse_service/warehouse_query"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"gorm.io/gorm"
 )
 
-func NewWarehouseFinanceService(db *gorm.DB, auth authorization_iface.Authorization) warehouse_iface.WarehouseFinanceServiceServer {
+func NewWarehouseFinanceService(db *gorm.DB, auth authorization_iface.Authorization, eventSender event_source.EventSender) WarehouseFinanceService {
 	return &warehouseFinImpl{
-		db:   db,
-		auth: auth,
+		db:          db,
+		auth:        auth,
+		eventSender: eventSender,
 	}
 }
 
 type warehouseFinImpl struct {
 	warehouse_iface.UnimplementedWarehouseFinanceServiceServer
-	db   *gorm.DB
-	auth authorization_iface.Authorization
+	db          *gorm.DB
+	auth        authorization_iface.Authorization
+	eventSender event_source.EventSender
+}
+
+var ErrIdentityNotFound = errors.New("identity not found in context")
+
+func getIdentity(ctx context.Context) (*authorization.JwtIdentity, error) {
+	identity, ok := ctx.Value("identity").(*authorization.JwtIdentity)
+	if !ok || identity == nil {
+		return nil, ErrIdentityNotFound
+	}
+	return identity, nil
+}
+
+// checkRead is read permission of entity in warehouse.
+func (w *warehouseFinImpl) checkRead(ctx context.Context, entity authorization_iface.Entity, warehouseID uint64) error {
+	identity, err := getIdentity(ctx)
+	if err != nil {
+		return err
+	}
+	return checkWarehouse(ctx, w.auth, identity, entity, 0, uint(warehouseID), authorization_iface.Read)
+}
+
+var ErrWarehouseMismatch = errors.New("data is not in requested warehouse")
+
+// checkWarehouse is permission of action on entity in warehouse of the data. domainID is warehouse sent by client,
+// 0 when client doesn't send it, and rejected when it is other warehouse.
+func checkWarehouse(
+	ctx context.Context,
+	auth authorization_iface.Authorization,
+	identity *authorization.JwtIdentity,
+	entity authorization_iface.Entity,
+	domainID uint,
+	warehouseID uint,
+	action authorization_iface.Action,
+) error {
+	if domainID != 0 && domainID != warehouseID {
+		return ErrWarehouseMismatch
+	}
+	return hasPermission(ctx, auth, identity, authorization_iface.CheckPermissionGroup{
+		entity: &authorization_iface.CheckPermission{
+			DomainID: warehouseID,
+			Actions:  []authorization_iface.Action{action},
+		},
+	})
+}
+
+func (w *warehouseFinImpl) hasPermission(ctx context.Context, identity *authorization.JwtIdentity, perms authorization_iface.CheckPermissionGroup) error {
+	return hasPermission(ctx, w.auth, identity, perms)
+}
+
+// hasPermission is legacy authorization check, for grpc and connect request alike.
+func hasPermission(ctx context.Context, auth authorization_iface.Authorization, identity *authorization.JwtIdentity, perms authorization_iface.CheckPermissionGroup) error {
+	return auth.HasPermission(identity, perms)
 }
 
 // ExpenseAccountCreate implements warehouse_iface.WarehouseFinanceServiceServer.
 func (w *warehouseFinImpl) ExpenseAccountCreate(ctx context.Context, payload *warehouse_iface.ExpenseAccountCreateReq) (*warehouse_iface.WarehouseExpenseAccount, error) {
-	identity := ctx.Value("identity").(*authorization.JwtIdentity)
-	err := w.auth.HasPermission(identity, authorization_iface.CheckPermissionGroup{
+	identity, err := getIdentity(ctx)
+	if err != nil {
+		return nil, err
+	}
+	err = w.hasPermission(ctx, identity, authorization_iface.CheckPermissionGroup{
 		&warehouse_models.WareExpenseAccount{}: &authorization_iface.CheckPermission{
 			DomainID: uint(payload.DomainId),
 			Actions:  []authorization_iface.Action{authorization_iface.Create},
@@ -78,8 +138,11 @@ func (w *warehouseFinImpl) ExpenseAccountCreate(ctx context.Context, payload *wa
 
 // ExpenseAccountEdit implements warehouse_iface.WarehouseFinanceServiceServer.
 func (w *warehouseFinImpl) ExpenseAccountEdit(ctx context.Context, payload *warehouse_iface.ExpenseAccountEditReq) (*warehouse_iface.WarehouseExpenseAccount, error) {
-	identity := ctx.Value("identity").(*authorization.JwtIdentity)
-	err := w.auth.HasPermission(identity, authorization_iface.CheckPermissionGroup{
+	identity, err := getIdentity(ctx)
+	if err != nil {
+		return nil, err
+	}
+	err = w.hasPermission(ctx, identity, authorization_iface.CheckPermissionGroup{
 		&warehouse_models.WareExpenseAccount{}: &authorization_iface.CheckPermission{
 			DomainID: uint(payload.DomainId),
 			Actions:  []authorization_iface.Action{authorization_iface.Update},
@@ -129,11 +192,80 @@ func (w *warehouseFinImpl) ExpenseAccountEdit(ctx context.Context, payload *ware
 	return result, nil
 }
 
+// ExpenseAccountDisabledReq is disable or re-enable account request. legacy proto has no rpc for this.
+type ExpenseAccountDisabledReq struct {
+	DomainId    uint64 `json:"domain_id"`
+	AccountId   uint64 `json:"account_id"`
+	WarehouseId uint64 `json:"warehouse_id"`
+	Disabled    bool   `json:"disabled"`
+	Note        string `json:"note"`
+}
+
+// ExpenseAccountSetDisabled disable or re-enable account, status change is kept in account status log.
+// disabled flag is on account shared by every warehouse using it, so it need admin permission on root domain.
+func (w *warehouseFinImpl) ExpenseAccountSetDisabled(ctx context.Context, payload *ExpenseAccountDisabledReq) (*warehouse_iface.WarehouseExpenseAccount, error) {
+	identity, err := getIdentity(ctx)
+	if err != nil {
+		return nil, err
+	}
+	err = w.hasPermission(ctx, identity, authorization_iface.CheckPermissionGroup{
+		&warehouse_models.WareExpenseAccount{}: &authorization_iface.CheckPermission{
+			DomainID: authorization.RootDomain,
+			Actions:  []authorization_iface.Action{authorization_iface.Update},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var result *warehouse_iface.WarehouseExpenseAccount
+	db := w.db.WithContext(ctx)
+	err = db.Transaction(func(tx *gorm.DB) error {
+		accountService := warehouse_mutations.NewExpenseAccountService(tx, uint(payload.WarehouseId))
+		data, err := accountService.
+			GetByQuery(true, func(tx *gorm.DB) *gorm.DB {
+				return tx.Where("ware_expense_accounts.id = ?", payload.AccountId)
+			})
+		if err != nil {
+			return err
+		}
+
+		if data.Account.Disabled != payload.Disabled {
+			err = accountService.Disabled(identity.UserID, payload.Disabled, strings.Trim(payload.Note, " "))
+			if err != nil {
+				return err
+			}
+		}
+
+		result = &warehouse_iface.WarehouseExpenseAccount{
+			Id:            uint64(data.Account.ID),
+			WarehouseId:   uint64(data.WarehouseID),
+			AccountTypeId: uint64(data.Account.AccountTypeID),
+			Disabled:      data.Account.Disabled,
+			Name:          data.Account.Name,
+			NumberId:      data.Account.NumberID,
+			IsOpsAccount:  data.IsOpsAccount,
+			CreatedAt:     timestamppb.New(data.Account.CreatedAt),
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
 // ExpenseAccountGet implements warehouse_iface.WarehouseFinanceServiceServer.
 func (w *warehouseFinImpl) ExpenseAccountGet(ctx context.Context, query *warehouse_iface.ExpenseAccountGetReq) (*warehouse_iface.WarehouseExpenseAccount, error) {
+	err := w.checkRead(ctx, &warehouse_models.WareExpenseAccount{}, query.WarehouseId)
+	if err != nil {
+		return nil, err
+	}
 
 	var result *warehouse_iface.WarehouseExpenseAccount
-	err := w.db.Transaction(func(tx *gorm.DB) error {
+	err = w.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
 		accountService := warehouse_mutations.NewExpenseAccountService(tx, uint(query.WarehouseId))
 		data, err := accountService.
 			GetByQuery(false, func(tx *gorm.DB) *gorm.DB {
@@ -151,6 +283,7 @@ func (w *warehouseFinImpl) ExpenseAccountGet(ctx context.Context, query *warehou
 			NumberId:     data.Account.NumberID,
 			Name:         data.Account.Name,
 			IsOpsAccount: data.IsOpsAccount,
+			Disabled:     data.Account.Disabled,
 			WarehouseId:  uint64(query.WarehouseId),
 			CreatedAt:    timestamppb.New(data.Account.CreatedAt),
 		}
@@ -166,60 +299,36 @@ func (w *warehouseFinImpl) ExpenseAccountGet(ctx context.Context, query *warehou
 
 // ExpenseAccountList implements warehouse_iface.WarehouseFinanceServiceServer.
 func (w *warehouseFinImpl) ExpenseAccountList(ctx context.Context, query *warehouse_iface.ExpenseAccountListReq) (*warehouse_iface.ExpenseAccountListRes, error) {
-	data := []*warehouse_models.WareExpenseAccountWarehouse{}
-
-	db := w.db.WithContext(ctx)
-	sqlQuery := db.Model(&warehouse_models.WareExpenseAccountWarehouse{}).
-		Joins("JOIN ware_expense_accounts ON ware_expense_accounts.id = ware_expense_account_warehouses.account_id")
-	if query.WarehouseId != 0 {
-		sqlQuery = sqlQuery.Where("ware_expense_account_warehouses.warehouse_id = ?", query.WarehouseId)
-	}
-	if query.NumberId != "" {
-		sqlQuery = sqlQuery.Where("ware_expense_accounts.number_id LIKE ?", "%"+query.NumberId+"%")
-	}
-	if query.Name != "" {
-		sqlQuery = sqlQuery.Where("LOWER(ware_expense_accounts.name) LIKE ?", "%"+query.Name+"%")
-	}
-	if query.IsOpsAccount {
-		sqlQuery = sqlQuery.Where("ware_expense_account_warehouses.is_ops_account = ?", query.IsOpsAccount)
-	}
-
-	err := sqlQuery.
-		Preload("Account").
-		Find(&data).Error
+	err := w.checkRead(ctx, &warehouse_models.WareExpenseAccount{}, query.WarehouseId)
 	if err != nil {
 		return nil, err
 	}
 
-	results := warehouse_iface.ExpenseAccountListRes{
-		Data: make([]*warehouse_iface.WarehouseExpenseAccount, len(data)),
-	}
-	for i, v := range data {
-		if v.Account == nil {
-			return nil, errors.New("account not found")
-		}
-
-		results.Data[i] = &warehouse_iface.WarehouseExpenseAccount{
-			Id:            uint64(v.AccountID),
-			WarehouseId:   uint64(v.WarehouseID),
-			AccountTypeId: uint64(v.Account.AccountTypeID),
-			Name:          v.Account.Name,
-			NumberId:      v.Account.NumberID,
-			Disabled:      v.Account.Disabled,
-			IsOpsAccount:  v.IsOpsAccount,
-			CreatedAt:     timestamppb.New(v.Account.CreatedAt),
-		}
+	page, err := w.ExpenseAccountPage(ctx, &ExpenseAccountQuery{ExpenseAccountListReq: query}, nil)
+	if err != nil {
+		return nil, err
 	}
 
-	return &results, err
+	return &warehouse_iface.ExpenseAccountListRes{
+		Data: page.Data,
+	}, nil
 }
 
-// ExpenseHistoryAdd implements warehouse_iface.WarehouseFinanceServiceServer.
+// ExpenseHistoryAdd implements warehouse_iface.WarehouseFinanceServiceServer. expense crossing budget
+// is returned as warning message and published as budget alert event.
 func (w *warehouseFinImpl) ExpenseHistoryAdd(ctx context.Context, payload *warehouse_iface.ExpenseHistoryAddReq) (*warehouse_iface.ExpenseHistoryAddRes, error) {
-	identity := ctx.Value("identity").(*authorization.JwtIdentity)
+	identity, err := getIdentity(ctx)
+	if err != nil {
+		return nil, err
+	}
+	err = checkWarehouse(ctx, w.auth, identity, &warehouse_models.WareExpenseHistory{}, 0, uint(payload.WarehouseId), authorization_iface.Create)
+	if err != nil {
+		return nil, err
+	}
 
+	var alert *warehouse_mutations.BudgetAlert
 	db := w.db.WithContext(ctx)
-	err := db.Transaction(func(tx *gorm.DB) error {
+	err = db.Transaction(func(tx *gorm.DB) error {
 		histService := warehouse_mutations.NewExpenseHistService(tx, identity)
 
 		_, err := histService.GetAccount(uint(payload.AccountId), uint(payload.WarehouseId))
@@ -227,35 +336,68 @@ func (w *warehouseFinImpl) ExpenseHistoryAdd(ctx context.Context, payload *wareh
 			return err
 		}
 
-		return histService.
+		err = histService.
 			Create(identity.From, &warehouse_mutations.CreateExpensePayload{
 				ExpenseType: warehouse_models.ExpenseType(payload.ExpenseType),
 				At:          payload.At.AsTime(),
 				Amount:      payload.Amount,
 				Note:        payload.Note,
 			})
+		if err != nil {
+			return err
+		}
+
+		alert = histService.BudgetAlert()
+		return nil
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	return &warehouse_iface.ExpenseHistoryAddRes{}, nil
+	result := warehouse_iface.ExpenseHistoryAddRes{}
+	if alert != nil {
+		result.Message = alert.Message()
+
+		// expense is already saved, failed alert is only logged
+		err = sendBudgetAlert(ctx, w.eventSender, alert)
+		if err != nil {
+			slog.Error("send expense budget alert failed", "expense_hist_id", alert.ExpenseHistID, "err", err.Error())
+		}
+	}
+
+	return &result, nil
 }
 
-// ExpenseHistoryAdd implements warehouse_iface.WarehouseFinanceServiceServer.
+// ExpenseHistoryEdit implements warehouse_iface.WarehouseFinanceServiceServer. same as ExpenseHistoryAdd, edit
+// crossing budget is returned as warning message and published as budget alert event.
 func (w *warehouseFinImpl) ExpenseHistoryEdit(ctx context.Context, payload *warehouse_iface.ExpenseHistoryEditReq) (*warehouse_iface.ExpenseHistoryEditRes, error) {
-	identity := ctx.Value("identity").(*authorization.JwtIdentity)
+	identity, err := getIdentity(ctx)
+	if err != nil {
+		return nil, err
+	}
 
+	var alert *warehouse_mutations.BudgetAlert
 	db := w.db.WithContext(ctx)
-	err := db.Transaction(func(tx *gorm.DB) error {
+	err = db.Transaction(func(tx *gorm.DB) error {
 		histService := warehouse_mutations.NewExpenseHistService(tx, identity)
 
-		_, err := histService.GetExpense(uint(payload.HistId))
+		expense, err := histService.GetExpense(uint(payload.HistId))
 		if err != nil {
 			return err
 		}
+		err = checkWarehouse(ctx, w.auth, identity, &warehouse_models.WareExpenseHistory{}, 0, expense.WarehouseID, authorization_iface.Update)
+		if err != nil {
+			return err
+		}
+		// moving to other warehouse need permission there too
+		if payload.WarehouseId != uint64(expense.WarehouseID) {
+			err = checkWarehouse(ctx, w.auth, identity, &warehouse_models.WareExpenseHistory{}, 0, uint(payload.WarehouseId), authorization_iface.Update)
+			if err != nil {
+				return err
+			}
+		}
 
-		return histService.
+		err = histService.
 			Update(identity.From, &warehouse_mutations.UpdateWareExpenseHistPayload{
 				WarehouseID: uint(payload.WarehouseId),
 				AccountID:   uint(payload.AccountId),
@@ -264,64 +406,500 @@ func (w *warehouseFinImpl) ExpenseHistoryEdit(ctx context.Context, payload *ware
 				At:          payload.At.AsTime(),
 				Note:        payload.Note,
 			})
+		if err != nil {
+			return err
+		}
+
+		alert = histService.BudgetAlert()
+		return nil
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	return &warehouse_iface.ExpenseHistoryEditRes{}, nil
+	result := warehouse_iface.ExpenseHistoryEditRes{}
+	if alert != nil {
+		result.Message = alert.Message()
+
+		// expense is already saved, failed alert is only logged
+		err = sendBudgetAlert(ctx, w.eventSender, alert)
+		if err != nil {
+			slog.Error("send expense budget alert failed", "expense_hist_id", alert.ExpenseHistID, "err", err.Error())
+		}
+	}
+
+	return &result, nil
+}
+
+// ExpenseHistoryReviewReq is admin approving or rejecting pending expense. legacy proto has no rpc for this.
+// DomainId is warehouse of the expense, optional and rejected when it is other warehouse.
+type ExpenseHistoryReviewReq struct {
+	DomainId uint64 `json:"domain_id"`
+	HistId   uint64 `json:"hist_id"`
+	Approve  bool   `json:"approve"`
+	Note     string `json:"note"`
+}
+
+// ExpenseHistoryReview approve or reject pending expense, only from admin team.
+func (w *warehouseFinImpl) ExpenseHistoryReview(ctx context.Context, payload *ExpenseHistoryReviewReq) (*warehouse_models.WareExpenseHistory, error) {
+	identity, err := getIdentity(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var result *warehouse_models.WareExpenseHistory
+	db := w.db.WithContext(ctx)
+	err = db.Transaction(func(tx *gorm.DB) error {
+		histService := warehouse_mutations.NewExpenseHistService(tx, identity)
+
+		result, err = histService.GetExpense(uint(payload.HistId))
+		if err != nil {
+			return err
+		}
+		err = checkWarehouse(ctx, w.auth, identity, &warehouse_models.WareExpenseHistory{}, uint(payload.DomainId), result.WarehouseID, authorization_iface.Update)
+		if err != nil {
+			return err
+		}
+
+		return histService.Review(identity.From, payload.Approve, strings.Trim(payload.Note, " "))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// ExpenseHistoryDeleteReq is soft deleting or restoring expense with reason. legacy proto has no rpc for this.
+// DomainId is warehouse of the expense, optional.
+type ExpenseHistoryDeleteReq struct {
+	DomainId uint64 `json:"domain_id"`
+	HistId   uint64 `json:"hist_id"`
+	Reason   string `json:"reason"`
+}
+
+// ExpenseHistoryDelete hide expense from list and report, instead of editing amount to 0.
+func (w *warehouseFinImpl) ExpenseHistoryDelete(ctx context.Context, payload *ExpenseHistoryDeleteReq) (*warehouse_models.WareExpenseHistory, error) {
+	return w.setExpenseDeleted(ctx, payload, true, authorization_iface.Delete)
+}
+
+// ExpenseHistoryRestore bring back soft deleted expense.
+func (w *warehouseFinImpl) ExpenseHistoryRestore(ctx context.Context, payload *ExpenseHistoryDeleteReq) (*warehouse_models.WareExpenseHistory, error) {
+	return w.setExpenseDeleted(ctx, payload, false, authorization_iface.Update)
+}
+
+func (w *warehouseFinImpl) setExpenseDeleted(ctx context.Context, payload *ExpenseHistoryDeleteReq, deleted bool, action authorization_iface.Action) (*warehouse_models.WareExpenseHistory, error) {
+	identity, err := getIdentity(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var result *warehouse_models.WareExpenseHistory
+	db := w.db.WithContext(ctx)
+	err = db.Transaction(func(tx *gorm.DB) error {
+		histService := warehouse_mutations.NewExpenseHistService(tx, identity)
+
+		result, err = histService.GetExpense(uint(payload.HistId))
+		if err != nil {
+			return err
+		}
+		err = checkWarehouse(ctx, w.auth, identity, &warehouse_models.WareExpenseHistory{}, uint(payload.DomainId), result.WarehouseID, action)
+		if err != nil {
+			return err
+		}
+
+		return histService.SetDeleted(deleted, strings.Trim(payload.Reason, " "))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// ExpenseHistoryRevisionReq is revision trail of one expense. legacy proto has no rpc for this.
+// DomainId is warehouse of the expense, optional.
+type ExpenseHistoryRevisionReq struct {
+	DomainId uint64 `json:"domain_id"`
+	HistId   uint64 `json:"hist_id"`
+}
+
+type ExpenseRevisionItem struct {
+	*warehouse_models.WareExpenseRevision
+	Changes []string `json:"changes"`
+}
+
+type ExpenseHistoryRevisionRes struct {
+	Data []*ExpenseRevisionItem `json:"data"`
+}
+
+// ExpenseHistoryRevision is every edit of expense oldest first, same access as ExpenseHistoryEdit.
+func (w *warehouseFinImpl) ExpenseHistoryRevision(ctx context.Context, query *ExpenseHistoryRevisionReq) (*ExpenseHistoryRevisionRes, error) {
+	identity, err := getIdentity(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	db := w.db.WithContext(ctx)
+	expense, err := warehouse_mutations.
+		NewExpenseHistService(db, identity).
+		GetExpense(uint(query.HistId))
+	if err != nil {
+		return nil, err
+	}
+	err = checkWarehouse(ctx, w.auth, identity, &warehouse_models.WareExpenseHistory{}, uint(query.DomainId), expense.WarehouseID, authorization_iface.Read)
+	if err != nil {
+		return nil, err
+	}
+
+	revisions := []*warehouse_models.WareExpenseRevision{}
+	err = db.
+		Model(&warehouse_models.WareExpenseRevision{}).
+		Where("ware_expense_revisions.expense_hist_id = ?", expense.ID).
+		Order("ware_expense_revisions.id").
+		Find(&revisions).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	result := ExpenseHistoryRevisionRes{
+		Data: make([]*ExpenseRevisionItem, len(revisions)),
+	}
+	for i, revision := range revisions {
+		result.Data[i] = &ExpenseRevisionItem{
+			WareExpenseRevision: revision,
+			Changes:             revision.Changes(),
+		}
+	}
+
+	return &result, nil
 }
 
 // ExpenseHistoryList implements warehouse_iface.WarehouseFinanceServiceServer.
 func (w *warehouseFinImpl) ExpenseHistoryList(ctx context.Context, query *warehouse_iface.ExpenseHistoryListReq) (*warehouse_iface.ExpenseHistoryListRes, error) {
-	result := warehouse_iface.ExpenseHistoryListRes{}
+	err := w.checkRead(ctx, &warehouse_models.WareExpenseHistory{}, query.WarehouseId)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := w.ExpenseHistoryPage(ctx, &ExpenseHistoryQuery{ExpenseHistoryListReq: query}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	result := warehouse_iface.ExpenseHistoryListRes{
+		Data: make([]*warehouse_iface.WarehouseExpenseHistory, len(page.Data)),
+	}
+	for i, item := range page.Data {
+		result.Data[i] = item.ToProto()
+	}
+
+	return &result, nil
+}
+
+// ExpenseReportDailyDefaultDays is range of legacy daily report which has no range in request,
+// ending today. ExpenseReportDailyMaxDays is longest range of one report.
+const (
+	ExpenseReportDailyDefaultDays = 31
+	ExpenseReportDailyMaxDays     = 92
+)
+
+var ErrReportRangeInvalid = errors.New("report range invalid")
+
+// ExpenseReportDailyQuery is daily report request with day range, start and end date in millisecond and both
+// day inclusive. zero end date is today, zero start date is ExpenseReportDailyDefaultDays before end date.
+type ExpenseReportDailyQuery struct {
+	*warehouse_iface.ExpenseReportDailyReq
+	StartDate int64 `json:"start_date"`
+	EndDate   int64 `json:"end_date"`
+}
+
+// ExpenseReportTypeFlow is flow of one expense type in a day.
+type ExpenseReportTypeFlow struct {
+	ExpenseType warehouse_models.ExpenseType `json:"expense_type"`
+	FlowType    warehouse_query.FlowType     `json:"flow_type"`
+	Count       int64                        `json:"count"`
+	Amount      float64                      `json:"amount"`
+}
+
+// ExpenseReportDay is legacy daily report with balance and flow per expense type of the day.
+type ExpenseReportDay struct {
+	*warehouse_iface.ReportDaily
+	OpeningAmount float64                  `json:"opening_amount"`
+	ClosingAmount float64                  `json:"closing_amount"`
+	Types         []*ExpenseReportTypeFlow `json:"types"`
+}
+
+type ExpenseReportDailyDetailRes struct {
+	Data []*ExpenseReportDay `json:"data"`
+}
+
+type expenseDailyFlow struct {
+	Day         string
+	ExpenseType warehouse_models.ExpenseType
+	Count       int64
+	Amount      float64
+}
+
+type balanceDaily struct {
+	Day       string
+	AccountID uint
+	Amount    float64
+}
+
+// ExpenseReportDaily implements warehouse_iface.WarehouseFinanceServiceServer.
+func (w *warehouseFinImpl) ExpenseReportDaily(ctx context.Context, query *warehouse_iface.ExpenseReportDailyReq) (*warehouse_iface.ExpenseReportDailyRes, error) {
+	err := w.checkRead(ctx, &warehouse_models.WareExpenseHistory{}, query.WarehouseId)
+	if err != nil {
+		return nil, err
+	}
+
+	detail, err := w.ExpenseReportDailyDetail(ctx, &ExpenseReportDailyQuery{
+		ExpenseReportDailyReq: query,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := warehouse_iface.ExpenseReportDailyRes{
+		Data: make([]*warehouse_iface.ReportDaily, len(detail.Data)),
+	}
+	for i, item := range detail.Data {
+		result.Data[i] = item.ReportDaily
+	}
+
+	return &result, nil
+}
+
+// ExpenseReportDailyDetail is daily report of day range, opening balance of first day is last balance before range.
+func (w *warehouseFinImpl) ExpenseReportDailyDetail(ctx context.Context, query *ExpenseReportDailyQuery) (*ExpenseReportDailyDetailRes, error) {
+	if query.ExpenseReportDailyReq == nil {
+		query.ExpenseReportDailyReq = &warehouse_iface.ExpenseReportDailyReq{}
+	}
+	err := w.checkRead(ctx, &warehouse_models.WareExpenseHistory{}, query.WarehouseId)
+	if err != nil {
+		return nil, err
+	}
+
+	result := ExpenseReportDailyDetailRes{
+		Data: []*ExpenseReportDay{},
+	}
 
 	db := w.db.WithContext(ctx)
-	sqlQuery := db.Model(&warehouse_models.WareExpenseHistory{}).
-		Joins("JOIN ware_expense_account_warehouses ON ware_expense_account_warehouses.account_id = ware_expense_histories.account_id AND ware_expense_account_warehouses.warehouse_id = ware_expense_histories").
-		Where("ware_expense_account_warehouses.is_ops_account = ?", query.IsOpsAccount)
-	if query.WarehouseId != 0 {
-		sqlQuery = sqlQuery.Where("ware_expense_histories.warehouse_id = ?", query.WarehouseId)
+	loc, err := warehouse_query.GetWarehouseLocation(db, uint(query.WarehouseId))
+	if err != nil {
+		return nil, err
 	}
-	if query.AccountId != 0 {
-		sqlQuery = sqlQuery.Where("ware_expense_histories.account_id = ?", query.AccountId)
+
+	rangeStart, rangeEnd, err := reportRange(query, loc)
+	if err != nil {
+		return nil, err
 	}
-	if query.ExpenseType != "" {
-		sqlQuery = sqlQuery.Where("ware_expense_histories.expense_type = ?", query.ExpenseType)
+	rangeLast := rangeEnd.Add(-time.Millisecond)
+
+	reports := map[string]*ExpenseReportDay{}
+	getReport := func(day string) (*ExpenseReportDay, error) {
+		report := reports[day]
+		if report != nil {
+			return report, nil
+		}
+
+		startDay, err := time.ParseInLocation("2006-01-02", day, loc)
+		if err != nil {
+			return nil, err
+		}
+
+		report = &ExpenseReportDay{
+			ReportDaily: &warehouse_iface.ReportDaily{
+				StartDate: startDay.UnixMilli(),
+				EndDate:   startDay.AddDate(0, 0, 1).Add(-time.Millisecond).UnixMilli(),
+			},
+			Types: []*ExpenseReportTypeFlow{},
+		}
+		reports[day] = report
+		return report, nil
 	}
-	if query.StartDate != 0 {
-		unixMilli := time.UnixMilli(query.StartDate)
-		startDay := time.Date(unixMilli.Year(), unixMilli.Month(), unixMilli.Day(), 0, 0, 0, 0, unixMilli.Location())
-		sqlQuery = sqlQuery.Where("ware_expense_histories.created_at >= ?", startDay)
+
+	// expense flow per day and expense type, split by flow type
+	for _, flowType := range []warehouse_query.FlowType{
+		warehouse_query.FlowTypeIncome,
+		warehouse_query.FlowTypeOutcome,
+	} {
+		dayField := warehouse_query.DayField(db, "ware_expense_histories.at", loc)
+		flows := []*expenseDailyFlow{}
+
+		expenseQuery := warehouse_query.
+			NewWarehouseExpenseQuery(db, false).
+			FromWarehouse(uint(query.WarehouseId)).
+			FromAccount(uint(query.AccountId)).
+			ExpenseAt(rangeStart, rangeLast).
+			FlowType(flowType).
+			WithStatus(warehouse_models.ExpenseStatusApproved).
+			IncludeDeleted(false)
+		// transfer inside warehouse is only moving between account
+		if query.AccountId == 0 {
+			expenseQuery = expenseQuery.ExcludeInternalTransfer()
+		}
+
+		err = expenseQuery.
+			GetQuery().
+			Select([]string{
+				dayField + " as day",
+				"ware_expense_histories.expense_type",
+				"COUNT(ware_expense_histories.id) as count",
+				"SUM(" + warehouse_query.ExpenseFlowAmountField + ") as amount",
+			}).
+			Group(dayField).
+			Group("ware_expense_histories.expense_type").
+			Order("ware_expense_histories.expense_type asc").
+			Find(&flows).
+			Error
+		if err != nil {
+			return nil, err
+		}
+
+		for _, flow := range flows {
+			report, err := getReport(flow.Day)
+			if err != nil {
+				return nil, err
+			}
+
+			report.Types = append(report.Types, &ExpenseReportTypeFlow{
+				ExpenseType: flow.ExpenseType,
+				FlowType:    flowType,
+				Count:       flow.Count,
+				Amount:      flow.Amount,
+			})
+
+			switch flowType {
+			case warehouse_query.FlowTypeIncome:
+				report.Income += flow.Amount
+			case warehouse_query.FlowTypeOutcome:
+				report.Expense += flow.Amount
+			}
+		}
 	}
-	if query.EndDate != 0 {
-		unixMilli := time.UnixMilli(query.EndDate)
-		endDay := time.Date(unixMilli.Year(), unixMilli.Month(), unixMilli.Day()+1, 0, 0, 0, -1, unixMilli.Location())
-		sqlQuery = sqlQuery.Where("ware_expense_histories.created_at <= ?", endDay)
+
+	// last balance of each account before range is opening of first day
+	lastBalance := map[uint]float64{}
+	openings := []*balanceDaily{}
+	lastAt := warehouse_query.
+		NewWarehouseBalanceHistQuery(db, false).
+		FromWarehouse(uint(query.WarehouseId)).
+		FromAccount(uint(query.AccountId)).
+		BalanceTime(time.Time{}, rangeStart.Add(-time.Millisecond)).
+		GetQuery().
+		Select([]string{
+			"ware_balance_account_histories.account_id",
+			"MAX(ware_balance_account_histories.at) as at",
+		}).
+		Group("ware_balance_account_histories.account_id")
+
+	err = warehouse_query.
+		NewWarehouseBalanceHistQuery(db, false).
+		FromWarehouse(uint(query.WarehouseId)).
+		GetQuery().
+		Joins("JOIN (?) last_balance ON last_balance.account_id = ware_balance_account_histories.account_id AND last_balance.at = ware_balance_account_histories.at", lastAt).
+		Select([]string{
+			"ware_balance_account_histories.account_id",
+			"ware_balance_account_histories.amount",
+		}).
+		Order("ware_balance_account_histories.id asc").
+		Find(&openings).
+		Error
+	if err != nil {
+		return nil, err
+	}
+	for _, balance := range openings {
+		lastBalance[balance.AccountID] = balance.Amount
 	}
 
-	data := []*warehouse_models.WareExpenseHistory{}
-	err := sqlQuery.
-		Find(&data).Error
+	// recorded balance per day and account
+	balances := []*balanceDaily{}
+	err = warehouse_query.
+		NewWarehouseBalanceHistQuery(db, false).
+		FromWarehouse(uint(query.WarehouseId)).
+		FromAccount(uint(query.AccountId)).
+		BalanceTime(rangeStart, rangeLast).
+		GetQuery().
+		Select([]string{
+			warehouse_query.DayField(db, "ware_balance_account_histories.at", loc) + " as day",
+			"ware_balance_account_histories.account_id",
+			"ware_balance_account_histories.amount",
+		}).
+		Order("ware_balance_account_histories.at asc").
+		Find(&balances).
+		Error
 	if err != nil {
 		return nil, err
 	}
 
-	result.Data = make([]*warehouse_iface.WarehouseExpenseHistory, len(data))
-	for i, v := range data {
-		result.Data[i] = &warehouse_iface.WarehouseExpenseHistory{
-			Id:          uint64(v.ID),
-			AccountId:   uint64(v.AccountID),
-			WarehouseId: uint64(v.WarehouseID),
-			ExpenseType: string(v.ExpenseType),
-			Amount:      v.Amount,
+	closing := map[string]map[uint]float64{}
+	for _, balance := range balances {
+		_, err = getReport(balance.Day)
+		if err != nil {
+			return nil, err
+		}
+
+		if closing[balance.Day] == nil {
+			closing[balance.Day] = map[uint]float64{}
+		}
+		closing[balance.Day][balance.AccountID] = balance.Amount
+	}
+
+	days := make([]string, 0, len(reports))
+	for day := range reports {
+		days = append(days, day)
+	}
+	slices.Sort(days)
+
+	// carrying last known balance of each account to compute opening and closing
+	for _, day := range days {
+		report := reports[day]
+
+		for _, amount := range lastBalance {
+			report.OpeningAmount += amount
+		}
+
+		for accountID, amount := range closing[day] {
+			lastBalance[accountID] = amount
+		}
+
+		for _, amount := range lastBalance {
+			report.ClosingAmount += amount
 		}
+
+		report.SystemDiffAmount = report.Income - report.Expense
+		report.ActualDiffAmount = report.ClosingAmount - report.OpeningAmount
+		report.ErrDiffAmount = report.ActualDiffAmount - report.SystemDiffAmount
+
+		result.Data = append(result.Data, report)
 	}
 
 	return &result, nil
 }
 
-// ExpenseReportDaily implements warehouse_iface.WarehouseFinanceServiceServer.
-func (w *warehouseFinImpl) ExpenseReportDaily(context.Context, *warehouse_iface.ExpenseReportDailyReq) (*warehouse_iface.ExpenseReportDailyRes, error) {
-	panic("unimplemented")
+// reportRange is start of first day and start of day after last day, in warehouse timezone.
+func reportRange(query *ExpenseReportDailyQuery, loc *time.Location) (time.Time, time.Time, error) {
+	startOfDay := func(t time.Time) time.Time {
+		t = t.In(loc)
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc)
+	}
+
+	end := startOfDay(time.Now()).AddDate(0, 0, 1)
+	if query.EndDate != 0 {
+		end = startOfDay(time.UnixMilli(query.EndDate)).AddDate(0, 0, 1)
+	}
+
+	start := end.AddDate(0, 0, -ExpenseReportDailyDefaultDays)
+	if query.StartDate != 0 {
+		start = startOfDay(time.UnixMilli(query.StartDate))
+	}
+
+	if !start.Before(end) || start.AddDate(0, 0, ExpenseReportDailyMaxDays).Before(end) {
+		return start, end, ErrReportRangeInvalid
+	}
+
+	return start, end, nil
 }
@@ -5,9 +5,13 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"slices"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/pdcgo/event_source"
+	"github.com/pdcgo/schema/services/common/v1"
 	"github.com/pdcgo/shared/authorization"
 	"github.com/pdcgo/shared/db_models"
 	"github.com/pdcgo/shared/interfaces/authorization_iface"
@@ -16,7 +20,10 @@ import (
 	"github.com/pdcgo/shared/pkg/moretest/moretest_mock"
 	"github.com/pdcgo/warehouse_service"
 	"github.com/pdcgo/warehouse_service/warehouse_models"
+	"github.com/pdcgo/warehouse_service/warehouse_mutations"
+	"github.com/pdcgo/warehouse_service/warehouse_query"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"gorm.io/gorm"
 )
 
@@ -26,8 +33,17 @@ func NewMockAuth(hasRole bool) authorization_iface.Authorization {
 	}
 }
 
+// NewMockDomainAuth only has role in domainIDs.
+func NewMockDomainAuth(domainIDs ...uint) authorization_iface.Authorization {
+	return &mockAuth{
+		hasRole:   true,
+		domainIDs: domainIDs,
+	}
+}
+
 type mockAuth struct {
-	hasRole bool
+	hasRole   bool
+	domainIDs []uint
 }
 
 // AuthIdentityFromToken implements authorization_iface.Authorization.
@@ -37,7 +53,33 @@ func (m *mockAuth) AuthIdentityFromToken(token string) authorization_iface.AuthI
 
 // AuthIdentityFromHeader implements authorization_iface.Authorization.
 func (m *mockAuth) AuthIdentityFromHeader(header http.Header) authorization_iface.AuthIdentity {
-	panic("unimplemented")
+	identity := &mockAuthIdentity{}
+	if header.Get("Authorization") == "" {
+		identity.err = errors.New("token not found")
+		return identity
+	}
+	identity.identity = &authorization.JwtIdentity{
+		UserID: 1,
+		From:   db_models.WarehouseTeamType,
+	}
+	return identity
+}
+
+type mockAuthIdentity struct {
+	identity *authorization.JwtIdentity
+	err      error
+}
+
+func (m *mockAuthIdentity) Identity() authorization_iface.Identity {
+	return m.identity
+}
+
+func (m *mockAuthIdentity) HasPermission(perms authorization_iface.CheckPermissionGroup) authorization_iface.AuthIdentity {
+	return m
+}
+
+func (m *mockAuthIdentity) Err() error {
+	return m.err
 }
 
 // ApiQueryCheckPermission implements authorization_iface.Authorization.
@@ -48,9 +90,17 @@ func (m *mockAuth) ApiQueryCheckPermission(identity authorization_iface.Identity
 // HasPermission implements authorization_iface.Authorization.
 func (m *mockAuth) HasPermission(identity authorization_iface.Identity, perms authorization_iface.CheckPermissionGroup) error {
 	if !m.hasRole {
-		err := errors.New("not have role")
+		err := fmt.Errorf("%w: not have role", authorization.ErrPermission)
 		return err
 	}
+	if m.domainIDs == nil {
+		return nil
+	}
+	for _, domainID := range perms.GetDomainIDs() {
+		if !slices.Contains(m.domainIDs, domainID) {
+			return fmt.Errorf("%w: not have role in domain %d", authorization.ErrPermission, domainID)
+		}
+	}
 	return nil
 }
 
@@ -100,12 +150,23 @@ func TestFinanceService(t *testing.T) {
 					&warehouse_models.WareExpenseAccount{},
 					&warehouse_models.WareExpenseAccountWarehouse{},
 					&warehouse_models.WareExpenseHistory{},
+					&warehouse_models.WareExpenseType{},
+					&warehouse_models.WareExpenseTypeTeam{},
+					&warehouse_models.WareExpenseBudget{},
+					&warehouse_models.WareBalanceAccountHistory{},
+					&warehouse_models.WareExpenseAccountStatusLog{},
+					&warehouse_models.WareExpenseStatusLog{},
+					&warehouse_models.WareExpenseRevision{},
+					&warehouse_models.WarehouseTimezone{},
 					&db_models.Team{},
 					&db_models.User{},
 					&db_models.UserTeam{},
 				)
 				assert.Nil(t, err)
 
+				err = db.Create(warehouse_models.DefaultExpenseTypes()).Error
+				assert.Nil(t, err)
+
 				return nil
 			},
 		},
@@ -116,7 +177,10 @@ func TestFinanceService(t *testing.T) {
 			adminTeam := seedTeam("admin_team", db_models.AdminTeamType)
 			adminUser := seedUser(adminTeam, "admin user")
 
-			ctx := context.Background()
+			ctx := context.WithValue(context.Background(), "identity", &authorization.JwtIdentity{
+				UserID: warehouseUser.ID,
+				From:   db_models.WarehouseTeamType,
+			})
 
 			t.Run("test create expense account", func(t *testing.T) {
 				createAccountPayload := &warehouse_iface.ExpenseAccountCreateReq{
@@ -130,7 +194,7 @@ func TestFinanceService(t *testing.T) {
 				auth := NewMockAuth(true)
 
 				t.Run("test create expense account", func(t *testing.T) {
-					service := warehouse_service.NewWarehouseFinanceService(&db, auth)
+					service := warehouse_service.NewWarehouseFinanceService(&db, auth, event_source.EmptySender)
 					nCtx := context.WithValue(ctx, "identity", &authorization.JwtIdentity{
 						UserID: uint(adminUser.ID),
 						From:   db_models.AdminTeamType,
@@ -165,7 +229,7 @@ func TestFinanceService(t *testing.T) {
 
 						t.Run("test edit number id and name", func(t *testing.T) {
 							db.Transaction(func(tx *gorm.DB) error {
-								service := warehouse_service.NewWarehouseFinanceService(tx, auth)
+								service := warehouse_service.NewWarehouseFinanceService(tx, auth, event_source.EmptySender)
 
 								nCtx := context.WithValue(ctx, "identity", &authorization.JwtIdentity{
 									UserID: uint(adminTeam.ID),
@@ -196,12 +260,84 @@ func TestFinanceService(t *testing.T) {
 							})
 						})
 					})
+					t.Run("test disable account", func(t *testing.T) {
+						disablePayload := &warehouse_service.ExpenseAccountDisabledReq{
+							DomainId:    uint64(adminTeam.ID),
+							AccountId:   account.Id,
+							WarehouseId: uint64(warehouseTeam.ID),
+							Disabled:    true,
+							Note:        "rekening ditutup",
+						}
+
+						result, err := service.ExpenseAccountSetDisabled(nCtx, disablePayload)
+						assert.Nil(t, err)
+						assert.True(t, result.Disabled)
+
+						t.Run("test disable without root domain role rejected", func(t *testing.T) {
+							service := warehouse_service.NewWarehouseFinanceService(&db, NewMockDomainAuth(authorization.RootDomain+1), event_source.EmptySender)
+
+							_, err := service.ExpenseAccountSetDisabled(nCtx, disablePayload)
+							assert.NotNil(t, err)
+						})
+
+						t.Run("test list disabled account", func(t *testing.T) {
+							result, err := service.ExpenseAccountPage(ctx, &warehouse_service.ExpenseAccountQuery{
+								ExpenseAccountListReq: &warehouse_iface.ExpenseAccountListReq{
+									WarehouseId: uint64(warehouseTeam.ID),
+								},
+								Status: warehouse_query.AccountStatusDisabled,
+							}, nil)
+							assert.Nil(t, err)
+							assert.Len(t, result.Data, 1)
+							assert.Equal(t, account.Id, result.Data[0].Id)
+
+							result, err = service.ExpenseAccountPage(ctx, &warehouse_service.ExpenseAccountQuery{
+								ExpenseAccountListReq: &warehouse_iface.ExpenseAccountListReq{
+									WarehouseId: uint64(warehouseTeam.ID),
+								},
+								Status: warehouse_query.AccountStatusActive,
+							}, nil)
+							assert.Nil(t, err)
+							for _, item := range result.Data {
+								assert.NotEqual(t, account.Id, item.Id)
+							}
+						})
+
+						t.Run("test add expense to disabled account", func(t *testing.T) {
+							_, err := service.ExpenseHistoryAdd(nCtx, &warehouse_iface.ExpenseHistoryAddReq{
+								AccountId:   account.Id,
+								WarehouseId: uint64(warehouseTeam.ID),
+								ExpenseType: string(warehouse_models.ExpenseTypeKitchen),
+								Amount:      -10_000,
+								At:          timestamppb.Now(),
+							})
+							assert.ErrorIs(t, err, warehouse_mutations.ErrExpenseAccountDisabled)
+						})
+
+						t.Run("test enable account", func(t *testing.T) {
+							disablePayload.Disabled = false
+							disablePayload.Note = ""
+
+							result, err := service.ExpenseAccountSetDisabled(nCtx, disablePayload)
+							assert.Nil(t, err)
+							assert.False(t, result.Disabled)
+
+							logs := []*warehouse_models.WareExpenseAccountStatusLog{}
+							err = db.Where("account_id = ?", account.Id).Order("id").Find(&logs).Error
+							assert.Nil(t, err)
+							assert.Len(t, logs, 2)
+							assert.True(t, logs[0].Disabled)
+							assert.Equal(t, "rekening ditutup", logs[0].Note)
+							assert.Equal(t, adminUser.ID, logs[0].ActorID)
+							assert.False(t, logs[1].Disabled)
+						})
+					})
 				})
 
 				t.Run("test create from warehouse", func(t *testing.T) {
 					db.Transaction(func(tx *gorm.DB) error {
 						auth := NewMockAuth(false)
-						service := warehouse_service.NewWarehouseFinanceService(tx, auth)
+						service := warehouse_service.NewWarehouseFinanceService(tx, auth, event_source.EmptySender)
 
 						nCtx := context.WithValue(ctx, "identity", &authorization.JwtIdentity{
 							UserID: uint(warehouseUser.ID),
@@ -216,7 +352,7 @@ func TestFinanceService(t *testing.T) {
 
 				t.Run("test expense list", func(t *testing.T) {
 					auth := NewMockAuth(true)
-					service := warehouse_service.NewWarehouseFinanceService(&db, auth)
+					service := warehouse_service.NewWarehouseFinanceService(&db, auth, event_source.EmptySender)
 
 					results, err := service.ExpenseAccountList(ctx, &warehouse_iface.ExpenseAccountListReq{
 						WarehouseId: uint64(warehouseTeam.ID),
@@ -224,6 +360,181 @@ func TestFinanceService(t *testing.T) {
 					assert.Nil(t, err)
 					assert.NotNil(t, results)
 					assert.NotNil(t, results.Data)
+
+					t.Run("test expense list paginated", func(t *testing.T) {
+						result, err := service.ExpenseAccountPage(ctx, &warehouse_service.ExpenseAccountQuery{
+							ExpenseAccountListReq: &warehouse_iface.ExpenseAccountListReq{
+								WarehouseId: uint64(warehouseTeam.ID),
+							},
+						}, &warehouse_service.ExpenseListPage{
+							Page:     &common.PageFilter{Page: 1, Limit: 1},
+							SortBy:   warehouse_service.ExpenseSortCreatedAt,
+							SortDesc: true,
+						})
+						assert.Nil(t, err)
+						assert.Len(t, result.Data, 1)
+						assert.Equal(t, int64(2), result.PageInfo.TotalItems)
+						assert.Equal(t, int64(2), result.PageInfo.TotalPage)
+					})
+
+					t.Run("test expense list unsupported sort", func(t *testing.T) {
+						_, err := service.ExpenseAccountPage(ctx, &warehouse_service.ExpenseAccountQuery{
+							ExpenseAccountListReq: &warehouse_iface.ExpenseAccountListReq{
+								WarehouseId: uint64(warehouseTeam.ID),
+							},
+						}, &warehouse_service.ExpenseListPage{
+							SortBy: warehouse_service.ExpenseSortAmount,
+						})
+						assert.ErrorIs(t, err, warehouse_service.ErrExpenseSortNotSupported)
+					})
+				})
+			})
+
+			t.Run("test expense report daily", func(t *testing.T) {
+				db.Transaction(func(tx *gorm.DB) error {
+					account := warehouse_models.WareExpenseAccount{
+						Name:      "Report Account",
+						NumberID:  "998877665544",
+						CreatedAt: time.Now(),
+					}
+					err := tx.Create(&account).Error
+					assert.Nil(t, err)
+
+					err = tx.Create(&warehouse_models.WareExpenseAccountWarehouse{
+						AccountID:   account.ID,
+						WarehouseID: warehouseTeam.ID,
+					}).Error
+					assert.Nil(t, err)
+
+					today := time.Now()
+					yesterday := today.AddDate(0, 0, -1)
+					beforeRange := today.AddDate(0, 0, -3)
+
+					balances := []*warehouse_models.WareBalanceAccountHistory{
+						{WarehouseID: warehouseTeam.ID, AccountID: account.ID, Amount: 90_000, At: beforeRange},
+						{WarehouseID: warehouseTeam.ID, AccountID: account.ID, Amount: 100_000, At: yesterday},
+						{WarehouseID: warehouseTeam.ID, AccountID: account.ID, Amount: 130_000, At: today},
+					}
+					err = tx.Create(&balances).Error
+					assert.Nil(t, err)
+
+					expenses := []*warehouse_models.WareExpenseHistory{
+						{WarehouseID: warehouseTeam.ID, AccountID: account.ID, ExpenseType: warehouse_models.ExpenseTypeEquity, Amount: 10_000, At: yesterday},
+						{WarehouseID: warehouseTeam.ID, AccountID: account.ID, ExpenseType: warehouse_models.ExpenseTypeEquity, Amount: 50_000, At: today},
+						{WarehouseID: warehouseTeam.ID, AccountID: account.ID, ExpenseType: warehouse_models.ExpenseTypeKitchen, Amount: -15_000, At: today},
+						{WarehouseID: warehouseTeam.ID, AccountID: account.ID, ExpenseType: warehouse_models.ExpenseTypeKitchen, Amount: -5_000, At: today},
+						{WarehouseID: warehouseTeam.ID, AccountID: account.ID, ExpenseType: warehouse_models.ExpenseTypeKitchen, Amount: -7_000, At: beforeRange},
+					}
+					err = tx.Create(&expenses).Error
+					assert.Nil(t, err)
+
+					service := warehouse_service.NewWarehouseFinanceService(tx, NewMockAuth(true), event_source.EmptySender)
+					result, err := service.ExpenseReportDailyDetail(ctx, &warehouse_service.ExpenseReportDailyQuery{
+						ExpenseReportDailyReq: &warehouse_iface.ExpenseReportDailyReq{
+							AccountId:   uint64(account.ID),
+							WarehouseId: uint64(warehouseTeam.ID),
+						},
+						StartDate: yesterday.UnixMilli(),
+						EndDate:   today.UnixMilli(),
+					})
+					assert.Nil(t, err)
+					assert.Len(t, result.Data, 2)
+
+					t.Run("test first day opening from balance before range", func(t *testing.T) {
+						report := result.Data[0]
+						assert.Equal(t, float64(90_000), report.OpeningAmount)
+						assert.Equal(t, float64(100_000), report.ClosingAmount)
+						assert.Equal(t, float64(10_000), report.Income)
+						assert.Equal(t, float64(0), report.Expense)
+						assert.Equal(t, float64(10_000), report.ActualDiffAmount)
+						assert.Equal(t, float64(0), report.ErrDiffAmount)
+					})
+
+					t.Run("test second day balance", func(t *testing.T) {
+						report := result.Data[1]
+						assert.Equal(t, float64(100_000), report.OpeningAmount)
+						assert.Equal(t, float64(130_000), report.ClosingAmount)
+						assert.Equal(t, float64(50_000), report.Income)
+						assert.Equal(t, float64(20_000), report.Expense)
+						assert.Equal(t, float64(30_000), report.SystemDiffAmount)
+						assert.Equal(t, float64(30_000), report.ActualDiffAmount)
+						assert.Equal(t, float64(0), report.ErrDiffAmount)
+						assert.Less(t, report.StartDate, report.EndDate)
+					})
+
+					t.Run("test flow per expense type", func(t *testing.T) {
+						assert.Equal(t, []*warehouse_service.ExpenseReportTypeFlow{
+							{ExpenseType: warehouse_models.ExpenseTypeEquity, FlowType: warehouse_query.FlowTypeIncome, Count: 1, Amount: 50_000},
+							{ExpenseType: warehouse_models.ExpenseTypeKitchen, FlowType: warehouse_query.FlowTypeOutcome, Count: 2, Amount: 20_000},
+						}, result.Data[1].Types)
+					})
+
+					t.Run("test legacy report", func(t *testing.T) {
+						legacy, err := service.ExpenseReportDaily(ctx, &warehouse_iface.ExpenseReportDailyReq{
+							AccountId:   uint64(account.ID),
+							WarehouseId: uint64(warehouseTeam.ID),
+						})
+						assert.Nil(t, err)
+						assert.Len(t, legacy.Data, 3)
+						assert.Equal(t, float64(90_000), legacy.Data[0].ActualDiffAmount)
+						assert.Equal(t, float64(7_000), legacy.Data[0].Expense)
+					})
+
+					t.Run("test correction row on opposite sign", func(t *testing.T) {
+						correction := warehouse_models.WareExpenseAccount{
+							Name:      "Correction Account",
+							NumberID:  "998877665545",
+							CreatedAt: time.Now(),
+						}
+						err := tx.Create(&correction).Error
+						assert.Nil(t, err)
+
+						err = tx.Create(&warehouse_models.WareExpenseAccountWarehouse{
+							AccountID:   correction.ID,
+							WarehouseID: warehouseTeam.ID,
+						}).Error
+						assert.Nil(t, err)
+
+						err = tx.Create(&[]*warehouse_models.WareExpenseHistory{
+							{WarehouseID: warehouseTeam.ID, AccountID: correction.ID, ExpenseType: warehouse_models.ExpenseTypeEquity, Amount: 30_000, At: today},
+							{WarehouseID: warehouseTeam.ID, AccountID: correction.ID, ExpenseType: warehouse_models.ExpenseTypeEquity, Amount: -10_000, At: today},
+							{WarehouseID: warehouseTeam.ID, AccountID: correction.ID, ExpenseType: warehouse_models.ExpenseTypeKitchen, Amount: -8_000, At: today},
+							{WarehouseID: warehouseTeam.ID, AccountID: correction.ID, ExpenseType: warehouse_models.ExpenseTypeKitchen, Amount: 3_000, At: today},
+						}).Error
+						assert.Nil(t, err)
+
+						result, err := service.ExpenseReportDailyDetail(ctx, &warehouse_service.ExpenseReportDailyQuery{
+							ExpenseReportDailyReq: &warehouse_iface.ExpenseReportDailyReq{
+								AccountId:   uint64(correction.ID),
+								WarehouseId: uint64(warehouseTeam.ID),
+							},
+							StartDate: today.UnixMilli(),
+							EndDate:   today.UnixMilli(),
+						})
+						assert.Nil(t, err)
+						assert.Len(t, result.Data, 1)
+
+						report := result.Data[0]
+						assert.Equal(t, float64(20_000), report.Income)
+						assert.Equal(t, float64(5_000), report.Expense)
+						assert.Equal(t, []*warehouse_service.ExpenseReportTypeFlow{
+							{ExpenseType: warehouse_models.ExpenseTypeEquity, FlowType: warehouse_query.FlowTypeIncome, Count: 2, Amount: 20_000},
+							{ExpenseType: warehouse_models.ExpenseTypeKitchen, FlowType: warehouse_query.FlowTypeOutcome, Count: 2, Amount: 5_000},
+						}, report.Types)
+					})
+
+					t.Run("test range too long", func(t *testing.T) {
+						_, err := service.ExpenseReportDailyDetail(ctx, &warehouse_service.ExpenseReportDailyQuery{
+							ExpenseReportDailyReq: &warehouse_iface.ExpenseReportDailyReq{
+								WarehouseId: uint64(warehouseTeam.ID),
+							},
+							StartDate: today.AddDate(-1, 0, 0).UnixMilli(),
+							EndDate:   today.UnixMilli(),
+						})
+						assert.ErrorIs(t, err, warehouse_service.ErrReportRangeInvalid)
+					})
+
+					return errors.New("dummy error")
 				})
 			})
 		},
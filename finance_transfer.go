@@ -0,0 +1,67 @@
+package warehouse_service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/pdcgo/shared/interfaces/authorization_iface"
+	"github.com/pdcgo/warehouse_service/warehouse_models"
+	"github.com/pdcgo/warehouse_service/warehouse_mutations"
+	"gorm.io/gorm"
+)
+
+// ExpenseTransferCreateReq is moving cash between account. zero ToWarehouseId is same warehouse,
+// different warehouse is only from admin team. legacy proto has no rpc for this.
+type ExpenseTransferCreateReq struct {
+	FromWarehouseId uint64    `json:"from_warehouse_id"`
+	FromAccountId   uint64    `json:"from_account_id"`
+	ToWarehouseId   uint64    `json:"to_warehouse_id"`
+	ToAccountId     uint64    `json:"to_account_id"`
+	Amount          float64   `json:"amount"`
+	Note            string    `json:"note"`
+	At              time.Time `json:"at"`
+}
+
+// ExpenseTransferCreate writing transfer with its outcome and income expense history in one transaction.
+// need Create permission of expense history in from warehouse, and in to warehouse when it is other warehouse.
+func (w *warehouseFinImpl) ExpenseTransferCreate(ctx context.Context, payload *ExpenseTransferCreateReq) (*warehouse_models.WareExpenseTransfer, error) {
+	identity, err := getIdentity(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkWarehouse(ctx, w.auth, identity, &warehouse_models.WareExpenseHistory{}, 0, uint(payload.FromWarehouseId), authorization_iface.Create)
+	if err != nil {
+		return nil, err
+	}
+	if payload.ToWarehouseId != 0 && payload.ToWarehouseId != payload.FromWarehouseId {
+		err = checkWarehouse(ctx, w.auth, identity, &warehouse_models.WareExpenseHistory{}, 0, uint(payload.ToWarehouseId), authorization_iface.Create)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var result *warehouse_models.WareExpenseTransfer
+	db := w.db.WithContext(ctx)
+	err = db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		result, err = warehouse_mutations.
+			NewExpenseTransferService(tx, identity).
+			Create(identity.From, &warehouse_mutations.CreateTransferPayload{
+				FromWarehouseID: uint(payload.FromWarehouseId),
+				FromAccountID:   uint(payload.FromAccountId),
+				ToWarehouseID:   uint(payload.ToWarehouseId),
+				ToAccountID:     uint(payload.ToAccountId),
+				Amount:          payload.Amount,
+				Note:            strings.Trim(payload.Note, " "),
+				At:              payload.At,
+			})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
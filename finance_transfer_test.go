@@ -0,0 +1,212 @@
+package warehouse_service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pdcgo/event_source"
+	"github.com/pdcgo/shared/authorization"
+	"github.com/pdcgo/shared/db_models"
+	"github.com/pdcgo/shared/interfaces/warehouse_iface"
+	"github.com/pdcgo/shared/pkg/moretest"
+	"github.com/pdcgo/shared/pkg/moretest/moretest_mock"
+	"github.com/pdcgo/warehouse_service"
+	"github.com/pdcgo/warehouse_service/warehouse_models"
+	"github.com/pdcgo/warehouse_service/warehouse_mutations"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+func TestExpenseTransfer(t *testing.T) {
+	var db gorm.DB
+
+	moretest.Suite(t, "testing expense transfer",
+		moretest.SetupListFunc{
+			moretest_mock.MockSqliteDatabase(&db),
+			func(t *testing.T) func() error {
+				err := db.AutoMigrate(
+					&warehouse_models.WareExpenseAccount{},
+					&warehouse_models.WareExpenseAccountWarehouse{},
+					&warehouse_models.WareExpenseHistory{},
+					&warehouse_models.WareExpenseTransfer{},
+					&warehouse_models.WareExpenseType{},
+					&warehouse_models.WareExpenseTypeTeam{},
+					&warehouse_models.WareExpenseBudget{},
+					&warehouse_models.WareExpenseStatusLog{},
+					&warehouse_models.WareExpenseRevision{},
+					&warehouse_models.WareBalanceAccountHistory{},
+					&warehouse_models.WarehouseTimezone{},
+				)
+				assert.Nil(t, err)
+
+				err = db.Create(warehouse_models.DefaultExpenseTypes()).Error
+				assert.Nil(t, err)
+
+				err = db.Create(&[]*warehouse_models.WareExpenseAccount{
+					{ID: 1, Name: "Bank", NumberID: "100001", CreatedAt: time.Now()},
+					{ID: 2, Name: "Kas Kecil", NumberID: "100002", CreatedAt: time.Now()},
+					{ID: 3, Name: "Kas", NumberID: "100003", CreatedAt: time.Now()},
+					{ID: 4, Name: "Bank Gudang 2", NumberID: "200001", CreatedAt: time.Now()},
+				}).Error
+				assert.Nil(t, err)
+
+				err = db.Create(&[]*warehouse_models.WareExpenseAccountWarehouse{
+					{AccountID: 1, WarehouseID: 1},
+					{AccountID: 2, WarehouseID: 1, IsOpsAccount: true},
+					{AccountID: 3, WarehouseID: 1},
+					{AccountID: 4, WarehouseID: 2},
+				}).Error
+				assert.Nil(t, err)
+
+				return nil
+			},
+		},
+		func(t *testing.T) {
+			whCtx := context.WithValue(context.Background(), "identity", &authorization.JwtIdentity{
+				UserID: 5,
+				From:   db_models.WarehouseTeamType,
+			})
+			adminCtx := context.WithValue(context.Background(), "identity", &authorization.JwtIdentity{
+				UserID: 1,
+				From:   db_models.AdminTeamType,
+			})
+			service := warehouse_service.NewWarehouseFinanceService(&db, NewMockAuth(true), event_source.EmptySender)
+
+			at := time.Now()
+			_, err := service.ExpenseHistoryAdd(whCtx, &warehouse_iface.ExpenseHistoryAddReq{
+				AccountId:   1,
+				WarehouseId: 1,
+				ExpenseType: string(warehouse_models.ExpenseTypeKitchen),
+				Amount:      -10_000,
+				At:          timestamppb.New(at),
+			})
+			assert.Nil(t, err)
+
+			transfer, err := service.ExpenseTransferCreate(whCtx, &warehouse_service.ExpenseTransferCreateReq{
+				FromWarehouseId: 1,
+				FromAccountId:   1,
+				ToAccountId:     2,
+				Amount:          250_000,
+				Note:            "isi kas kecil",
+				At:              at,
+			})
+			assert.Nil(t, err)
+			assert.Equal(t, uint(1), transfer.ToWarehouseID)
+
+			t.Run("test linked leg", func(t *testing.T) {
+				legs := []*warehouse_models.WareExpenseHistory{}
+				err := db.Where("transfer_id = ?", transfer.ID).Order("amount").Find(&legs).Error
+				assert.Nil(t, err)
+				assert.Len(t, legs, 2)
+				assert.Equal(t, uint(1), legs[0].AccountID)
+				assert.Equal(t, float64(-250_000), legs[0].Amount)
+				assert.Equal(t, uint(2), legs[1].AccountID)
+				assert.Equal(t, float64(250_000), legs[1].Amount)
+				assert.Equal(t, warehouse_models.ExpenseTypeTransfer, legs[1].ExpenseType)
+
+				_, err = service.ExpenseHistoryEdit(whCtx, &warehouse_iface.ExpenseHistoryEditReq{
+					HistId:      uint64(legs[0].ID),
+					AccountId:   1,
+					WarehouseId: 1,
+					ExpenseType: string(warehouse_models.ExpenseTypeTransfer),
+					Amount:      -1,
+					At:          timestamppb.New(at),
+				})
+				assert.ErrorIs(t, err, warehouse_mutations.ErrExpenseIsTransfer)
+			})
+
+			t.Run("test invalid transfer", func(t *testing.T) {
+				_, err := service.ExpenseTransferCreate(whCtx, &warehouse_service.ExpenseTransferCreateReq{
+					FromWarehouseId: 1, FromAccountId: 1, ToAccountId: 1, Amount: 1, At: at,
+				})
+				assert.ErrorIs(t, err, warehouse_mutations.ErrTransferSameAccount)
+
+				_, err = service.ExpenseTransferCreate(whCtx, &warehouse_service.ExpenseTransferCreateReq{
+					FromWarehouseId: 1, FromAccountId: 1, ToWarehouseId: 2, ToAccountId: 4, Amount: 1, At: at,
+				})
+				assert.ErrorIs(t, err, warehouse_mutations.ErrTransferCrossWarehouse)
+
+				_, err = service.ExpenseHistoryAdd(whCtx, &warehouse_iface.ExpenseHistoryAddReq{
+					AccountId: 1, WarehouseId: 1, ExpenseType: string(warehouse_models.ExpenseTypeTransfer), Amount: -1, At: timestamppb.New(at),
+				})
+				assert.NotNil(t, err)
+			})
+
+			t.Run("test checked on both warehouse", func(t *testing.T) {
+				service := warehouse_service.NewWarehouseFinanceService(&db, NewMockDomainAuth(1), event_source.EmptySender)
+
+				_, err := service.ExpenseTransferCreate(whCtx, &warehouse_service.ExpenseTransferCreateReq{
+					FromWarehouseId: 2, FromAccountId: 4, ToWarehouseId: 2, ToAccountId: 4, Amount: 1, At: at,
+				})
+				assert.ErrorIs(t, err, authorization.ErrPermission)
+
+				_, err = service.ExpenseTransferCreate(adminCtx, &warehouse_service.ExpenseTransferCreateReq{
+					FromWarehouseId: 1, FromAccountId: 1, ToWarehouseId: 2, ToAccountId: 4, Amount: 1, At: at,
+				})
+				assert.ErrorIs(t, err, authorization.ErrPermission)
+			})
+
+			t.Run("test listing single item", func(t *testing.T) {
+				_, err := service.ExpenseTransferCreate(whCtx, &warehouse_service.ExpenseTransferCreateReq{
+					FromWarehouseId: 1, FromAccountId: 1, ToAccountId: 3, Amount: 30_000, At: at,
+				})
+				assert.Nil(t, err)
+
+				result, err := service.ExpenseHistoryPage(whCtx, &warehouse_service.ExpenseHistoryQuery{
+					ExpenseHistoryListReq: &warehouse_iface.ExpenseHistoryListReq{
+						WarehouseId: 1,
+						ExpenseType: string(warehouse_models.ExpenseTypeTransfer),
+					},
+				}, nil)
+				assert.Nil(t, err)
+				// bank to kas shown once, bank to ops account leg shown in non ops listing
+				assert.Len(t, result.Data, 2)
+				for _, item := range result.Data {
+					assert.Equal(t, uint(1), item.AccountID)
+					assert.Less(t, item.Amount, float64(0))
+				}
+
+				result, err = service.ExpenseHistoryPage(whCtx, &warehouse_service.ExpenseHistoryQuery{
+					ExpenseHistoryListReq: &warehouse_iface.ExpenseHistoryListReq{WarehouseId: 1, AccountId: 3},
+				}, nil)
+				assert.Nil(t, err)
+				assert.Len(t, result.Data, 1)
+				assert.Equal(t, float64(30_000), result.Data[0].Amount)
+				assert.Equal(t, uint(1), result.Data[0].TransferAccountID)
+			})
+
+			t.Run("test report", func(t *testing.T) {
+				_, err := service.ExpenseTransferCreate(adminCtx, &warehouse_service.ExpenseTransferCreateReq{
+					FromWarehouseId: 1, FromAccountId: 1, ToWarehouseId: 2, ToAccountId: 4, Amount: 40_000, At: at,
+				})
+				assert.Nil(t, err)
+
+				report := func(accountID uint64) (float64, float64) {
+					result, err := service.ExpenseReportDaily(adminCtx, &warehouse_iface.ExpenseReportDailyReq{
+						WarehouseId: 1,
+						AccountId:   accountID,
+					})
+					assert.Nil(t, err)
+
+					var income, expense float64
+					for _, item := range result.Data {
+						income += item.Income
+						expense += item.Expense
+					}
+					return income, expense
+				}
+
+				// only kitchen and transfer to other warehouse
+				income, expense := report(0)
+				assert.Equal(t, float64(0), income)
+				assert.Equal(t, float64(50_000), expense)
+
+				income, expense = report(2)
+				assert.Equal(t, float64(250_000), income)
+				assert.Equal(t, float64(0), expense)
+			})
+		},
+	)
+}
@@ -0,0 +1,8 @@
+@echo off
+REM generate go and connect code of proto/ into services/
+buf lint
+buf generate
+if %ERRORLEVEL% neq 0 (
+    echo buf generate failed!
+    exit /b %ERRORLEVEL%
+)
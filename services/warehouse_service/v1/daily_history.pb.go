@@ -0,0 +1,451 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: warehouse_service/v1/daily_history.proto
+
+package warehouse_service_iface
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	_ "github.com/pdcgo/schema/services/role_base/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type DailyHistoryRebuildRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	WarehouseId uint64                 `protobuf:"varint,1,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	// empty for all sku that changed in range
+	SkuIds []string `protobuf:"bytes,2,rep,name=sku_ids,json=skuIds,proto3" json:"sku_ids,omitempty"`
+	// start and end is day in warehouse time, formatted as 2006-01-02
+	Start string `protobuf:"bytes,3,opt,name=start,proto3" json:"start,omitempty"`
+	End   string `protobuf:"bytes,4,opt,name=end,proto3" json:"end,omitempty"`
+	// write recomputed row
+	Fix           bool `protobuf:"varint,5,opt,name=fix,proto3" json:"fix,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DailyHistoryRebuildRequest) Reset() {
+	*x = DailyHistoryRebuildRequest{}
+	mi := &file_warehouse_service_v1_daily_history_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DailyHistoryRebuildRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DailyHistoryRebuildRequest) ProtoMessage() {}
+
+func (x *DailyHistoryRebuildRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_v1_daily_history_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DailyHistoryRebuildRequest.ProtoReflect.Descriptor instead.
+func (*DailyHistoryRebuildRequest) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_v1_daily_history_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DailyHistoryRebuildRequest) GetWarehouseId() uint64 {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return 0
+}
+
+func (x *DailyHistoryRebuildRequest) GetSkuIds() []string {
+	if x != nil {
+		return x.SkuIds
+	}
+	return nil
+}
+
+func (x *DailyHistoryRebuildRequest) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *DailyHistoryRebuildRequest) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+func (x *DailyHistoryRebuildRequest) GetFix() bool {
+	if x != nil {
+		return x.Fix
+	}
+	return false
+}
+
+type DailySkuHistory struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	T                *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=t,proto3" json:"t,omitempty"`
+	SkuId            string                 `protobuf:"bytes,2,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	WarehouseId      uint64                 `protobuf:"varint,3,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	StartStockCount  int64                  `protobuf:"varint,4,opt,name=start_stock_count,json=startStockCount,proto3" json:"start_stock_count,omitempty"`
+	EndStockCount    int64                  `protobuf:"varint,5,opt,name=end_stock_count,json=endStockCount,proto3" json:"end_stock_count,omitempty"`
+	StartStockAmount float64                `protobuf:"fixed64,6,opt,name=start_stock_amount,json=startStockAmount,proto3" json:"start_stock_amount,omitempty"`
+	EndStockAmount   float64                `protobuf:"fixed64,7,opt,name=end_stock_amount,json=endStockAmount,proto3" json:"end_stock_amount,omitempty"`
+	DiffStockCount   int64                  `protobuf:"varint,8,opt,name=diff_stock_count,json=diffStockCount,proto3" json:"diff_stock_count,omitempty"`
+	DiffStockAmount  float64                `protobuf:"fixed64,9,opt,name=diff_stock_amount,json=diffStockAmount,proto3" json:"diff_stock_amount,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *DailySkuHistory) Reset() {
+	*x = DailySkuHistory{}
+	mi := &file_warehouse_service_v1_daily_history_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DailySkuHistory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DailySkuHistory) ProtoMessage() {}
+
+func (x *DailySkuHistory) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_v1_daily_history_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DailySkuHistory.ProtoReflect.Descriptor instead.
+func (*DailySkuHistory) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_v1_daily_history_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DailySkuHistory) GetT() *timestamppb.Timestamp {
+	if x != nil {
+		return x.T
+	}
+	return nil
+}
+
+func (x *DailySkuHistory) GetSkuId() string {
+	if x != nil {
+		return x.SkuId
+	}
+	return ""
+}
+
+func (x *DailySkuHistory) GetWarehouseId() uint64 {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return 0
+}
+
+func (x *DailySkuHistory) GetStartStockCount() int64 {
+	if x != nil {
+		return x.StartStockCount
+	}
+	return 0
+}
+
+func (x *DailySkuHistory) GetEndStockCount() int64 {
+	if x != nil {
+		return x.EndStockCount
+	}
+	return 0
+}
+
+func (x *DailySkuHistory) GetStartStockAmount() float64 {
+	if x != nil {
+		return x.StartStockAmount
+	}
+	return 0
+}
+
+func (x *DailySkuHistory) GetEndStockAmount() float64 {
+	if x != nil {
+		return x.EndStockAmount
+	}
+	return 0
+}
+
+func (x *DailySkuHistory) GetDiffStockCount() int64 {
+	if x != nil {
+		return x.DiffStockCount
+	}
+	return 0
+}
+
+func (x *DailySkuHistory) GetDiffStockAmount() float64 {
+	if x != nil {
+		return x.DiffStockAmount
+	}
+	return 0
+}
+
+type DailyHistoryDiff struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SkuId         string                 `protobuf:"bytes,1,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	T             *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=t,proto3" json:"t,omitempty"`
+	Missing       bool                   `protobuf:"varint,3,opt,name=missing,proto3" json:"missing,omitempty"`
+	Current       *DailySkuHistory       `protobuf:"bytes,4,opt,name=current,proto3" json:"current,omitempty"`
+	Expected      *DailySkuHistory       `protobuf:"bytes,5,opt,name=expected,proto3" json:"expected,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DailyHistoryDiff) Reset() {
+	*x = DailyHistoryDiff{}
+	mi := &file_warehouse_service_v1_daily_history_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DailyHistoryDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DailyHistoryDiff) ProtoMessage() {}
+
+func (x *DailyHistoryDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_v1_daily_history_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DailyHistoryDiff.ProtoReflect.Descriptor instead.
+func (*DailyHistoryDiff) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_v1_daily_history_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DailyHistoryDiff) GetSkuId() string {
+	if x != nil {
+		return x.SkuId
+	}
+	return ""
+}
+
+func (x *DailyHistoryDiff) GetT() *timestamppb.Timestamp {
+	if x != nil {
+		return x.T
+	}
+	return nil
+}
+
+func (x *DailyHistoryDiff) GetMissing() bool {
+	if x != nil {
+		return x.Missing
+	}
+	return false
+}
+
+func (x *DailyHistoryDiff) GetCurrent() *DailySkuHistory {
+	if x != nil {
+		return x.Current
+	}
+	return nil
+}
+
+func (x *DailyHistoryDiff) GetExpected() *DailySkuHistory {
+	if x != nil {
+		return x.Expected
+	}
+	return nil
+}
+
+type DailyHistoryRebuildResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SkuCount      int64                  `protobuf:"varint,1,opt,name=sku_count,json=skuCount,proto3" json:"sku_count,omitempty"`
+	RowCount      int64                  `protobuf:"varint,2,opt,name=row_count,json=rowCount,proto3" json:"row_count,omitempty"`
+	Diffs         []*DailyHistoryDiff    `protobuf:"bytes,3,rep,name=diffs,proto3" json:"diffs,omitempty"`
+	Fixed         int64                  `protobuf:"varint,4,opt,name=fixed,proto3" json:"fixed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DailyHistoryRebuildResponse) Reset() {
+	*x = DailyHistoryRebuildResponse{}
+	mi := &file_warehouse_service_v1_daily_history_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DailyHistoryRebuildResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DailyHistoryRebuildResponse) ProtoMessage() {}
+
+func (x *DailyHistoryRebuildResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_v1_daily_history_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DailyHistoryRebuildResponse.ProtoReflect.Descriptor instead.
+func (*DailyHistoryRebuildResponse) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_v1_daily_history_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DailyHistoryRebuildResponse) GetSkuCount() int64 {
+	if x != nil {
+		return x.SkuCount
+	}
+	return 0
+}
+
+func (x *DailyHistoryRebuildResponse) GetRowCount() int64 {
+	if x != nil {
+		return x.RowCount
+	}
+	return 0
+}
+
+func (x *DailyHistoryRebuildResponse) GetDiffs() []*DailyHistoryDiff {
+	if x != nil {
+		return x.Diffs
+	}
+	return nil
+}
+
+func (x *DailyHistoryRebuildResponse) GetFixed() int64 {
+	if x != nil {
+		return x.Fixed
+	}
+	return 0
+}
+
+var File_warehouse_service_v1_daily_history_proto protoreflect.FileDescriptor
+
+const file_warehouse_service_v1_daily_history_proto_rawDesc = "" +
+	"\n" +
+	"(warehouse_service/v1/daily_history.proto\x12\x14warehouse_service.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17role_base/v1/role.proto\"\xef\x01\n" +
+	"\x1aDailyHistoryRebuildRequest\x12*\n" +
+	"\fwarehouse_id\x18\x01 \x01(\x04B\a\xbaH\x042\x02 \x00R\vwarehouseId\x12\x17\n" +
+	"\asku_ids\x18\x02 \x03(\tR\x06skuIds\x129\n" +
+	"\x05start\x18\x03 \x01(\tB#\xbaH r\x1e2\x1c^[0-9]{4}-[0-9]{2}-[0-9]{2}$R\x05start\x125\n" +
+	"\x03end\x18\x04 \x01(\tB#\xbaH r\x1e2\x1c^[0-9]{4}-[0-9]{2}-[0-9]{2}$R\x03end\x12\x10\n" +
+	"\x03fix\x18\x05 \x01(\bR\x03fix:\b\x92\xb5\x18\x04\n" +
+	"\x02\x01\x02\"\xf7\x02\n" +
+	"\x0fDailySkuHistory\x12(\n" +
+	"\x01t\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x01t\x12\x15\n" +
+	"\x06sku_id\x18\x02 \x01(\tR\x05skuId\x12!\n" +
+	"\fwarehouse_id\x18\x03 \x01(\x04R\vwarehouseId\x12*\n" +
+	"\x11start_stock_count\x18\x04 \x01(\x03R\x0fstartStockCount\x12&\n" +
+	"\x0fend_stock_count\x18\x05 \x01(\x03R\rendStockCount\x12,\n" +
+	"\x12start_stock_amount\x18\x06 \x01(\x01R\x10startStockAmount\x12(\n" +
+	"\x10end_stock_amount\x18\a \x01(\x01R\x0eendStockAmount\x12(\n" +
+	"\x10diff_stock_count\x18\b \x01(\x03R\x0ediffStockCount\x12*\n" +
+	"\x11diff_stock_amount\x18\t \x01(\x01R\x0fdiffStockAmount\"\xf1\x01\n" +
+	"\x10DailyHistoryDiff\x12\x15\n" +
+	"\x06sku_id\x18\x01 \x01(\tR\x05skuId\x12(\n" +
+	"\x01t\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x01t\x12\x18\n" +
+	"\amissing\x18\x03 \x01(\bR\amissing\x12?\n" +
+	"\acurrent\x18\x04 \x01(\v2%.warehouse_service.v1.DailySkuHistoryR\acurrent\x12A\n" +
+	"\bexpected\x18\x05 \x01(\v2%.warehouse_service.v1.DailySkuHistoryR\bexpected\"\xab\x01\n" +
+	"\x1bDailyHistoryRebuildResponse\x12\x1b\n" +
+	"\tsku_count\x18\x01 \x01(\x03R\bskuCount\x12\x1b\n" +
+	"\trow_count\x18\x02 \x01(\x03R\browCount\x12<\n" +
+	"\x05diffs\x18\x03 \x03(\v2&.warehouse_service.v1.DailyHistoryDiffR\x05diffs\x12\x14\n" +
+	"\x05fixed\x18\x04 \x01(\x03R\x05fixed2\x91\x01\n" +
+	"\x13DailyHistoryService\x12z\n" +
+	"\x13DailyHistoryRebuild\x120.warehouse_service.v1.DailyHistoryRebuildRequest\x1a1.warehouse_service.v1.DailyHistoryRebuildResponseBZZXgithub.com/pdcgo/warehouse_service/services/warehouse_service/v1;warehouse_service_ifaceb\x06proto3"
+
+var (
+	file_warehouse_service_v1_daily_history_proto_rawDescOnce sync.Once
+	file_warehouse_service_v1_daily_history_proto_rawDescData []byte
+)
+
+func file_warehouse_service_v1_daily_history_proto_rawDescGZIP() []byte {
+	file_warehouse_service_v1_daily_history_proto_rawDescOnce.Do(func() {
+		file_warehouse_service_v1_daily_history_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_warehouse_service_v1_daily_history_proto_rawDesc), len(file_warehouse_service_v1_daily_history_proto_rawDesc)))
+	})
+	return file_warehouse_service_v1_daily_history_proto_rawDescData
+}
+
+var file_warehouse_service_v1_daily_history_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_warehouse_service_v1_daily_history_proto_goTypes = []any{
+	(*DailyHistoryRebuildRequest)(nil),  // 0: warehouse_service.v1.DailyHistoryRebuildRequest
+	(*DailySkuHistory)(nil),             // 1: warehouse_service.v1.DailySkuHistory
+	(*DailyHistoryDiff)(nil),            // 2: warehouse_service.v1.DailyHistoryDiff
+	(*DailyHistoryRebuildResponse)(nil), // 3: warehouse_service.v1.DailyHistoryRebuildResponse
+	(*timestamppb.Timestamp)(nil),       // 4: google.protobuf.Timestamp
+}
+var file_warehouse_service_v1_daily_history_proto_depIdxs = []int32{
+	4, // 0: warehouse_service.v1.DailySkuHistory.t:type_name -> google.protobuf.Timestamp
+	4, // 1: warehouse_service.v1.DailyHistoryDiff.t:type_name -> google.protobuf.Timestamp
+	1, // 2: warehouse_service.v1.DailyHistoryDiff.current:type_name -> warehouse_service.v1.DailySkuHistory
+	1, // 3: warehouse_service.v1.DailyHistoryDiff.expected:type_name -> warehouse_service.v1.DailySkuHistory
+	2, // 4: warehouse_service.v1.DailyHistoryRebuildResponse.diffs:type_name -> warehouse_service.v1.DailyHistoryDiff
+	0, // 5: warehouse_service.v1.DailyHistoryService.DailyHistoryRebuild:input_type -> warehouse_service.v1.DailyHistoryRebuildRequest
+	3, // 6: warehouse_service.v1.DailyHistoryService.DailyHistoryRebuild:output_type -> warehouse_service.v1.DailyHistoryRebuildResponse
+	6, // [6:7] is the sub-list for method output_type
+	5, // [5:6] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_warehouse_service_v1_daily_history_proto_init() }
+func file_warehouse_service_v1_daily_history_proto_init() {
+	if File_warehouse_service_v1_daily_history_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_warehouse_service_v1_daily_history_proto_rawDesc), len(file_warehouse_service_v1_daily_history_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_warehouse_service_v1_daily_history_proto_goTypes,
+		DependencyIndexes: file_warehouse_service_v1_daily_history_proto_depIdxs,
+		MessageInfos:      file_warehouse_service_v1_daily_history_proto_msgTypes,
+	}.Build()
+	File_warehouse_service_v1_daily_history_proto = out.File
+	file_warehouse_service_v1_daily_history_proto_goTypes = nil
+	file_warehouse_service_v1_daily_history_proto_depIdxs = nil
+}
@@ -0,0 +1,619 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: warehouse_service/v1/dead_letter.proto
+
+package warehouse_service_iface
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	v1 "github.com/pdcgo/schema/services/common/v1"
+	_ "github.com/pdcgo/schema/services/role_base/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type DeadLetterStatus int32
+
+const (
+	DeadLetterStatus_DEAD_LETTER_STATUS_UNSPECIFIED DeadLetterStatus = 0
+	DeadLetterStatus_DEAD_LETTER_STATUS_RETRYING    DeadLetterStatus = 1
+	DeadLetterStatus_DEAD_LETTER_STATUS_DEAD        DeadLetterStatus = 2
+	DeadLetterStatus_DEAD_LETTER_STATUS_REPLAYING   DeadLetterStatus = 3
+	DeadLetterStatus_DEAD_LETTER_STATUS_REPLAYED    DeadLetterStatus = 4
+	DeadLetterStatus_DEAD_LETTER_STATUS_RESOLVED    DeadLetterStatus = 5
+)
+
+// Enum value maps for DeadLetterStatus.
+var (
+	DeadLetterStatus_name = map[int32]string{
+		0: "DEAD_LETTER_STATUS_UNSPECIFIED",
+		1: "DEAD_LETTER_STATUS_RETRYING",
+		2: "DEAD_LETTER_STATUS_DEAD",
+		3: "DEAD_LETTER_STATUS_REPLAYING",
+		4: "DEAD_LETTER_STATUS_REPLAYED",
+		5: "DEAD_LETTER_STATUS_RESOLVED",
+	}
+	DeadLetterStatus_value = map[string]int32{
+		"DEAD_LETTER_STATUS_UNSPECIFIED": 0,
+		"DEAD_LETTER_STATUS_RETRYING":    1,
+		"DEAD_LETTER_STATUS_DEAD":        2,
+		"DEAD_LETTER_STATUS_REPLAYING":   3,
+		"DEAD_LETTER_STATUS_REPLAYED":    4,
+		"DEAD_LETTER_STATUS_RESOLVED":    5,
+	}
+)
+
+func (x DeadLetterStatus) Enum() *DeadLetterStatus {
+	p := new(DeadLetterStatus)
+	*p = x
+	return p
+}
+
+func (x DeadLetterStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DeadLetterStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_warehouse_service_v1_dead_letter_proto_enumTypes[0].Descriptor()
+}
+
+func (DeadLetterStatus) Type() protoreflect.EnumType {
+	return &file_warehouse_service_v1_dead_letter_proto_enumTypes[0]
+}
+
+func (x DeadLetterStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DeadLetterStatus.Descriptor instead.
+func (DeadLetterStatus) EnumDescriptor() ([]byte, []int) {
+	return file_warehouse_service_v1_dead_letter_proto_rawDescGZIP(), []int{0}
+}
+
+type DeadLetter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	MessageId     string                 `protobuf:"bytes,2,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	Raw           []byte                 `protobuf:"bytes,3,opt,name=raw,proto3" json:"raw,omitempty"`
+	Error         string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	AttemptCount  int64                  `protobuf:"varint,5,opt,name=attempt_count,json=attemptCount,proto3" json:"attempt_count,omitempty"`
+	MaxAttempt    int64                  `protobuf:"varint,6,opt,name=max_attempt,json=maxAttempt,proto3" json:"max_attempt,omitempty"`
+	Status        DeadLetterStatus       `protobuf:"varint,7,opt,name=status,proto3,enum=warehouse_service.v1.DeadLetterStatus" json:"status,omitempty"`
+	LastAttemptAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=last_attempt_at,json=lastAttemptAt,proto3" json:"last_attempt_at,omitempty"`
+	ReplayedAt    *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=replayed_at,json=replayedAt,proto3" json:"replayed_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeadLetter) Reset() {
+	*x = DeadLetter{}
+	mi := &file_warehouse_service_v1_dead_letter_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeadLetter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeadLetter) ProtoMessage() {}
+
+func (x *DeadLetter) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_v1_dead_letter_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeadLetter.ProtoReflect.Descriptor instead.
+func (*DeadLetter) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_v1_dead_letter_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DeadLetter) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DeadLetter) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *DeadLetter) GetRaw() []byte {
+	if x != nil {
+		return x.Raw
+	}
+	return nil
+}
+
+func (x *DeadLetter) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *DeadLetter) GetAttemptCount() int64 {
+	if x != nil {
+		return x.AttemptCount
+	}
+	return 0
+}
+
+func (x *DeadLetter) GetMaxAttempt() int64 {
+	if x != nil {
+		return x.MaxAttempt
+	}
+	return 0
+}
+
+func (x *DeadLetter) GetStatus() DeadLetterStatus {
+	if x != nil {
+		return x.Status
+	}
+	return DeadLetterStatus_DEAD_LETTER_STATUS_UNSPECIFIED
+}
+
+func (x *DeadLetter) GetLastAttemptAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastAttemptAt
+	}
+	return nil
+}
+
+func (x *DeadLetter) GetReplayedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReplayedAt
+	}
+	return nil
+}
+
+func (x *DeadLetter) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *DeadLetter) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type DeadLetterListRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// unspecified is all status
+	Status        DeadLetterStatus `protobuf:"varint,1,opt,name=status,proto3,enum=warehouse_service.v1.DeadLetterStatus" json:"status,omitempty"`
+	Page          *v1.PageFilter   `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeadLetterListRequest) Reset() {
+	*x = DeadLetterListRequest{}
+	mi := &file_warehouse_service_v1_dead_letter_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeadLetterListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeadLetterListRequest) ProtoMessage() {}
+
+func (x *DeadLetterListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_v1_dead_letter_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeadLetterListRequest.ProtoReflect.Descriptor instead.
+func (*DeadLetterListRequest) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_v1_dead_letter_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DeadLetterListRequest) GetStatus() DeadLetterStatus {
+	if x != nil {
+		return x.Status
+	}
+	return DeadLetterStatus_DEAD_LETTER_STATUS_UNSPECIFIED
+}
+
+func (x *DeadLetterListRequest) GetPage() *v1.PageFilter {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type DeadLetterListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*DeadLetter          `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	PageInfo      *v1.PageInfo           `protobuf:"bytes,2,opt,name=page_info,json=pageInfo,proto3" json:"page_info,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeadLetterListResponse) Reset() {
+	*x = DeadLetterListResponse{}
+	mi := &file_warehouse_service_v1_dead_letter_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeadLetterListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeadLetterListResponse) ProtoMessage() {}
+
+func (x *DeadLetterListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_v1_dead_letter_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeadLetterListResponse.ProtoReflect.Descriptor instead.
+func (*DeadLetterListResponse) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_v1_dead_letter_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DeadLetterListResponse) GetData() []*DeadLetter {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *DeadLetterListResponse) GetPageInfo() *v1.PageInfo {
+	if x != nil {
+		return x.PageInfo
+	}
+	return nil
+}
+
+type DeadLetterGetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeadLetterGetRequest) Reset() {
+	*x = DeadLetterGetRequest{}
+	mi := &file_warehouse_service_v1_dead_letter_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeadLetterGetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeadLetterGetRequest) ProtoMessage() {}
+
+func (x *DeadLetterGetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_v1_dead_letter_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeadLetterGetRequest.ProtoReflect.Descriptor instead.
+func (*DeadLetterGetRequest) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_v1_dead_letter_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DeadLetterGetRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeadLetterGetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *DeadLetter            `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeadLetterGetResponse) Reset() {
+	*x = DeadLetterGetResponse{}
+	mi := &file_warehouse_service_v1_dead_letter_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeadLetterGetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeadLetterGetResponse) ProtoMessage() {}
+
+func (x *DeadLetterGetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_v1_dead_letter_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeadLetterGetResponse.ProtoReflect.Descriptor instead.
+func (*DeadLetterGetResponse) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_v1_dead_letter_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DeadLetterGetResponse) GetData() *DeadLetter {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type DeadLetterReplayRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeadLetterReplayRequest) Reset() {
+	*x = DeadLetterReplayRequest{}
+	mi := &file_warehouse_service_v1_dead_letter_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeadLetterReplayRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeadLetterReplayRequest) ProtoMessage() {}
+
+func (x *DeadLetterReplayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_v1_dead_letter_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeadLetterReplayRequest.ProtoReflect.Descriptor instead.
+func (*DeadLetterReplayRequest) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_v1_dead_letter_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DeadLetterReplayRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeadLetterReplayResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *DeadLetter            `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeadLetterReplayResponse) Reset() {
+	*x = DeadLetterReplayResponse{}
+	mi := &file_warehouse_service_v1_dead_letter_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeadLetterReplayResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeadLetterReplayResponse) ProtoMessage() {}
+
+func (x *DeadLetterReplayResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_v1_dead_letter_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeadLetterReplayResponse.ProtoReflect.Descriptor instead.
+func (*DeadLetterReplayResponse) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_v1_dead_letter_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeadLetterReplayResponse) GetData() *DeadLetter {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+var File_warehouse_service_v1_dead_letter_proto protoreflect.FileDescriptor
+
+const file_warehouse_service_v1_dead_letter_proto_rawDesc = "" +
+	"\n" +
+	"&warehouse_service/v1/dead_letter.proto\x12\x14warehouse_service.v1\x1a\x1bbuf/validate/validate.proto\x1a\x16common/v1/common.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17role_base/v1/role.proto\"\xe0\x03\n" +
+	"\n" +
+	"DeadLetter\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x02 \x01(\tR\tmessageId\x12\x10\n" +
+	"\x03raw\x18\x03 \x01(\fR\x03raw\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\x12#\n" +
+	"\rattempt_count\x18\x05 \x01(\x03R\fattemptCount\x12\x1f\n" +
+	"\vmax_attempt\x18\x06 \x01(\x03R\n" +
+	"maxAttempt\x12>\n" +
+	"\x06status\x18\a \x01(\x0e2&.warehouse_service.v1.DeadLetterStatusR\x06status\x12B\n" +
+	"\x0flast_attempt_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\rlastAttemptAt\x12;\n" +
+	"\vreplayed_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"replayedAt\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x96\x01\n" +
+	"\x15DeadLetterListRequest\x12H\n" +
+	"\x06status\x18\x01 \x01(\x0e2&.warehouse_service.v1.DeadLetterStatusB\b\xbaH\x05\x82\x01\x02\x10\x01R\x06status\x12)\n" +
+	"\x04page\x18\x02 \x01(\v2\x15.common.v1.PageFilterR\x04page:\b\x92\xb5\x18\x04\n" +
+	"\x02\x01\x02\"\x80\x01\n" +
+	"\x16DeadLetterListResponse\x124\n" +
+	"\x04data\x18\x01 \x03(\v2 .warehouse_service.v1.DeadLetterR\x04data\x120\n" +
+	"\tpage_info\x18\x02 \x01(\v2\x13.common.v1.PageInfoR\bpageInfo\"9\n" +
+	"\x14DeadLetterGetRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x04B\a\xbaH\x042\x02 \x00R\x02id:\b\x92\xb5\x18\x04\n" +
+	"\x02\x01\x02\"M\n" +
+	"\x15DeadLetterGetResponse\x124\n" +
+	"\x04data\x18\x01 \x01(\v2 .warehouse_service.v1.DeadLetterR\x04data\"<\n" +
+	"\x17DeadLetterReplayRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x04B\a\xbaH\x042\x02 \x00R\x02id:\b\x92\xb5\x18\x04\n" +
+	"\x02\x01\x02\"P\n" +
+	"\x18DeadLetterReplayResponse\x124\n" +
+	"\x04data\x18\x01 \x01(\v2 .warehouse_service.v1.DeadLetterR\x04data*\xd8\x01\n" +
+	"\x10DeadLetterStatus\x12\"\n" +
+	"\x1eDEAD_LETTER_STATUS_UNSPECIFIED\x10\x00\x12\x1f\n" +
+	"\x1bDEAD_LETTER_STATUS_RETRYING\x10\x01\x12\x1b\n" +
+	"\x17DEAD_LETTER_STATUS_DEAD\x10\x02\x12 \n" +
+	"\x1cDEAD_LETTER_STATUS_REPLAYING\x10\x03\x12\x1f\n" +
+	"\x1bDEAD_LETTER_STATUS_REPLAYED\x10\x04\x12\x1f\n" +
+	"\x1bDEAD_LETTER_STATUS_RESOLVED\x10\x052\xdd\x02\n" +
+	"\x11DeadLetterService\x12k\n" +
+	"\x0eDeadLetterList\x12+.warehouse_service.v1.DeadLetterListRequest\x1a,.warehouse_service.v1.DeadLetterListResponse\x12h\n" +
+	"\rDeadLetterGet\x12*.warehouse_service.v1.DeadLetterGetRequest\x1a+.warehouse_service.v1.DeadLetterGetResponse\x12q\n" +
+	"\x10DeadLetterReplay\x12-.warehouse_service.v1.DeadLetterReplayRequest\x1a..warehouse_service.v1.DeadLetterReplayResponseBZZXgithub.com/pdcgo/warehouse_service/services/warehouse_service/v1;warehouse_service_ifaceb\x06proto3"
+
+var (
+	file_warehouse_service_v1_dead_letter_proto_rawDescOnce sync.Once
+	file_warehouse_service_v1_dead_letter_proto_rawDescData []byte
+)
+
+func file_warehouse_service_v1_dead_letter_proto_rawDescGZIP() []byte {
+	file_warehouse_service_v1_dead_letter_proto_rawDescOnce.Do(func() {
+		file_warehouse_service_v1_dead_letter_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_warehouse_service_v1_dead_letter_proto_rawDesc), len(file_warehouse_service_v1_dead_letter_proto_rawDesc)))
+	})
+	return file_warehouse_service_v1_dead_letter_proto_rawDescData
+}
+
+var file_warehouse_service_v1_dead_letter_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_warehouse_service_v1_dead_letter_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_warehouse_service_v1_dead_letter_proto_goTypes = []any{
+	(DeadLetterStatus)(0),            // 0: warehouse_service.v1.DeadLetterStatus
+	(*DeadLetter)(nil),               // 1: warehouse_service.v1.DeadLetter
+	(*DeadLetterListRequest)(nil),    // 2: warehouse_service.v1.DeadLetterListRequest
+	(*DeadLetterListResponse)(nil),   // 3: warehouse_service.v1.DeadLetterListResponse
+	(*DeadLetterGetRequest)(nil),     // 4: warehouse_service.v1.DeadLetterGetRequest
+	(*DeadLetterGetResponse)(nil),    // 5: warehouse_service.v1.DeadLetterGetResponse
+	(*DeadLetterReplayRequest)(nil),  // 6: warehouse_service.v1.DeadLetterReplayRequest
+	(*DeadLetterReplayResponse)(nil), // 7: warehouse_service.v1.DeadLetterReplayResponse
+	(*timestamppb.Timestamp)(nil),    // 8: google.protobuf.Timestamp
+	(*v1.PageFilter)(nil),            // 9: common.v1.PageFilter
+	(*v1.PageInfo)(nil),              // 10: common.v1.PageInfo
+}
+var file_warehouse_service_v1_dead_letter_proto_depIdxs = []int32{
+	0,  // 0: warehouse_service.v1.DeadLetter.status:type_name -> warehouse_service.v1.DeadLetterStatus
+	8,  // 1: warehouse_service.v1.DeadLetter.last_attempt_at:type_name -> google.protobuf.Timestamp
+	8,  // 2: warehouse_service.v1.DeadLetter.replayed_at:type_name -> google.protobuf.Timestamp
+	8,  // 3: warehouse_service.v1.DeadLetter.created_at:type_name -> google.protobuf.Timestamp
+	8,  // 4: warehouse_service.v1.DeadLetter.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 5: warehouse_service.v1.DeadLetterListRequest.status:type_name -> warehouse_service.v1.DeadLetterStatus
+	9,  // 6: warehouse_service.v1.DeadLetterListRequest.page:type_name -> common.v1.PageFilter
+	1,  // 7: warehouse_service.v1.DeadLetterListResponse.data:type_name -> warehouse_service.v1.DeadLetter
+	10, // 8: warehouse_service.v1.DeadLetterListResponse.page_info:type_name -> common.v1.PageInfo
+	1,  // 9: warehouse_service.v1.DeadLetterGetResponse.data:type_name -> warehouse_service.v1.DeadLetter
+	1,  // 10: warehouse_service.v1.DeadLetterReplayResponse.data:type_name -> warehouse_service.v1.DeadLetter
+	2,  // 11: warehouse_service.v1.DeadLetterService.DeadLetterList:input_type -> warehouse_service.v1.DeadLetterListRequest
+	4,  // 12: warehouse_service.v1.DeadLetterService.DeadLetterGet:input_type -> warehouse_service.v1.DeadLetterGetRequest
+	6,  // 13: warehouse_service.v1.DeadLetterService.DeadLetterReplay:input_type -> warehouse_service.v1.DeadLetterReplayRequest
+	3,  // 14: warehouse_service.v1.DeadLetterService.DeadLetterList:output_type -> warehouse_service.v1.DeadLetterListResponse
+	5,  // 15: warehouse_service.v1.DeadLetterService.DeadLetterGet:output_type -> warehouse_service.v1.DeadLetterGetResponse
+	7,  // 16: warehouse_service.v1.DeadLetterService.DeadLetterReplay:output_type -> warehouse_service.v1.DeadLetterReplayResponse
+	14, // [14:17] is the sub-list for method output_type
+	11, // [11:14] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
+}
+
+func init() { file_warehouse_service_v1_dead_letter_proto_init() }
+func file_warehouse_service_v1_dead_letter_proto_init() {
+	if File_warehouse_service_v1_dead_letter_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_warehouse_service_v1_dead_letter_proto_rawDesc), len(file_warehouse_service_v1_dead_letter_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_warehouse_service_v1_dead_letter_proto_goTypes,
+		DependencyIndexes: file_warehouse_service_v1_dead_letter_proto_depIdxs,
+		EnumInfos:         file_warehouse_service_v1_dead_letter_proto_enumTypes,
+		MessageInfos:      file_warehouse_service_v1_dead_letter_proto_msgTypes,
+	}.Build()
+	File_warehouse_service_v1_dead_letter_proto = out.File
+	file_warehouse_service_v1_dead_letter_proto_goTypes = nil
+	file_warehouse_service_v1_dead_letter_proto_depIdxs = nil
+}
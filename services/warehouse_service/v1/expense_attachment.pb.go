@@ -0,0 +1,533 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: warehouse_service/v1/expense_attachment.proto
+
+package warehouse_service_iface
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ExpenseAttachment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ExpenseHistId uint64                 `protobuf:"varint,2,opt,name=expense_hist_id,json=expenseHistId,proto3" json:"expense_hist_id,omitempty"`
+	FileName      string                 `protobuf:"bytes,3,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	// sniffed from content on upload
+	ContentType   string                 `protobuf:"bytes,4,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Size          int64                  `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`
+	UploadedById  uint64                 `protobuf:"varint,6,opt,name=uploaded_by_id,json=uploadedById,proto3" json:"uploaded_by_id,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpenseAttachment) Reset() {
+	*x = ExpenseAttachment{}
+	mi := &file_warehouse_service_v1_expense_attachment_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpenseAttachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpenseAttachment) ProtoMessage() {}
+
+func (x *ExpenseAttachment) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_v1_expense_attachment_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpenseAttachment.ProtoReflect.Descriptor instead.
+func (*ExpenseAttachment) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_v1_expense_attachment_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ExpenseAttachment) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ExpenseAttachment) GetExpenseHistId() uint64 {
+	if x != nil {
+		return x.ExpenseHistId
+	}
+	return 0
+}
+
+func (x *ExpenseAttachment) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *ExpenseAttachment) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ExpenseAttachment) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *ExpenseAttachment) GetUploadedById() uint64 {
+	if x != nil {
+		return x.UploadedById
+	}
+	return 0
+}
+
+func (x *ExpenseAttachment) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ExpenseAttachmentUploadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DomainId      uint64                 `protobuf:"varint,1,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	HistId        uint64                 `protobuf:"varint,2,opt,name=hist_id,json=histId,proto3" json:"hist_id,omitempty"`
+	FileName      string                 `protobuf:"bytes,3,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	Content       []byte                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpenseAttachmentUploadRequest) Reset() {
+	*x = ExpenseAttachmentUploadRequest{}
+	mi := &file_warehouse_service_v1_expense_attachment_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpenseAttachmentUploadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpenseAttachmentUploadRequest) ProtoMessage() {}
+
+func (x *ExpenseAttachmentUploadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_v1_expense_attachment_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpenseAttachmentUploadRequest.ProtoReflect.Descriptor instead.
+func (*ExpenseAttachmentUploadRequest) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_v1_expense_attachment_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ExpenseAttachmentUploadRequest) GetDomainId() uint64 {
+	if x != nil {
+		return x.DomainId
+	}
+	return 0
+}
+
+func (x *ExpenseAttachmentUploadRequest) GetHistId() uint64 {
+	if x != nil {
+		return x.HistId
+	}
+	return 0
+}
+
+func (x *ExpenseAttachmentUploadRequest) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *ExpenseAttachmentUploadRequest) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+type ExpenseAttachmentUploadResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *ExpenseAttachment     `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpenseAttachmentUploadResponse) Reset() {
+	*x = ExpenseAttachmentUploadResponse{}
+	mi := &file_warehouse_service_v1_expense_attachment_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpenseAttachmentUploadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpenseAttachmentUploadResponse) ProtoMessage() {}
+
+func (x *ExpenseAttachmentUploadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_v1_expense_attachment_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpenseAttachmentUploadResponse.ProtoReflect.Descriptor instead.
+func (*ExpenseAttachmentUploadResponse) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_v1_expense_attachment_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ExpenseAttachmentUploadResponse) GetData() *ExpenseAttachment {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ExpenseAttachmentListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DomainId      uint64                 `protobuf:"varint,1,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	HistId        uint64                 `protobuf:"varint,2,opt,name=hist_id,json=histId,proto3" json:"hist_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpenseAttachmentListRequest) Reset() {
+	*x = ExpenseAttachmentListRequest{}
+	mi := &file_warehouse_service_v1_expense_attachment_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpenseAttachmentListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpenseAttachmentListRequest) ProtoMessage() {}
+
+func (x *ExpenseAttachmentListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_v1_expense_attachment_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpenseAttachmentListRequest.ProtoReflect.Descriptor instead.
+func (*ExpenseAttachmentListRequest) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_v1_expense_attachment_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ExpenseAttachmentListRequest) GetDomainId() uint64 {
+	if x != nil {
+		return x.DomainId
+	}
+	return 0
+}
+
+func (x *ExpenseAttachmentListRequest) GetHistId() uint64 {
+	if x != nil {
+		return x.HistId
+	}
+	return 0
+}
+
+type ExpenseAttachmentListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*ExpenseAttachment   `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpenseAttachmentListResponse) Reset() {
+	*x = ExpenseAttachmentListResponse{}
+	mi := &file_warehouse_service_v1_expense_attachment_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpenseAttachmentListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpenseAttachmentListResponse) ProtoMessage() {}
+
+func (x *ExpenseAttachmentListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_v1_expense_attachment_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpenseAttachmentListResponse.ProtoReflect.Descriptor instead.
+func (*ExpenseAttachmentListResponse) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_v1_expense_attachment_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ExpenseAttachmentListResponse) GetData() []*ExpenseAttachment {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ExpenseAttachmentDownloadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DomainId      uint64                 `protobuf:"varint,1,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	AttachmentId  uint64                 `protobuf:"varint,2,opt,name=attachment_id,json=attachmentId,proto3" json:"attachment_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpenseAttachmentDownloadRequest) Reset() {
+	*x = ExpenseAttachmentDownloadRequest{}
+	mi := &file_warehouse_service_v1_expense_attachment_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpenseAttachmentDownloadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpenseAttachmentDownloadRequest) ProtoMessage() {}
+
+func (x *ExpenseAttachmentDownloadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_v1_expense_attachment_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpenseAttachmentDownloadRequest.ProtoReflect.Descriptor instead.
+func (*ExpenseAttachmentDownloadRequest) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_v1_expense_attachment_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ExpenseAttachmentDownloadRequest) GetDomainId() uint64 {
+	if x != nil {
+		return x.DomainId
+	}
+	return 0
+}
+
+func (x *ExpenseAttachmentDownloadRequest) GetAttachmentId() uint64 {
+	if x != nil {
+		return x.AttachmentId
+	}
+	return 0
+}
+
+type ExpenseAttachmentDownloadResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attachment    *ExpenseAttachment     `protobuf:"bytes,1,opt,name=attachment,proto3" json:"attachment,omitempty"`
+	Chunk         []byte                 `protobuf:"bytes,2,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpenseAttachmentDownloadResponse) Reset() {
+	*x = ExpenseAttachmentDownloadResponse{}
+	mi := &file_warehouse_service_v1_expense_attachment_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpenseAttachmentDownloadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpenseAttachmentDownloadResponse) ProtoMessage() {}
+
+func (x *ExpenseAttachmentDownloadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_v1_expense_attachment_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpenseAttachmentDownloadResponse.ProtoReflect.Descriptor instead.
+func (*ExpenseAttachmentDownloadResponse) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_v1_expense_attachment_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ExpenseAttachmentDownloadResponse) GetAttachment() *ExpenseAttachment {
+	if x != nil {
+		return x.Attachment
+	}
+	return nil
+}
+
+func (x *ExpenseAttachmentDownloadResponse) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+var File_warehouse_service_v1_expense_attachment_proto protoreflect.FileDescriptor
+
+const file_warehouse_service_v1_expense_attachment_proto_rawDesc = "" +
+	"\n" +
+	"-warehouse_service/v1/expense_attachment.proto\x12\x14warehouse_service.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x80\x02\n" +
+	"\x11ExpenseAttachment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12&\n" +
+	"\x0fexpense_hist_id\x18\x02 \x01(\x04R\rexpenseHistId\x12\x1b\n" +
+	"\tfile_name\x18\x03 \x01(\tR\bfileName\x12!\n" +
+	"\fcontent_type\x18\x04 \x01(\tR\vcontentType\x12\x12\n" +
+	"\x04size\x18\x05 \x01(\x03R\x04size\x12$\n" +
+	"\x0euploaded_by_id\x18\x06 \x01(\x04R\fuploadedById\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x9f\x01\n" +
+	"\x1eExpenseAttachmentUploadRequest\x12\x1b\n" +
+	"\tdomain_id\x18\x01 \x01(\x04R\bdomainId\x12 \n" +
+	"\ahist_id\x18\x02 \x01(\x04B\a\xbaH\x042\x02 \x00R\x06histId\x12\x1b\n" +
+	"\tfile_name\x18\x03 \x01(\tR\bfileName\x12!\n" +
+	"\acontent\x18\x04 \x01(\fB\a\xbaH\x04z\x02\x10\x01R\acontent\"^\n" +
+	"\x1fExpenseAttachmentUploadResponse\x12;\n" +
+	"\x04data\x18\x01 \x01(\v2'.warehouse_service.v1.ExpenseAttachmentR\x04data\"]\n" +
+	"\x1cExpenseAttachmentListRequest\x12\x1b\n" +
+	"\tdomain_id\x18\x01 \x01(\x04R\bdomainId\x12 \n" +
+	"\ahist_id\x18\x02 \x01(\x04B\a\xbaH\x042\x02 \x00R\x06histId\"\\\n" +
+	"\x1dExpenseAttachmentListResponse\x12;\n" +
+	"\x04data\x18\x01 \x03(\v2'.warehouse_service.v1.ExpenseAttachmentR\x04data\"m\n" +
+	" ExpenseAttachmentDownloadRequest\x12\x1b\n" +
+	"\tdomain_id\x18\x01 \x01(\x04R\bdomainId\x12,\n" +
+	"\rattachment_id\x18\x02 \x01(\x04B\a\xbaH\x042\x02 \x00R\fattachmentId\"\x82\x01\n" +
+	"!ExpenseAttachmentDownloadResponse\x12G\n" +
+	"\n" +
+	"attachment\x18\x01 \x01(\v2'.warehouse_service.v1.ExpenseAttachmentR\n" +
+	"attachment\x12\x14\n" +
+	"\x05chunk\x18\x02 \x01(\fR\x05chunk2\xb7\x03\n" +
+	"\x18ExpenseAttachmentService\x12\x86\x01\n" +
+	"\x17ExpenseAttachmentUpload\x124.warehouse_service.v1.ExpenseAttachmentUploadRequest\x1a5.warehouse_service.v1.ExpenseAttachmentUploadResponse\x12\x80\x01\n" +
+	"\x15ExpenseAttachmentList\x122.warehouse_service.v1.ExpenseAttachmentListRequest\x1a3.warehouse_service.v1.ExpenseAttachmentListResponse\x12\x8e\x01\n" +
+	"\x19ExpenseAttachmentDownload\x126.warehouse_service.v1.ExpenseAttachmentDownloadRequest\x1a7.warehouse_service.v1.ExpenseAttachmentDownloadResponse0\x01BZZXgithub.com/pdcgo/warehouse_service/services/warehouse_service/v1;warehouse_service_ifaceb\x06proto3"
+
+var (
+	file_warehouse_service_v1_expense_attachment_proto_rawDescOnce sync.Once
+	file_warehouse_service_v1_expense_attachment_proto_rawDescData []byte
+)
+
+func file_warehouse_service_v1_expense_attachment_proto_rawDescGZIP() []byte {
+	file_warehouse_service_v1_expense_attachment_proto_rawDescOnce.Do(func() {
+		file_warehouse_service_v1_expense_attachment_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_warehouse_service_v1_expense_attachment_proto_rawDesc), len(file_warehouse_service_v1_expense_attachment_proto_rawDesc)))
+	})
+	return file_warehouse_service_v1_expense_attachment_proto_rawDescData
+}
+
+var file_warehouse_service_v1_expense_attachment_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_warehouse_service_v1_expense_attachment_proto_goTypes = []any{
+	(*ExpenseAttachment)(nil),                 // 0: warehouse_service.v1.ExpenseAttachment
+	(*ExpenseAttachmentUploadRequest)(nil),    // 1: warehouse_service.v1.ExpenseAttachmentUploadRequest
+	(*ExpenseAttachmentUploadResponse)(nil),   // 2: warehouse_service.v1.ExpenseAttachmentUploadResponse
+	(*ExpenseAttachmentListRequest)(nil),      // 3: warehouse_service.v1.ExpenseAttachmentListRequest
+	(*ExpenseAttachmentListResponse)(nil),     // 4: warehouse_service.v1.ExpenseAttachmentListResponse
+	(*ExpenseAttachmentDownloadRequest)(nil),  // 5: warehouse_service.v1.ExpenseAttachmentDownloadRequest
+	(*ExpenseAttachmentDownloadResponse)(nil), // 6: warehouse_service.v1.ExpenseAttachmentDownloadResponse
+	(*timestamppb.Timestamp)(nil),             // 7: google.protobuf.Timestamp
+}
+var file_warehouse_service_v1_expense_attachment_proto_depIdxs = []int32{
+	7, // 0: warehouse_service.v1.ExpenseAttachment.created_at:type_name -> google.protobuf.Timestamp
+	0, // 1: warehouse_service.v1.ExpenseAttachmentUploadResponse.data:type_name -> warehouse_service.v1.ExpenseAttachment
+	0, // 2: warehouse_service.v1.ExpenseAttachmentListResponse.data:type_name -> warehouse_service.v1.ExpenseAttachment
+	0, // 3: warehouse_service.v1.ExpenseAttachmentDownloadResponse.attachment:type_name -> warehouse_service.v1.ExpenseAttachment
+	1, // 4: warehouse_service.v1.ExpenseAttachmentService.ExpenseAttachmentUpload:input_type -> warehouse_service.v1.ExpenseAttachmentUploadRequest
+	3, // 5: warehouse_service.v1.ExpenseAttachmentService.ExpenseAttachmentList:input_type -> warehouse_service.v1.ExpenseAttachmentListRequest
+	5, // 6: warehouse_service.v1.ExpenseAttachmentService.ExpenseAttachmentDownload:input_type -> warehouse_service.v1.ExpenseAttachmentDownloadRequest
+	2, // 7: warehouse_service.v1.ExpenseAttachmentService.ExpenseAttachmentUpload:output_type -> warehouse_service.v1.ExpenseAttachmentUploadResponse
+	4, // 8: warehouse_service.v1.ExpenseAttachmentService.ExpenseAttachmentList:output_type -> warehouse_service.v1.ExpenseAttachmentListResponse
+	6, // 9: warehouse_service.v1.ExpenseAttachmentService.ExpenseAttachmentDownload:output_type -> warehouse_service.v1.ExpenseAttachmentDownloadResponse
+	7, // [7:10] is the sub-list for method output_type
+	4, // [4:7] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_warehouse_service_v1_expense_attachment_proto_init() }
+func file_warehouse_service_v1_expense_attachment_proto_init() {
+	if File_warehouse_service_v1_expense_attachment_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_warehouse_service_v1_expense_attachment_proto_rawDesc), len(file_warehouse_service_v1_expense_attachment_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_warehouse_service_v1_expense_attachment_proto_goTypes,
+		DependencyIndexes: file_warehouse_service_v1_expense_attachment_proto_depIdxs,
+		MessageInfos:      file_warehouse_service_v1_expense_attachment_proto_msgTypes,
+	}.Build()
+	File_warehouse_service_v1_expense_attachment_proto = out.File
+	file_warehouse_service_v1_expense_attachment_proto_goTypes = nil
+	file_warehouse_service_v1_expense_attachment_proto_depIdxs = nil
+}
@@ -0,0 +1,4154 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: warehouse_service/v1/finance_ext.proto
+
+package warehouse_service_iface
+
+import (
+	v1 "github.com/pdcgo/schema/services/common/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ExpenseListPage is paging and sorting of expense list. no page is returning all row.
+type ExpenseListPage struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Page  *v1.PageFilter         `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
+	// at, amount or created_at
+	SortBy        string `protobuf:"bytes,2,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
+	SortDesc      bool   `protobuf:"varint,3,opt,name=sort_desc,json=sortDesc,proto3" json:"sort_desc,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpenseListPage) Reset() {
+	*x = ExpenseListPage{}
+	mi := &file_warehouse_service_v1_finance_ext_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpenseListPage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpenseListPage) ProtoMessage() {}
+
+func (x *ExpenseListPage) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_v1_finance_ext_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpenseListPage.ProtoReflect.Descriptor instead.
+func (*ExpenseListPage) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_v1_finance_ext_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ExpenseListPage) GetPage() *v1.PageFilter {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *ExpenseListPage) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
+	}
+	return ""
+}
+
+func (x *ExpenseListPage) GetSortDesc() bool {
+	if x != nil {
+		return x.SortDesc
+	}
+	return false
+}
+
+type ExpenseAccount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	WarehouseId   uint64                 `protobuf:"varint,2,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	AccountTypeId uint64                 `protobuf:"varint,3,opt,name=account_type_id,json=accountTypeId,proto3" json:"account_type_id,omitempty"`
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	NumberId      string                 `protobuf:"bytes,5,opt,name=number_id,json=numberId,proto3" json:"number_id,omitempty"`
+	IsOpsAccount  bool                   `protobuf:"varint,6,opt,name=is_ops_account,json=isOpsAccount,proto3" json:"is_ops_account,omitempty"`
+	Disabled      bool                   `protobuf:"varint,7,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpenseAccount) Reset() {
+	*x = ExpenseAccount{}
+	mi := &file_warehouse_service_v1_finance_ext_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpenseAccount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpenseAccount) ProtoMessage() {}
+
+func (x *ExpenseAccount) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_v1_finance_ext_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpenseAccount.ProtoReflect.Descriptor instead.
+func (*ExpenseAccount) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_v1_finance_ext_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ExpenseAccount) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ExpenseAccount) GetWarehouseId() uint64 {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return 0
+}
+
+func (x *ExpenseAccount) GetAccountTypeId() uint64 {
+	if x != nil {
+		return x.AccountTypeId
+	}
+	return 0
+}
+
+func (x *ExpenseAccount) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ExpenseAccount) GetNumberId() string {
+	if x != nil {
+		return x.NumberId
+	}
+	return ""
+}
+
+func (x *ExpenseAccount) GetIsOpsAccount() bool {
+	if x != nil {
+		return x.IsOpsAccount
+	}
+	return false
+}
+
+func (x *ExpenseAccount) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *ExpenseAccount) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ExpenseHistory struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	WarehouseId uint64                 `protobuf:"varint,2,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	AccountId   uint64                 `protobuf:"varint,3,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	CreatedById uint64                 `protobuf:"varint,4,opt,name=created_by_id,json=createdById,proto3" json:"created_by_id,omitempty"`
+	ExpenseType string                 `protobuf:"bytes,5,opt,name=expense_type,json=expenseType,proto3" json:"expense_type,omitempty"`
+	Amount      float64                `protobuf:"fixed64,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	Note        string                 `protobuf:"bytes,7,opt,name=note,proto3" json:"note,omitempty"`
+	// pending, approved or rejected
+	Status string `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+	// 0 is not transfer leg
+	TransferId   uint64                 `protobuf:"varint,9,opt,name=transfer_id,json=transferId,proto3" json:"transfer_id,omitempty"`
+	Deleted      bool                   `protobuf:"varint,10,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	At           *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=at,proto3" json:"at,omitempty"`
+	CreatedAt    *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	IsOpsAccount bool                   `protobuf:"varint,13,opt,name=is_ops_account,json=isOpsAccount,proto3" json:"is_ops_account,omitempty"`
+	// other account of transfer leg
+	TransferAccountId uint64 `protobuf:"varint,14,opt,name=transfer_account_id,json=transferAccountId,proto3" json:"transfer_account_id,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ExpenseHistory) Reset() {
+	*x = ExpenseHistory{}
+	mi := &file_warehouse_service_v1_finance_ext_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpenseHistory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpenseHistory) ProtoMessage() {}
+
+func (x *ExpenseHistory) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_v1_finance_ext_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpenseHistory.ProtoReflect.Descriptor instead.
+func (*ExpenseHistory) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_v1_finance_ext_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ExpenseHistory) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ExpenseHistory) GetWarehouseId() uint64 {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return 0
+}
+
+func (x *ExpenseHistory) GetAccountId() uint64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *ExpenseHistory) GetCreatedById() uint64 {
+	if x != nil {
+		return x.CreatedById
+	}
+	return 0
+}
+
+func (x *ExpenseHistory) GetExpenseType() string {
+	if x != nil {
+		return x.ExpenseType
+	}
+	return ""
+}
+
+func (x *ExpenseHistory) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *ExpenseHistory) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *ExpenseHistory) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ExpenseHistory) GetTransferId() uint64 {
+	if x != nil {
+		return x.TransferId
+	}
+	return 0
+}
+
+func (x *ExpenseHistory) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
+
+func (x *ExpenseHistory) GetAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.At
+	}
+	return nil
+}
+
+func (x *ExpenseHistory) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ExpenseHistory) GetIsOpsAccount() bool {
+	if x != nil {
+		return x.IsOpsAccount
+	}
+	return false
+}
+
+func (x *ExpenseHistory) GetTransferAccountId() uint64 {
+	if x != nil {
+		return x.TransferAccountId
+	}
+	return 0
+}
+
+// ExpenseTotal is count and amount per expense type and flow type, outcome amount is negated.
+type ExpenseTotal struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	ExpenseType string                 `protobuf:"bytes,1,opt,name=expense_type,json=expenseType,proto3" json:"expense_type,omitempty"`
+	// income or outcome
+	FlowType      string  `protobuf:"bytes,2,opt,name=flow_type,json=flowType,proto3" json:"flow_type,omitempty"`
+	Count         int64   `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Amount        float64 `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpenseTotal) Reset() {
+	*x = ExpenseTotal{}
+	mi := &file_warehouse_service_v1_finance_ext_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpenseTotal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpenseTotal) ProtoMessage() {}
+
+func (x *ExpenseTotal) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_v1_finance_ext_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpenseTotal.ProtoReflect.Descriptor instead.
+func (*ExpenseTotal) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_v1_finance_ext_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ExpenseTotal) GetExpenseType() string {
+	if x != nil {
+		return x.ExpenseType
+	}
+	return ""
+}
+
+func (x *ExpenseTotal) GetFlowType() string {
+	if x != nil {
+		return x.FlowType
+	}
+	return ""
+}
+
+func (x *ExpenseTotal) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *ExpenseTotal) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type ExpenseReportDay struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	StartDate        int64                  `protobuf:"varint,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate          int64                  `protobuf:"varint,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Expense          float64                `protobuf:"fixed64,3,opt,name=expense,proto3" json:"expense,omitempty"`
+	Income           float64                `protobuf:"fixed64,4,opt,name=income,proto3" json:"income,omitempty"`
+	SystemDiffAmount float64                `protobuf:"fixed64,5,opt,name=system_diff_amount,json=systemDiffAmount,proto3" json:"system_diff_amount,omitempty"`
+	ActualDiffAmount float64                `protobuf:"fixed64,6,opt,name=actual_diff_amount,json=actualDiffAmount,proto3" json:"actual_diff_amount,omitempty"`
+	ErrDiffAmount    float64                `protobuf:"fixed64,7,opt,name=err_diff_amount,json=errDiffAmount,proto3" json:"err_diff_amount,omitempty"`
+	OpeningAmount    float64                `protobuf:"fixed64,8,opt,name=opening_amount,json=openingAmount,proto3" json:"opening_amount,omitempty"`
+	ClosingAmount    float64                `protobuf:"fixed64,9,opt,name=closing_amount,json=closingAmount,proto3" json:"closing_amount,omitempty"`
+	Types            []*ExpenseTotal        `protobuf:"bytes,10,rep,name=types,proto3" json:"types,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ExpenseReportDay) Reset() {
+	*x = ExpenseReportDay{}
+	mi := &file_warehouse_service_v1_finance_ext_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpenseReportDay) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpenseReportDay) ProtoMessage() {}
+
+func (x *ExpenseReportDay) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_v1_finance_ext_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpenseReportDay.ProtoReflect.Descriptor instead.
+func (*ExpenseReportDay) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_v1_finance_ext_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ExpenseReportDay) GetStartDate() int64 {
+	if x != nil {
+		return x.StartDate
+	}
+	return 0
+}
+
+func (x *ExpenseReportDay) GetEndDate() int64 {
+	if x != nil {
+		return x.EndDate
+	}
+	return 0
+}
+
+func (x *ExpenseReportDay) GetExpense() float64 {
+	if x != nil {
+		return x.Expense
+	}
+	return 0
+}
+
+func (x *ExpenseReportDay) GetIncome() float64 {
+	if x != nil {
+		return x.Income
+	}
+	return 0
+}
+
+func (x *ExpenseReportDay) GetSystemDiffAmount() float64 {
+	if x != nil {
+		return x.SystemDiffAmount
+	}
+	return 0
+}
+
+func (x *ExpenseReportDay) GetActualDiffAmount() float64 {
+	if x != nil {
+		return x.ActualDiffAmount
+	}
+	return 0
+}
+
+func (x *ExpenseReportDay) GetErrDiffAmount() float64 {
+	if x != nil {
+		return x.ErrDiffAmount
+	}
+	return 0
+}
+
+func (x *ExpenseReportDay) GetOpeningAmount() float64 {
+	if x != nil {
+		return x.OpeningAmount
+	}
+	return 0
+}
+
+func (x *ExpenseReportDay) GetClosingAmount() float64 {
+	if x != nil {
+		return x.ClosingAmount
+	}
+	return 0
+}
+
+func (x *ExpenseReportDay) GetTypes() []*ExpenseTotal {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+type ExpenseReportDailyDetailRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	AccountId   uint64                 `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	WarehouseId uint64                 `protobuf:"varint,2,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	// unix milli, empty is last 31 day until today
+	StartDate     int64 `protobuf:"varint,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       int64 `protobuf:"varint,4,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpenseReportDailyDetailRequest) Reset() {
+	*x = ExpenseReportDailyDetailRequest{}
+	mi := &file_warehouse_service_v1_finance_ext_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpenseReportDailyDetailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpenseReportDailyDetailRequest) ProtoMessage() {}
+
+func (x *ExpenseReportDailyDetailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_v1_finance_ext_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpenseReportDailyDetailRequest.ProtoReflect.Descriptor instead.
+func (*ExpenseReportDailyDetailRequest) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_v1_finance_ext_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ExpenseReportDailyDetailRequest) GetAccountId() uint64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *ExpenseReportDailyDetailRequest) GetWarehouseId() uint64 {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return 0
+}
+
+func (x *ExpenseReportDailyDetailRequest) GetStartDate() int64 {
+	if x != nil {
+		return x.StartDate
+	}
+	return 0
+}
+
+func (x *ExpenseReportDailyDetailRequest) GetEndDate() int64 {
+	if x != nil {
+		return x.EndDate
+	}
+	return 0
+}
+
+type ExpenseReportDailyDetailResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*ExpenseReportDay    `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpenseReportDailyDetailResponse) Reset() {
+	*x = ExpenseReportDailyDetailResponse{}
+	mi := &file_warehouse_service_v1_finance_ext_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpenseReportDailyDetailResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpenseReportDailyDetailResponse) ProtoMessage() {}
+
+func (x *ExpenseReportDailyDetailResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_v1_finance_ext_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpenseReportDailyDetailResponse.ProtoReflect.Descriptor instead.
+func (*ExpenseReportDailyDetailResponse) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_v1_finance_ext_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ExpenseReportDailyDetailResponse) GetData() []*ExpenseReportDay {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ExpenseAccountPageRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	WarehouseId  uint64                 `protobuf:"varint,1,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	NumberId     string                 `protobuf:"bytes,2,opt,name=number_id,json=numberId,proto3" json:"number_id,omitempty"`
+	Name         string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	IsOpsAccount bool                   `protobuf:"varint,4,opt,name=is_ops_account,json=isOpsAccount,proto3" json:"is_ops_account,omitempty"`
+	// active or disabled, empty is all account
+	Status        string           `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	Page          *ExpenseListPage `protobuf:"bytes,6,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpenseAccountPageRequest) Reset() {
+	*x = ExpenseAccountPageRequest{}
+	mi := &file_warehouse_service_v1_finance_ext_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpenseAccountPageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpenseAccountPageRequest) ProtoMessage() {}
+
+func (x *ExpenseAccountPageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_v1_finance_ext_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpenseAccountPageRequest.ProtoReflect.Descriptor instead.
+func (*ExpenseAccountPageRequest) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_v1_finance_ext_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ExpenseAccountPageRequest) GetWarehouseId() uint64 {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return 0
+}
+
+func (x *ExpenseAccountPageRequest) GetNumberId() string {
+	if x != nil {
+		return x.NumberId
+	}
+	return ""
+}
+
+func (x *ExpenseAccountPageRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ExpenseAccountPageRequest) GetIsOpsAccount() bool {
+	if x != nil {
+		return x.IsOpsAccount
+	}
+	return false
+}
+
+func (x *ExpenseAccountPageRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ExpenseAccountPageRequest) GetPage() *ExpenseListPage {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type ExpenseAccountPageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*ExpenseAccount      `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	PageInfo      *v1.PageInfo           `protobuf:"bytes,2,opt,name=page_info,json=pageInfo,proto3" json:"page_info,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpenseAccountPageResponse) Reset() {
+	*x = ExpenseAccountPageResponse{}
+	mi := &file_warehouse_service_v1_finance_ext_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpenseAccountPageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpenseAccountPageResponse) ProtoMessage() {}
+
+func (x *ExpenseAccountPageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_v1_finance_ext_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpenseAccountPageResponse.ProtoReflect.Descriptor instead.
+func (*ExpenseAccountPageResponse) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_v1_finance_ext_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ExpenseAccountPageResponse) GetData() []*ExpenseAccount {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ExpenseAccountPageResponse) GetPageInfo() *v1.PageInfo {
+	if x != nil {
+		return x.PageInfo
+	}
+	return nil
+}
+
+type ExpenseAccountSetDisabledRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DomainId      uint64                 `protobuf:"varint,1,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	AccountId     uint64                 `protobuf:"varint,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	WarehouseId   uint64                 `protobuf:"varint,3,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	Disabled      bool                   `protobuf:"varint,4,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	Note          string                 `protobuf:"bytes,5,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpenseAccountSetDisabledRequest) Reset() {
+	*x = ExpenseAccountSetDisabledRequest{}
+	mi := &file_warehouse_service_v1_finance_ext_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpenseAccountSetDisabledRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpenseAccountSetDisabledRequest) ProtoMessage() {}
+
+func (x *ExpenseAccountSetDisabledRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_v1_finance_ext_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpenseAccountSetDisabledRequest.ProtoReflect.Descriptor instead.
+func (*ExpenseAccountSetDisabledRequest) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_v1_finance_ext_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ExpenseAccountSetDisabledRequest) GetDomainId() uint64 {
+	if x != nil {
+		return x.DomainId
+	}
+	return 0
+}
+
+func (x *ExpenseAccountSetDisabledRequest) GetAccountId() uint64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *ExpenseAccountSetDisabledRequest) GetWarehouseId() uint64 {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return 0
+}
+
+func (x *ExpenseAccountSetDisabledRequest) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *ExpenseAccountSetDisabledRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type ExpenseAccountSetDisabledResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *ExpenseAccount        `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpenseAccountSetDisabledResponse) Reset() {
+	*x = ExpenseAccountSetDisabledResponse{}
+	mi := &file_warehouse_service_v1_finance_ext_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpenseAccountSetDisabledResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpenseAccountSetDisabledResponse) ProtoMessage() {}
+
+func (x *ExpenseAccountSetDisabledResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_v1_finance_ext_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpenseAccountSetDisabledResponse.ProtoReflect.Descriptor instead.
+func (*ExpenseAccountSetDisabledResponse) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_v1_finance_ext_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ExpenseAccountSetDisabledResponse) GetData() *ExpenseAccount {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ExpenseHistoryPageRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	AccountId    uint64                 `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	WarehouseId  uint64                 `protobuf:"varint,2,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	IsOpsAccount bool                   `protobuf:"varint,3,opt,name=is_ops_account,json=isOpsAccount,proto3" json:"is_ops_account,omitempty"`
+	ExpenseType  string                 `protobuf:"bytes,4,opt,name=expense_type,json=expenseType,proto3" json:"expense_type,omitempty"`
+	StartDate    int64                  `protobuf:"varint,5,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate      int64                  `protobuf:"varint,6,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	// at or created_at, empty is at
+	TimeType string `protobuf:"bytes,7,opt,name=time_type,json=timeType,proto3" json:"time_type,omitempty"`
+	// empty is all status
+	Status         string `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+	IncludeDeleted bool   `protobuf:"varint,9,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"`
+	// no page is newest first
+	Page          *ExpenseListPage `protobuf:"bytes,10,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpenseHistoryPageRequest) Reset() {
+	*x = ExpenseHistoryPageRequest{}
+	mi := &file_warehouse_service_v1_finance_ext_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpenseHistoryPageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpenseHistoryPageRequest) ProtoMessage() {}
+
+func (x *ExpenseHistoryPageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_v1_finance_ext_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpenseHistoryPageRequest.ProtoReflect.Descriptor instead.
+func (*ExpenseHistoryPageRequest) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_v1_finance_ext_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ExpenseHistoryPageRequest) GetAccountId() uint64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *ExpenseHistoryPageRequest) GetWarehouseId() uint64 {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return 0
+}
+
+func (x *ExpenseHistoryPageRequest) GetIsOpsAccount() bool {
+	if x != nil {
+		return x.IsOpsAccount
+	}
+	return false
+}
+
+func (x *ExpenseHistoryPageRequest) GetExpenseType() string {
+	if x != nil {
+		return x.ExpenseType
+	}
+	return ""
+}
+
+func (x *ExpenseHistoryPageRequest) GetStartDate() int64 {
+	if x != nil {
+		return x.StartDate
+	}
+	return 0
+}
+
+func (x *ExpenseHistoryPageRequest) GetEndDate() int64 {
+	if x != nil {
+		return x.EndDate
+	}
+	return 0
+}
+
+func (x *ExpenseHistoryPageRequest) GetTimeType() string {
+	if x != nil {
+		return x.TimeType
+	}
+	return ""
+}
+
+func (x *ExpenseHistoryPageRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ExpenseHistoryPageRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+func (x *ExpenseHistoryPageRequest) GetPage() *ExpenseListPage {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type ExpenseHistoryPageResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Data     []*ExpenseHistory      `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	PageInfo *v1.PageInfo           `protobuf:"bytes,2,opt,name=page_info,json=pageInfo,proto3" json:"page_info,omitempty"`
+	// over all matching row, not only current page
+	Totals        []*ExpenseTotal `protobuf:"bytes,3,rep,name=totals,proto3" json:"totals,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpenseHistoryPageResponse) Reset() {
+	*x = ExpenseHistoryPageResponse{}
+	mi := &file_warehouse_service_v1_finance_ext_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpenseHistoryPageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpenseHistoryPageResponse) ProtoMessage() {}
+
+func (x *ExpenseHistoryPageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_v1_finance_ext_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpenseHistoryPageResponse.ProtoReflect.Descriptor instead.
+func (*ExpenseHistoryPageResponse) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_v1_finance_ext_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ExpenseHistoryPageResponse) GetData() []*ExpenseHistory {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ExpenseHistoryPageResponse) GetPageInfo() *v1.PageInfo {
+	if x != nil {
+		return x.PageInfo
+	}
+	return nil
+}
+
+func (x *ExpenseHistoryPageResponse) GetTotals() []*ExpenseTotal {
+	if x != nil {
+		return x.Totals
+	}
+	return nil
+}
+
+type ExpenseHistoryReviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DomainId      uint64                 `protobuf:"varint,1,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	HistId        uint64                 `protobuf:"varint,2,opt,name=hist_id,json=histId,proto3" json:"hist_id,omitempty"`
+	Approve       bool                   `protobuf:"varint,3,opt,name=approve,proto3" json:"approve,omitempty"`
+	Note          string                 `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpenseHistoryReviewRequest) Reset() {
+	*x = ExpenseHistoryReviewRequest{}
+	mi := &file_warehouse_service_v1_finance_ext_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpenseHistoryReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpenseHistoryReviewRequest) ProtoMessage() {}
+
+func (x *ExpenseHistoryReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_v1_finance_ext_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpenseHistoryReviewRequest.ProtoReflect.Descriptor instead.
+func (*ExpenseHistoryReviewRequest) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_v1_finance_ext_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ExpenseHistoryReviewRequest) GetDomainId() uint64 {
+	if x != nil {
+		return x.DomainId
+	}
+	return 0
+}
+
+func (x *ExpenseHistoryReviewRequest) GetHistId() uint64 {
+	if x != nil {
+		return x.HistId
+	}
+	return 0
+}
+
+func (x *ExpenseHistoryReviewRequest) GetApprove() bool {
+	if x != nil {
+		return x.Approve
+	}
+	return false
+}
+
+func (x *ExpenseHistoryReviewRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type ExpenseHistoryReviewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *ExpenseHistory        `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpenseHistoryReviewResponse) Reset() {
+	*x = ExpenseHistoryReviewResponse{}
+	mi := &file_warehouse_service_v1_finance_ext_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpenseHistoryReviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpenseHistoryReviewResponse) ProtoMessage() {}
+
+func (x *ExpenseHistoryReviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_v1_finance_ext_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpenseHistoryReviewResponse.ProtoReflect.Descriptor instead.
+func (*ExpenseHistoryReviewResponse) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_v1_finance_ext_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ExpenseHistoryReviewResponse) GetData() *ExpenseHistory {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ExpenseRevisionValue struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WarehouseId   uint64                 `protobuf:"varint,1,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	AccountId     uint64                 `protobuf:"varint,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	ExpenseType   string                 `protobuf:"bytes,3,opt,name=expense_type,json=expenseType,proto3" json:"expense_type,omitempty"`
+	Amount        float64                `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Note          string                 `protobuf:"bytes,5,opt,name=note,proto3" json:"note,omitempty"`
+	Status        string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	At            *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=at,proto3" json:"at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpenseRevisionValue) Reset() {
+	*x = ExpenseRevisionValue{}
+	mi := &file_warehouse_service_v1_finance_ext_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpenseRevisionValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpenseRevisionValue) ProtoMessage() {}
+
+func (x *ExpenseRevisionValue) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_v1_finance_ext_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpenseRevisionValue.ProtoReflect.Descriptor instead.
+func (*ExpenseRevisionValue) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_v1_finance_ext_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ExpenseRevisionValue) GetWarehouseId() uint64 {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return 0
+}
+
+func (x *ExpenseRevisionValue) GetAccountId() uint64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *ExpenseRevisionValue) GetExpenseType() string {
+	if x != nil {
+		return x.ExpenseType
+	}
+	return ""
+}
+
+func (x *ExpenseRevisionValue) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *ExpenseRevisionValue) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *ExpenseRevisionValue) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ExpenseRevisionValue) GetAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.At
+	}
+	return nil
+}
+
+type ExpenseRevision struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ExpenseHistId uint64                 `protobuf:"varint,2,opt,name=expense_hist_id,json=expenseHistId,proto3" json:"expense_hist_id,omitempty"`
+	EditorId      uint64                 `protobuf:"varint,3,opt,name=editor_id,json=editorId,proto3" json:"editor_id,omitempty"`
+	EditorFrom    string                 `protobuf:"bytes,4,opt,name=editor_from,json=editorFrom,proto3" json:"editor_from,omitempty"`
+	Before        *ExpenseRevisionValue  `protobuf:"bytes,5,opt,name=before,proto3" json:"before,omitempty"`
+	After         *ExpenseRevisionValue  `protobuf:"bytes,6,opt,name=after,proto3" json:"after,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// field changed by revision
+	Changes       []string `protobuf:"bytes,8,rep,name=changes,proto3" json:"changes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpenseRevision) Reset() {
+	*x = ExpenseRevision{}
+	mi := &file_warehouse_service_v1_finance_ext_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpenseRevision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpenseRevision) ProtoMessage() {}
+
+func (x *ExpenseRevision) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_v1_finance_ext_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpenseRevision.ProtoReflect.Descriptor instead.
+func (*ExpenseRevision) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_v1_finance_ext_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ExpenseRevision) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ExpenseRevision) GetExpenseHistId() uint64 {
+	if x != nil {
+		return x.ExpenseHistId
+	}
+	return 0
+}
+
+func (x *ExpenseRevision) GetEditorId() uint64 {
+	if x != nil {
+		return x.EditorId
+	}
+	return 0
+}
+
+func (x *ExpenseRevision) GetEditorFrom() string {
+	if x != nil {
+		return x.EditorFrom
+	}
+	return ""
+}
+
+func (x *ExpenseRevision) GetBefore() *ExpenseRevisionValue {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *ExpenseRevision) GetAfter() *ExpenseRevisionValue {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+func (x *ExpenseRevision) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ExpenseRevision) GetChanges() []string {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+type ExpenseHistoryRevisionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DomainId      uint64                 `protobuf:"varint,1,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	HistId        uint64                 `protobuf:"varint,2,opt,name=hist_id,json=histId,proto3" json:"hist_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpenseHistoryRevisionRequest) Reset() {
+	*x = ExpenseHistoryRevisionRequest{}
+	mi := &file_warehouse_service_v1_finance_ext_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpenseHistoryRevisionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpenseHistoryRevisionRequest) ProtoMessage() {}
+
+func (x *ExpenseHistoryRevisionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_v1_finance_ext_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpenseHistoryRevisionRequest.ProtoReflect.Descriptor instead.
+func (*ExpenseHistoryRevisionRequest) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_v1_finance_ext_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ExpenseHistoryRevisionRequest) GetDomainId() uint64 {
+	if x != nil {
+		return x.DomainId
+	}
+	return 0
+}
+
+func (x *ExpenseHistoryRevisionRequest) GetHistId() uint64 {
+	if x != nil {
+		return x.HistId
+	}
+	return 0
+}
+
+type ExpenseHistoryRevisionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*ExpenseRevision     `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpenseHistoryRevisionResponse) Reset() {
+	*x = ExpenseHistoryRevisionResponse{}
+	mi := &file_warehouse_service_v1_finance_ext_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpenseHistoryRevisionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpenseHistoryRevisionResponse) ProtoMessage() {}
+
+func (x *ExpenseHistoryRevisionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_v1_finance_ext_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpenseHistoryRevisionResponse.ProtoReflect.Descriptor instead.
+func (*ExpenseHistoryRevisionResponse) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_v1_finance_ext_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ExpenseHistoryRevisionResponse) GetData() []*ExpenseRevision {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ExpenseHistoryDeleteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DomainId      uint64                 `protobuf:"varint,1,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	HistId        uint64                 `protobuf:"varint,2,opt,name=hist_id,json=histId,proto3" json:"hist_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpenseHistoryDeleteRequest) Reset() {
+	*x = ExpenseHistoryDeleteRequest{}
+	mi := &file_warehouse_service_v1_finance_ext_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpenseHistoryDeleteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpenseHistoryDeleteRequest) ProtoMessage() {}
+
+func (x *ExpenseHistoryDeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_v1_finance_ext_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpenseHistoryDeleteRequest.ProtoReflect.Descriptor instead.
+func (*ExpenseHistoryDeleteRequest) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_v1_finance_ext_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ExpenseHistoryDeleteRequest) GetDomainId() uint64 {
+	if x != nil {
+		return x.DomainId
+	}
+	return 0
+}
+
+func (x *ExpenseHistoryDeleteRequest) GetHistId() uint64 {
+	if x != nil {
+		return x.HistId
+	}
+	return 0
+}
+
+func (x *ExpenseHistoryDeleteRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ExpenseHistoryDeleteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *ExpenseHistory        `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpenseHistoryDeleteResponse) Reset() {
+	*x = ExpenseHistoryDeleteResponse{}
+	mi := &file_warehouse_service_v1_finance_ext_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpenseHistoryDeleteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpenseHistoryDeleteResponse) ProtoMessage() {}
+
+func (x *ExpenseHistoryDeleteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_v1_finance_ext_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpenseHistoryDeleteResponse.ProtoReflect.Descriptor instead.
+func (*ExpenseHistoryDeleteResponse) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_v1_finance_ext_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ExpenseHistoryDeleteResponse) GetData() *ExpenseHistory {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ExpenseHistoryRestoreRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DomainId      uint64                 `protobuf:"varint,1,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	HistId        uint64                 `protobuf:"varint,2,opt,name=hist_id,json=histId,proto3" json:"hist_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpenseHistoryRestoreRequest) Reset() {
+	*x = ExpenseHistoryRestoreRequest{}
+	mi := &file_warehouse_service_v1_finance_ext_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpenseHistoryRestoreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpenseHistoryRestoreRequest) ProtoMessage() {}
+
+func (x *ExpenseHistoryRestoreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_v1_finance_ext_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpenseHistoryRestoreRequest.ProtoReflect.Descriptor instead.
+func (*ExpenseHistoryRestoreRequest) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_v1_finance_ext_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ExpenseHistoryRestoreRequest) GetDomainId() uint64 {
+	if x != nil {
+		return x.DomainId
+	}
+	return 0
+}
+
+func (x *ExpenseHistoryRestoreRequest) GetHistId() uint64 {
+	if x != nil {
+		return x.HistId
+	}
+	return 0
+}
+
+func (x *ExpenseHistoryRestoreRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ExpenseHistoryRestoreResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *ExpenseHistory        `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpenseHistoryRestoreResponse) Reset() {
+	*x = ExpenseHistoryRestoreResponse{}
+	mi := &file_warehouse_service_v1_finance_ext_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpenseHistoryRestoreResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpenseHistoryRestoreResponse) ProtoMessage() {}
+
+func (x *ExpenseHistoryRestoreResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_v1_finance_ext_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpenseHistoryRestoreResponse.ProtoReflect.Descriptor instead.
+func (*ExpenseHistoryRestoreResponse) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_v1_finance_ext_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ExpenseHistoryRestoreResponse) GetData() *ExpenseHistory {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ExpenseTransfer struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	FromWarehouseId uint64                 `protobuf:"varint,2,opt,name=from_warehouse_id,json=fromWarehouseId,proto3" json:"from_warehouse_id,omitempty"`
+	FromAccountId   uint64                 `protobuf:"varint,3,opt,name=from_account_id,json=fromAccountId,proto3" json:"from_account_id,omitempty"`
+	ToWarehouseId   uint64                 `protobuf:"varint,4,opt,name=to_warehouse_id,json=toWarehouseId,proto3" json:"to_warehouse_id,omitempty"`
+	ToAccountId     uint64                 `protobuf:"varint,5,opt,name=to_account_id,json=toAccountId,proto3" json:"to_account_id,omitempty"`
+	Amount          float64                `protobuf:"fixed64,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	Note            string                 `protobuf:"bytes,7,opt,name=note,proto3" json:"note,omitempty"`
+	At              *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=at,proto3" json:"at,omitempty"`
+	CreatedById     uint64                 `protobuf:"varint,9,opt,name=created_by_id,json=createdById,proto3" json:"created_by_id,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ExpenseTransfer) Reset() {
+	*x = ExpenseTransfer{}
+	mi := &file_warehouse_service_v1_finance_ext_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpenseTransfer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpenseTransfer) ProtoMessage() {}
+
+func (x *ExpenseTransfer) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_v1_finance_ext_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpenseTransfer.ProtoReflect.Descriptor instead.
+func (*ExpenseTransfer) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_v1_finance_ext_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ExpenseTransfer) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ExpenseTransfer) GetFromWarehouseId() uint64 {
+	if x != nil {
+		return x.FromWarehouseId
+	}
+	return 0
+}
+
+func (x *ExpenseTransfer) GetFromAccountId() uint64 {
+	if x != nil {
+		return x.FromAccountId
+	}
+	return 0
+}
+
+func (x *ExpenseTransfer) GetToWarehouseId() uint64 {
+	if x != nil {
+		return x.ToWarehouseId
+	}
+	return 0
+}
+
+func (x *ExpenseTransfer) GetToAccountId() uint64 {
+	if x != nil {
+		return x.ToAccountId
+	}
+	return 0
+}
+
+func (x *ExpenseTransfer) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *ExpenseTransfer) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *ExpenseTransfer) GetAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.At
+	}
+	return nil
+}
+
+func (x *ExpenseTransfer) GetCreatedById() uint64 {
+	if x != nil {
+		return x.CreatedById
+	}
+	return 0
+}
+
+func (x *ExpenseTransfer) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ExpenseTransferCreateRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	FromWarehouseId uint64                 `protobuf:"varint,1,opt,name=from_warehouse_id,json=fromWarehouseId,proto3" json:"from_warehouse_id,omitempty"`
+	FromAccountId   uint64                 `protobuf:"varint,2,opt,name=from_account_id,json=fromAccountId,proto3" json:"from_account_id,omitempty"`
+	ToWarehouseId   uint64                 `protobuf:"varint,3,opt,name=to_warehouse_id,json=toWarehouseId,proto3" json:"to_warehouse_id,omitempty"`
+	ToAccountId     uint64                 `protobuf:"varint,4,opt,name=to_account_id,json=toAccountId,proto3" json:"to_account_id,omitempty"`
+	Amount          float64                `protobuf:"fixed64,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	Note            string                 `protobuf:"bytes,6,opt,name=note,proto3" json:"note,omitempty"`
+	At              *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=at,proto3" json:"at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ExpenseTransferCreateRequest) Reset() {
+	*x = ExpenseTransferCreateRequest{}
+	mi := &file_warehouse_service_v1_finance_ext_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpenseTransferCreateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpenseTransferCreateRequest) ProtoMessage() {}
+
+func (x *ExpenseTransferCreateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_v1_finance_ext_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpenseTransferCreateRequest.ProtoReflect.Descriptor instead.
+func (*ExpenseTransferCreateRequest) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_v1_finance_ext_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ExpenseTransferCreateRequest) GetFromWarehouseId() uint64 {
+	if x != nil {
+		return x.FromWarehouseId
+	}
+	return 0
+}
+
+func (x *ExpenseTransferCreateRequest) GetFromAccountId() uint64 {
+	if x != nil {
+		return x.FromAccountId
+	}
+	return 0
+}
+
+func (x *ExpenseTransferCreateRequest) GetToWarehouseId() uint64 {
+	if x != nil {
+		return x.ToWarehouseId
+	}
+	return 0
+}
+
+func (x *ExpenseTransferCreateRequest) GetToAccountId() uint64 {
+	if x != nil {
+		return x.ToAccountId
+	}
+	return 0
+}
+
+func (x *ExpenseTransferCreateRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *ExpenseTransferCreateRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *ExpenseTransferCreateRequest) GetAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.At
+	}
+	return nil
+}
+
+type ExpenseTransferCreateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *ExpenseTransfer       `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpenseTransferCreateResponse) Reset() {
+	*x = ExpenseTransferCreateResponse{}
+	mi := &file_warehouse_service_v1_finance_ext_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpenseTransferCreateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpenseTransferCreateResponse) ProtoMessage() {}
+
+func (x *ExpenseTransferCreateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_v1_finance_ext_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpenseTransferCreateResponse.ProtoReflect.Descriptor instead.
+func (*ExpenseTransferCreateResponse) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_v1_finance_ext_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ExpenseTransferCreateResponse) GetData() *ExpenseTransfer {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type BalanceHistory struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	WarehouseId uint64                 `protobuf:"varint,2,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	AccountId   uint64                 `protobuf:"varint,3,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	CreatedById uint64                 `protobuf:"varint,4,opt,name=created_by_id,json=createdById,proto3" json:"created_by_id,omitempty"`
+	Amount      float64                `protobuf:"fixed64,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	// start of warehouse day
+	At            *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=at,proto3" json:"at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BalanceHistory) Reset() {
+	*x = BalanceHistory{}
+	mi := &file_warehouse_service_v1_finance_ext_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BalanceHistory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BalanceHistory) ProtoMessage() {}
+
+func (x *BalanceHistory) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_v1_finance_ext_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BalanceHistory.ProtoReflect.Descriptor instead.
+func (*BalanceHistory) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_v1_finance_ext_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *BalanceHistory) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *BalanceHistory) GetWarehouseId() uint64 {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return 0
+}
+
+func (x *BalanceHistory) GetAccountId() uint64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *BalanceHistory) GetCreatedById() uint64 {
+	if x != nil {
+		return x.CreatedById
+	}
+	return 0
+}
+
+func (x *BalanceHistory) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *BalanceHistory) GetAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.At
+	}
+	return nil
+}
+
+func (x *BalanceHistory) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type BalanceHistoryCreateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DomainId      uint64                 `protobuf:"varint,1,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	AccountId     uint64                 `protobuf:"varint,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Amount        float64                `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	At            *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=at,proto3" json:"at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BalanceHistoryCreateRequest) Reset() {
+	*x = BalanceHistoryCreateRequest{}
+	mi := &file_warehouse_service_v1_finance_ext_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BalanceHistoryCreateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BalanceHistoryCreateRequest) ProtoMessage() {}
+
+func (x *BalanceHistoryCreateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_v1_finance_ext_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BalanceHistoryCreateRequest.ProtoReflect.Descriptor instead.
+func (*BalanceHistoryCreateRequest) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_v1_finance_ext_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *BalanceHistoryCreateRequest) GetDomainId() uint64 {
+	if x != nil {
+		return x.DomainId
+	}
+	return 0
+}
+
+func (x *BalanceHistoryCreateRequest) GetAccountId() uint64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *BalanceHistoryCreateRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *BalanceHistoryCreateRequest) GetAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.At
+	}
+	return nil
+}
+
+type BalanceHistoryCreateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *BalanceHistory        `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BalanceHistoryCreateResponse) Reset() {
+	*x = BalanceHistoryCreateResponse{}
+	mi := &file_warehouse_service_v1_finance_ext_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BalanceHistoryCreateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BalanceHistoryCreateResponse) ProtoMessage() {}
+
+func (x *BalanceHistoryCreateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_v1_finance_ext_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BalanceHistoryCreateResponse.ProtoReflect.Descriptor instead.
+func (*BalanceHistoryCreateResponse) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_v1_finance_ext_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *BalanceHistoryCreateResponse) GetData() *BalanceHistory {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type BalanceHistoryUpdateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DomainId      uint64                 `protobuf:"varint,1,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	HistId        uint64                 `protobuf:"varint,2,opt,name=hist_id,json=histId,proto3" json:"hist_id,omitempty"`
+	Amount        float64                `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	At            *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=at,proto3" json:"at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BalanceHistoryUpdateRequest) Reset() {
+	*x = BalanceHistoryUpdateRequest{}
+	mi := &file_warehouse_service_v1_finance_ext_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BalanceHistoryUpdateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BalanceHistoryUpdateRequest) ProtoMessage() {}
+
+func (x *BalanceHistoryUpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_v1_finance_ext_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BalanceHistoryUpdateRequest.ProtoReflect.Descriptor instead.
+func (*BalanceHistoryUpdateRequest) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_v1_finance_ext_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *BalanceHistoryUpdateRequest) GetDomainId() uint64 {
+	if x != nil {
+		return x.DomainId
+	}
+	return 0
+}
+
+func (x *BalanceHistoryUpdateRequest) GetHistId() uint64 {
+	if x != nil {
+		return x.HistId
+	}
+	return 0
+}
+
+func (x *BalanceHistoryUpdateRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *BalanceHistoryUpdateRequest) GetAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.At
+	}
+	return nil
+}
+
+type BalanceHistoryUpdateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *BalanceHistory        `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BalanceHistoryUpdateResponse) Reset() {
+	*x = BalanceHistoryUpdateResponse{}
+	mi := &file_warehouse_service_v1_finance_ext_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BalanceHistoryUpdateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BalanceHistoryUpdateResponse) ProtoMessage() {}
+
+func (x *BalanceHistoryUpdateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_v1_finance_ext_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BalanceHistoryUpdateResponse.ProtoReflect.Descriptor instead.
+func (*BalanceHistoryUpdateResponse) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_v1_finance_ext_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *BalanceHistoryUpdateResponse) GetData() *BalanceHistory {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type BalanceHistoryDeleteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DomainId      uint64                 `protobuf:"varint,1,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	HistId        uint64                 `protobuf:"varint,2,opt,name=hist_id,json=histId,proto3" json:"hist_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BalanceHistoryDeleteRequest) Reset() {
+	*x = BalanceHistoryDeleteRequest{}
+	mi := &file_warehouse_service_v1_finance_ext_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BalanceHistoryDeleteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BalanceHistoryDeleteRequest) ProtoMessage() {}
+
+func (x *BalanceHistoryDeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_v1_finance_ext_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BalanceHistoryDeleteRequest.ProtoReflect.Descriptor instead.
+func (*BalanceHistoryDeleteRequest) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_v1_finance_ext_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *BalanceHistoryDeleteRequest) GetDomainId() uint64 {
+	if x != nil {
+		return x.DomainId
+	}
+	return 0
+}
+
+func (x *BalanceHistoryDeleteRequest) GetHistId() uint64 {
+	if x != nil {
+		return x.HistId
+	}
+	return 0
+}
+
+type BalanceHistoryDeleteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BalanceHistoryDeleteResponse) Reset() {
+	*x = BalanceHistoryDeleteResponse{}
+	mi := &file_warehouse_service_v1_finance_ext_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BalanceHistoryDeleteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BalanceHistoryDeleteResponse) ProtoMessage() {}
+
+func (x *BalanceHistoryDeleteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_v1_finance_ext_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BalanceHistoryDeleteResponse.ProtoReflect.Descriptor instead.
+func (*BalanceHistoryDeleteResponse) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_v1_finance_ext_proto_rawDescGZIP(), []int{32}
+}
+
+type BalanceHistoryListRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	WarehouseId uint64                 `protobuf:"varint,1,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	AccountId   uint64                 `protobuf:"varint,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	CreatedById uint64                 `protobuf:"varint,3,opt,name=created_by_id,json=createdById,proto3" json:"created_by_id,omitempty"`
+	// unix milli of day
+	At            int64          `protobuf:"varint,4,opt,name=at,proto3" json:"at,omitempty"`
+	StartDate     int64          `protobuf:"varint,5,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       int64          `protobuf:"varint,6,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Page          *v1.PageFilter `protobuf:"bytes,7,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BalanceHistoryListRequest) Reset() {
+	*x = BalanceHistoryListRequest{}
+	mi := &file_warehouse_service_v1_finance_ext_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BalanceHistoryListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BalanceHistoryListRequest) ProtoMessage() {}
+
+func (x *BalanceHistoryListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_v1_finance_ext_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BalanceHistoryListRequest.ProtoReflect.Descriptor instead.
+func (*BalanceHistoryListRequest) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_v1_finance_ext_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *BalanceHistoryListRequest) GetWarehouseId() uint64 {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return 0
+}
+
+func (x *BalanceHistoryListRequest) GetAccountId() uint64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *BalanceHistoryListRequest) GetCreatedById() uint64 {
+	if x != nil {
+		return x.CreatedById
+	}
+	return 0
+}
+
+func (x *BalanceHistoryListRequest) GetAt() int64 {
+	if x != nil {
+		return x.At
+	}
+	return 0
+}
+
+func (x *BalanceHistoryListRequest) GetStartDate() int64 {
+	if x != nil {
+		return x.StartDate
+	}
+	return 0
+}
+
+func (x *BalanceHistoryListRequest) GetEndDate() int64 {
+	if x != nil {
+		return x.EndDate
+	}
+	return 0
+}
+
+func (x *BalanceHistoryListRequest) GetPage() *v1.PageFilter {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type BalanceHistoryListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*BalanceHistory      `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	PageInfo      *v1.PageInfo           `protobuf:"bytes,2,opt,name=page_info,json=pageInfo,proto3" json:"page_info,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BalanceHistoryListResponse) Reset() {
+	*x = BalanceHistoryListResponse{}
+	mi := &file_warehouse_service_v1_finance_ext_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BalanceHistoryListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BalanceHistoryListResponse) ProtoMessage() {}
+
+func (x *BalanceHistoryListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_v1_finance_ext_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BalanceHistoryListResponse.ProtoReflect.Descriptor instead.
+func (*BalanceHistoryListResponse) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_v1_finance_ext_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *BalanceHistoryListResponse) GetData() []*BalanceHistory {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *BalanceHistoryListResponse) GetPageInfo() *v1.PageInfo {
+	if x != nil {
+		return x.PageInfo
+	}
+	return nil
+}
+
+type AccountReconciliation struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	AccountId       uint64                 `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Day             string                 `protobuf:"bytes,2,opt,name=day,proto3" json:"day,omitempty"`
+	PreviousDay     string                 `protobuf:"bytes,3,opt,name=previous_day,json=previousDay,proto3" json:"previous_day,omitempty"`
+	PreviousBalance float64                `protobuf:"fixed64,4,opt,name=previous_balance,json=previousBalance,proto3" json:"previous_balance,omitempty"`
+	Income          float64                `protobuf:"fixed64,5,opt,name=income,proto3" json:"income,omitempty"`
+	Outcome         float64                `protobuf:"fixed64,6,opt,name=outcome,proto3" json:"outcome,omitempty"`
+	ExpectedBalance float64                `protobuf:"fixed64,7,opt,name=expected_balance,json=expectedBalance,proto3" json:"expected_balance,omitempty"`
+	RecordedBalance float64                `protobuf:"fixed64,8,opt,name=recorded_balance,json=recordedBalance,proto3" json:"recorded_balance,omitempty"`
+	Difference      float64                `protobuf:"fixed64,9,opt,name=difference,proto3" json:"difference,omitempty"`
+	Mismatch        bool                   `protobuf:"varint,10,opt,name=mismatch,proto3" json:"mismatch,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *AccountReconciliation) Reset() {
+	*x = AccountReconciliation{}
+	mi := &file_warehouse_service_v1_finance_ext_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountReconciliation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountReconciliation) ProtoMessage() {}
+
+func (x *AccountReconciliation) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_v1_finance_ext_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountReconciliation.ProtoReflect.Descriptor instead.
+func (*AccountReconciliation) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_v1_finance_ext_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *AccountReconciliation) GetAccountId() uint64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *AccountReconciliation) GetDay() string {
+	if x != nil {
+		return x.Day
+	}
+	return ""
+}
+
+func (x *AccountReconciliation) GetPreviousDay() string {
+	if x != nil {
+		return x.PreviousDay
+	}
+	return ""
+}
+
+func (x *AccountReconciliation) GetPreviousBalance() float64 {
+	if x != nil {
+		return x.PreviousBalance
+	}
+	return 0
+}
+
+func (x *AccountReconciliation) GetIncome() float64 {
+	if x != nil {
+		return x.Income
+	}
+	return 0
+}
+
+func (x *AccountReconciliation) GetOutcome() float64 {
+	if x != nil {
+		return x.Outcome
+	}
+	return 0
+}
+
+func (x *AccountReconciliation) GetExpectedBalance() float64 {
+	if x != nil {
+		return x.ExpectedBalance
+	}
+	return 0
+}
+
+func (x *AccountReconciliation) GetRecordedBalance() float64 {
+	if x != nil {
+		return x.RecordedBalance
+	}
+	return 0
+}
+
+func (x *AccountReconciliation) GetDifference() float64 {
+	if x != nil {
+		return x.Difference
+	}
+	return 0
+}
+
+func (x *AccountReconciliation) GetMismatch() bool {
+	if x != nil {
+		return x.Mismatch
+	}
+	return false
+}
+
+type AccountBalanceReconciliationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WarehouseId   uint64                 `protobuf:"varint,1,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	AccountId     uint64                 `protobuf:"varint,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	StartDate     int64                  `protobuf:"varint,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       int64                  `protobuf:"varint,4,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	MismatchOnly  bool                   `protobuf:"varint,5,opt,name=mismatch_only,json=mismatchOnly,proto3" json:"mismatch_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccountBalanceReconciliationRequest) Reset() {
+	*x = AccountBalanceReconciliationRequest{}
+	mi := &file_warehouse_service_v1_finance_ext_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountBalanceReconciliationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountBalanceReconciliationRequest) ProtoMessage() {}
+
+func (x *AccountBalanceReconciliationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_v1_finance_ext_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountBalanceReconciliationRequest.ProtoReflect.Descriptor instead.
+func (*AccountBalanceReconciliationRequest) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_v1_finance_ext_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *AccountBalanceReconciliationRequest) GetWarehouseId() uint64 {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return 0
+}
+
+func (x *AccountBalanceReconciliationRequest) GetAccountId() uint64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *AccountBalanceReconciliationRequest) GetStartDate() int64 {
+	if x != nil {
+		return x.StartDate
+	}
+	return 0
+}
+
+func (x *AccountBalanceReconciliationRequest) GetEndDate() int64 {
+	if x != nil {
+		return x.EndDate
+	}
+	return 0
+}
+
+func (x *AccountBalanceReconciliationRequest) GetMismatchOnly() bool {
+	if x != nil {
+		return x.MismatchOnly
+	}
+	return false
+}
+
+type AccountBalanceReconciliationResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	WarehouseId   uint64                   `protobuf:"varint,1,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	Timezone      string                   `protobuf:"bytes,2,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	CheckedCount  int64                    `protobuf:"varint,3,opt,name=checked_count,json=checkedCount,proto3" json:"checked_count,omitempty"`
+	MismatchCount int64                    `protobuf:"varint,4,opt,name=mismatch_count,json=mismatchCount,proto3" json:"mismatch_count,omitempty"`
+	Data          []*AccountReconciliation `protobuf:"bytes,5,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccountBalanceReconciliationResponse) Reset() {
+	*x = AccountBalanceReconciliationResponse{}
+	mi := &file_warehouse_service_v1_finance_ext_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountBalanceReconciliationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountBalanceReconciliationResponse) ProtoMessage() {}
+
+func (x *AccountBalanceReconciliationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_v1_finance_ext_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountBalanceReconciliationResponse.ProtoReflect.Descriptor instead.
+func (*AccountBalanceReconciliationResponse) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_v1_finance_ext_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *AccountBalanceReconciliationResponse) GetWarehouseId() uint64 {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return 0
+}
+
+func (x *AccountBalanceReconciliationResponse) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *AccountBalanceReconciliationResponse) GetCheckedCount() int64 {
+	if x != nil {
+		return x.CheckedCount
+	}
+	return 0
+}
+
+func (x *AccountBalanceReconciliationResponse) GetMismatchCount() int64 {
+	if x != nil {
+		return x.MismatchCount
+	}
+	return 0
+}
+
+func (x *AccountBalanceReconciliationResponse) GetData() []*AccountReconciliation {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ExpenseType struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Key   string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Name  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// income or outcome
+	Flow      string `protobuf:"bytes,3,opt,name=flow,proto3" json:"flow,omitempty"`
+	AdminOnly bool   `protobuf:"varint,4,opt,name=admin_only,json=adminOnly,proto3" json:"admin_only,omitempty"`
+	// 0 is usable in every warehouse
+	WarehouseId uint64 `protobuf:"varint,5,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	Disabled    bool   `protobuf:"varint,6,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	// team type allowed using it, admin team can use every type
+	TeamTypes     []string               `protobuf:"bytes,7,rep,name=team_types,json=teamTypes,proto3" json:"team_types,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpenseType) Reset() {
+	*x = ExpenseType{}
+	mi := &file_warehouse_service_v1_finance_ext_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpenseType) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpenseType) ProtoMessage() {}
+
+func (x *ExpenseType) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_v1_finance_ext_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpenseType.ProtoReflect.Descriptor instead.
+func (*ExpenseType) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_v1_finance_ext_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ExpenseType) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ExpenseType) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ExpenseType) GetFlow() string {
+	if x != nil {
+		return x.Flow
+	}
+	return ""
+}
+
+func (x *ExpenseType) GetAdminOnly() bool {
+	if x != nil {
+		return x.AdminOnly
+	}
+	return false
+}
+
+func (x *ExpenseType) GetWarehouseId() uint64 {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return 0
+}
+
+func (x *ExpenseType) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *ExpenseType) GetTeamTypes() []string {
+	if x != nil {
+		return x.TeamTypes
+	}
+	return nil
+}
+
+func (x *ExpenseType) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ExpenseType) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type ExpenseTypeListRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	WarehouseId     uint64                 `protobuf:"varint,1,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	TeamType        string                 `protobuf:"bytes,2,opt,name=team_type,json=teamType,proto3" json:"team_type,omitempty"`
+	IncludeDisabled bool                   `protobuf:"varint,3,opt,name=include_disabled,json=includeDisabled,proto3" json:"include_disabled,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ExpenseTypeListRequest) Reset() {
+	*x = ExpenseTypeListRequest{}
+	mi := &file_warehouse_service_v1_finance_ext_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpenseTypeListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpenseTypeListRequest) ProtoMessage() {}
+
+func (x *ExpenseTypeListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_v1_finance_ext_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpenseTypeListRequest.ProtoReflect.Descriptor instead.
+func (*ExpenseTypeListRequest) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_v1_finance_ext_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ExpenseTypeListRequest) GetWarehouseId() uint64 {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return 0
+}
+
+func (x *ExpenseTypeListRequest) GetTeamType() string {
+	if x != nil {
+		return x.TeamType
+	}
+	return ""
+}
+
+func (x *ExpenseTypeListRequest) GetIncludeDisabled() bool {
+	if x != nil {
+		return x.IncludeDisabled
+	}
+	return false
+}
+
+type ExpenseTypeListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*ExpenseType         `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpenseTypeListResponse) Reset() {
+	*x = ExpenseTypeListResponse{}
+	mi := &file_warehouse_service_v1_finance_ext_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpenseTypeListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpenseTypeListResponse) ProtoMessage() {}
+
+func (x *ExpenseTypeListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_v1_finance_ext_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpenseTypeListResponse.ProtoReflect.Descriptor instead.
+func (*ExpenseTypeListResponse) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_v1_finance_ext_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ExpenseTypeListResponse) GetData() []*ExpenseType {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ExpenseTypeCreateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DomainId      uint64                 `protobuf:"varint,1,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	Data          *ExpenseType           `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpenseTypeCreateRequest) Reset() {
+	*x = ExpenseTypeCreateRequest{}
+	mi := &file_warehouse_service_v1_finance_ext_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpenseTypeCreateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpenseTypeCreateRequest) ProtoMessage() {}
+
+func (x *ExpenseTypeCreateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_v1_finance_ext_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpenseTypeCreateRequest.ProtoReflect.Descriptor instead.
+func (*ExpenseTypeCreateRequest) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_v1_finance_ext_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ExpenseTypeCreateRequest) GetDomainId() uint64 {
+	if x != nil {
+		return x.DomainId
+	}
+	return 0
+}
+
+func (x *ExpenseTypeCreateRequest) GetData() *ExpenseType {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ExpenseTypeCreateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *ExpenseType           `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpenseTypeCreateResponse) Reset() {
+	*x = ExpenseTypeCreateResponse{}
+	mi := &file_warehouse_service_v1_finance_ext_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpenseTypeCreateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpenseTypeCreateResponse) ProtoMessage() {}
+
+func (x *ExpenseTypeCreateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_v1_finance_ext_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpenseTypeCreateResponse.ProtoReflect.Descriptor instead.
+func (*ExpenseTypeCreateResponse) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_v1_finance_ext_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ExpenseTypeCreateResponse) GetData() *ExpenseType {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ExpenseTypeUpdateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DomainId      uint64                 `protobuf:"varint,1,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	Data          *ExpenseType           `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpenseTypeUpdateRequest) Reset() {
+	*x = ExpenseTypeUpdateRequest{}
+	mi := &file_warehouse_service_v1_finance_ext_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpenseTypeUpdateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpenseTypeUpdateRequest) ProtoMessage() {}
+
+func (x *ExpenseTypeUpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_v1_finance_ext_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpenseTypeUpdateRequest.ProtoReflect.Descriptor instead.
+func (*ExpenseTypeUpdateRequest) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_v1_finance_ext_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ExpenseTypeUpdateRequest) GetDomainId() uint64 {
+	if x != nil {
+		return x.DomainId
+	}
+	return 0
+}
+
+func (x *ExpenseTypeUpdateRequest) GetData() *ExpenseType {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ExpenseTypeUpdateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *ExpenseType           `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpenseTypeUpdateResponse) Reset() {
+	*x = ExpenseTypeUpdateResponse{}
+	mi := &file_warehouse_service_v1_finance_ext_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpenseTypeUpdateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpenseTypeUpdateResponse) ProtoMessage() {}
+
+func (x *ExpenseTypeUpdateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_v1_finance_ext_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpenseTypeUpdateResponse.ProtoReflect.Descriptor instead.
+func (*ExpenseTypeUpdateResponse) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_v1_finance_ext_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ExpenseTypeUpdateResponse) GetData() *ExpenseType {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ExpenseBudget struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	WarehouseId uint64                 `protobuf:"varint,2,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	ExpenseType string                 `protobuf:"bytes,3,opt,name=expense_type,json=expenseType,proto3" json:"expense_type,omitempty"`
+	// 2006-01
+	Month        string  `protobuf:"bytes,4,opt,name=month,proto3" json:"month,omitempty"`
+	Amount       float64 `protobuf:"fixed64,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	AlertPercent float64 `protobuf:"fixed64,6,opt,name=alert_percent,json=alertPercent,proto3" json:"alert_percent,omitempty"`
+	// warn or reject
+	Enforce       string                 `protobuf:"bytes,7,opt,name=enforce,proto3" json:"enforce,omitempty"`
+	UpdatedById   uint64                 `protobuf:"varint,8,opt,name=updated_by_id,json=updatedById,proto3" json:"updated_by_id,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpenseBudget) Reset() {
+	*x = ExpenseBudget{}
+	mi := &file_warehouse_service_v1_finance_ext_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpenseBudget) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpenseBudget) ProtoMessage() {}
+
+func (x *ExpenseBudget) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_v1_finance_ext_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpenseBudget.ProtoReflect.Descriptor instead.
+func (*ExpenseBudget) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_v1_finance_ext_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ExpenseBudget) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ExpenseBudget) GetWarehouseId() uint64 {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return 0
+}
+
+func (x *ExpenseBudget) GetExpenseType() string {
+	if x != nil {
+		return x.ExpenseType
+	}
+	return ""
+}
+
+func (x *ExpenseBudget) GetMonth() string {
+	if x != nil {
+		return x.Month
+	}
+	return ""
+}
+
+func (x *ExpenseBudget) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *ExpenseBudget) GetAlertPercent() float64 {
+	if x != nil {
+		return x.AlertPercent
+	}
+	return 0
+}
+
+func (x *ExpenseBudget) GetEnforce() string {
+	if x != nil {
+		return x.Enforce
+	}
+	return ""
+}
+
+func (x *ExpenseBudget) GetUpdatedById() uint64 {
+	if x != nil {
+		return x.UpdatedById
+	}
+	return 0
+}
+
+func (x *ExpenseBudget) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ExpenseBudget) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type ExpenseBudgetSetRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	DomainId    uint64                 `protobuf:"varint,1,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	WarehouseId uint64                 `protobuf:"varint,2,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	ExpenseType string                 `protobuf:"bytes,3,opt,name=expense_type,json=expenseType,proto3" json:"expense_type,omitempty"`
+	Month       string                 `protobuf:"bytes,4,opt,name=month,proto3" json:"month,omitempty"`
+	// 0 is removing budget
+	Amount        float64 `protobuf:"fixed64,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	AlertPercent  float64 `protobuf:"fixed64,6,opt,name=alert_percent,json=alertPercent,proto3" json:"alert_percent,omitempty"`
+	Enforce       string  `protobuf:"bytes,7,opt,name=enforce,proto3" json:"enforce,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpenseBudgetSetRequest) Reset() {
+	*x = ExpenseBudgetSetRequest{}
+	mi := &file_warehouse_service_v1_finance_ext_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpenseBudgetSetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpenseBudgetSetRequest) ProtoMessage() {}
+
+func (x *ExpenseBudgetSetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_v1_finance_ext_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpenseBudgetSetRequest.ProtoReflect.Descriptor instead.
+func (*ExpenseBudgetSetRequest) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_v1_finance_ext_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ExpenseBudgetSetRequest) GetDomainId() uint64 {
+	if x != nil {
+		return x.DomainId
+	}
+	return 0
+}
+
+func (x *ExpenseBudgetSetRequest) GetWarehouseId() uint64 {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return 0
+}
+
+func (x *ExpenseBudgetSetRequest) GetExpenseType() string {
+	if x != nil {
+		return x.ExpenseType
+	}
+	return ""
+}
+
+func (x *ExpenseBudgetSetRequest) GetMonth() string {
+	if x != nil {
+		return x.Month
+	}
+	return ""
+}
+
+func (x *ExpenseBudgetSetRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *ExpenseBudgetSetRequest) GetAlertPercent() float64 {
+	if x != nil {
+		return x.AlertPercent
+	}
+	return 0
+}
+
+func (x *ExpenseBudgetSetRequest) GetEnforce() string {
+	if x != nil {
+		return x.Enforce
+	}
+	return ""
+}
+
+type ExpenseBudgetSetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *ExpenseBudget         `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpenseBudgetSetResponse) Reset() {
+	*x = ExpenseBudgetSetResponse{}
+	mi := &file_warehouse_service_v1_finance_ext_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpenseBudgetSetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpenseBudgetSetResponse) ProtoMessage() {}
+
+func (x *ExpenseBudgetSetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_v1_finance_ext_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpenseBudgetSetResponse.ProtoReflect.Descriptor instead.
+func (*ExpenseBudgetSetResponse) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_v1_finance_ext_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ExpenseBudgetSetResponse) GetData() *ExpenseBudget {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ExpenseBudgetUsage struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	ExpenseType  string                 `protobuf:"bytes,1,opt,name=expense_type,json=expenseType,proto3" json:"expense_type,omitempty"`
+	Budget       float64                `protobuf:"fixed64,2,opt,name=budget,proto3" json:"budget,omitempty"`
+	AlertPercent float64                `protobuf:"fixed64,3,opt,name=alert_percent,json=alertPercent,proto3" json:"alert_percent,omitempty"`
+	Enforce      string                 `protobuf:"bytes,4,opt,name=enforce,proto3" json:"enforce,omitempty"`
+	Spent        float64                `protobuf:"fixed64,5,opt,name=spent,proto3" json:"spent,omitempty"`
+	Remaining    float64                `protobuf:"fixed64,6,opt,name=remaining,proto3" json:"remaining,omitempty"`
+	// percent of budget spent
+	Utilisation   float64 `protobuf:"fixed64,7,opt,name=utilisation,proto3" json:"utilisation,omitempty"`
+	Alert         bool    `protobuf:"varint,8,opt,name=alert,proto3" json:"alert,omitempty"`
+	Exceeded      bool    `protobuf:"varint,9,opt,name=exceeded,proto3" json:"exceeded,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpenseBudgetUsage) Reset() {
+	*x = ExpenseBudgetUsage{}
+	mi := &file_warehouse_service_v1_finance_ext_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpenseBudgetUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpenseBudgetUsage) ProtoMessage() {}
+
+func (x *ExpenseBudgetUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_v1_finance_ext_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpenseBudgetUsage.ProtoReflect.Descriptor instead.
+func (*ExpenseBudgetUsage) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_v1_finance_ext_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ExpenseBudgetUsage) GetExpenseType() string {
+	if x != nil {
+		return x.ExpenseType
+	}
+	return ""
+}
+
+func (x *ExpenseBudgetUsage) GetBudget() float64 {
+	if x != nil {
+		return x.Budget
+	}
+	return 0
+}
+
+func (x *ExpenseBudgetUsage) GetAlertPercent() float64 {
+	if x != nil {
+		return x.AlertPercent
+	}
+	return 0
+}
+
+func (x *ExpenseBudgetUsage) GetEnforce() string {
+	if x != nil {
+		return x.Enforce
+	}
+	return ""
+}
+
+func (x *ExpenseBudgetUsage) GetSpent() float64 {
+	if x != nil {
+		return x.Spent
+	}
+	return 0
+}
+
+func (x *ExpenseBudgetUsage) GetRemaining() float64 {
+	if x != nil {
+		return x.Remaining
+	}
+	return 0
+}
+
+func (x *ExpenseBudgetUsage) GetUtilisation() float64 {
+	if x != nil {
+		return x.Utilisation
+	}
+	return 0
+}
+
+func (x *ExpenseBudgetUsage) GetAlert() bool {
+	if x != nil {
+		return x.Alert
+	}
+	return false
+}
+
+func (x *ExpenseBudgetUsage) GetExceeded() bool {
+	if x != nil {
+		return x.Exceeded
+	}
+	return false
+}
+
+type ExpenseBudgetReportRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	WarehouseId uint64                 `protobuf:"varint,1,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	// empty is current month
+	Month         string `protobuf:"bytes,2,opt,name=month,proto3" json:"month,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpenseBudgetReportRequest) Reset() {
+	*x = ExpenseBudgetReportRequest{}
+	mi := &file_warehouse_service_v1_finance_ext_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpenseBudgetReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpenseBudgetReportRequest) ProtoMessage() {}
+
+func (x *ExpenseBudgetReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_v1_finance_ext_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpenseBudgetReportRequest.ProtoReflect.Descriptor instead.
+func (*ExpenseBudgetReportRequest) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_v1_finance_ext_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ExpenseBudgetReportRequest) GetWarehouseId() uint64 {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return 0
+}
+
+func (x *ExpenseBudgetReportRequest) GetMonth() string {
+	if x != nil {
+		return x.Month
+	}
+	return ""
+}
+
+type ExpenseBudgetReportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WarehouseId   uint64                 `protobuf:"varint,1,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	Month         string                 `protobuf:"bytes,2,opt,name=month,proto3" json:"month,omitempty"`
+	Data          []*ExpenseBudgetUsage  `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpenseBudgetReportResponse) Reset() {
+	*x = ExpenseBudgetReportResponse{}
+	mi := &file_warehouse_service_v1_finance_ext_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpenseBudgetReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpenseBudgetReportResponse) ProtoMessage() {}
+
+func (x *ExpenseBudgetReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_v1_finance_ext_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpenseBudgetReportResponse.ProtoReflect.Descriptor instead.
+func (*ExpenseBudgetReportResponse) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_v1_finance_ext_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ExpenseBudgetReportResponse) GetWarehouseId() uint64 {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return 0
+}
+
+func (x *ExpenseBudgetReportResponse) GetMonth() string {
+	if x != nil {
+		return x.Month
+	}
+	return ""
+}
+
+func (x *ExpenseBudgetReportResponse) GetData() []*ExpenseBudgetUsage {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+var File_warehouse_service_v1_finance_ext_proto protoreflect.FileDescriptor
+
+const file_warehouse_service_v1_finance_ext_proto_rawDesc = "" +
+	"\n" +
+	"&warehouse_service/v1/finance_ext.proto\x12\x14warehouse_service.v1\x1a\x16common/v1/common.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"r\n" +
+	"\x0fExpenseListPage\x12)\n" +
+	"\x04page\x18\x01 \x01(\v2\x15.common.v1.PageFilterR\x04page\x12\x17\n" +
+	"\asort_by\x18\x02 \x01(\tR\x06sortBy\x12\x1b\n" +
+	"\tsort_desc\x18\x03 \x01(\bR\bsortDesc\"\x99\x02\n" +
+	"\x0eExpenseAccount\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12!\n" +
+	"\fwarehouse_id\x18\x02 \x01(\x04R\vwarehouseId\x12&\n" +
+	"\x0faccount_type_id\x18\x03 \x01(\x04R\raccountTypeId\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12\x1b\n" +
+	"\tnumber_id\x18\x05 \x01(\tR\bnumberId\x12$\n" +
+	"\x0eis_ops_account\x18\x06 \x01(\bR\fisOpsAccount\x12\x1a\n" +
+	"\bdisabled\x18\a \x01(\bR\bdisabled\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xe5\x03\n" +
+	"\x0eExpenseHistory\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12!\n" +
+	"\fwarehouse_id\x18\x02 \x01(\x04R\vwarehouseId\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x03 \x01(\x04R\taccountId\x12\"\n" +
+	"\rcreated_by_id\x18\x04 \x01(\x04R\vcreatedById\x12!\n" +
+	"\fexpense_type\x18\x05 \x01(\tR\vexpenseType\x12\x16\n" +
+	"\x06amount\x18\x06 \x01(\x01R\x06amount\x12\x12\n" +
+	"\x04note\x18\a \x01(\tR\x04note\x12\x16\n" +
+	"\x06status\x18\b \x01(\tR\x06status\x12\x1f\n" +
+	"\vtransfer_id\x18\t \x01(\x04R\n" +
+	"transferId\x12\x18\n" +
+	"\adeleted\x18\n" +
+	" \x01(\bR\adeleted\x12*\n" +
+	"\x02at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\x02at\x129\n" +
+	"\n" +
+	"created_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12$\n" +
+	"\x0eis_ops_account\x18\r \x01(\bR\fisOpsAccount\x12.\n" +
+	"\x13transfer_account_id\x18\x0e \x01(\x04R\x11transferAccountId\"|\n" +
+	"\fExpenseTotal\x12!\n" +
+	"\fexpense_type\x18\x01 \x01(\tR\vexpenseType\x12\x1b\n" +
+	"\tflow_type\x18\x02 \x01(\tR\bflowType\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x03R\x05count\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x01R\x06amount\"\x8a\x03\n" +
+	"\x10ExpenseReportDay\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x01 \x01(\x03R\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x02 \x01(\x03R\aendDate\x12\x18\n" +
+	"\aexpense\x18\x03 \x01(\x01R\aexpense\x12\x16\n" +
+	"\x06income\x18\x04 \x01(\x01R\x06income\x12,\n" +
+	"\x12system_diff_amount\x18\x05 \x01(\x01R\x10systemDiffAmount\x12,\n" +
+	"\x12actual_diff_amount\x18\x06 \x01(\x01R\x10actualDiffAmount\x12&\n" +
+	"\x0ferr_diff_amount\x18\a \x01(\x01R\rerrDiffAmount\x12%\n" +
+	"\x0eopening_amount\x18\b \x01(\x01R\ropeningAmount\x12%\n" +
+	"\x0eclosing_amount\x18\t \x01(\x01R\rclosingAmount\x128\n" +
+	"\x05types\x18\n" +
+	" \x03(\v2\".warehouse_service.v1.ExpenseTotalR\x05types\"\x9d\x01\n" +
+	"\x1fExpenseReportDailyDetailRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\x04R\taccountId\x12!\n" +
+	"\fwarehouse_id\x18\x02 \x01(\x04R\vwarehouseId\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x03 \x01(\x03R\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x04 \x01(\x03R\aendDate\"^\n" +
+	" ExpenseReportDailyDetailResponse\x12:\n" +
+	"\x04data\x18\x01 \x03(\v2&.warehouse_service.v1.ExpenseReportDayR\x04data\"\xe8\x01\n" +
+	"\x19ExpenseAccountPageRequest\x12!\n" +
+	"\fwarehouse_id\x18\x01 \x01(\x04R\vwarehouseId\x12\x1b\n" +
+	"\tnumber_id\x18\x02 \x01(\tR\bnumberId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12$\n" +
+	"\x0eis_ops_account\x18\x04 \x01(\bR\fisOpsAccount\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x129\n" +
+	"\x04page\x18\x06 \x01(\v2%.warehouse_service.v1.ExpenseListPageR\x04page\"\x88\x01\n" +
+	"\x1aExpenseAccountPageResponse\x128\n" +
+	"\x04data\x18\x01 \x03(\v2$.warehouse_service.v1.ExpenseAccountR\x04data\x120\n" +
+	"\tpage_info\x18\x02 \x01(\v2\x13.common.v1.PageInfoR\bpageInfo\"\xb1\x01\n" +
+	" ExpenseAccountSetDisabledRequest\x12\x1b\n" +
+	"\tdomain_id\x18\x01 \x01(\x04R\bdomainId\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\x04R\taccountId\x12!\n" +
+	"\fwarehouse_id\x18\x03 \x01(\x04R\vwarehouseId\x12\x1a\n" +
+	"\bdisabled\x18\x04 \x01(\bR\bdisabled\x12\x12\n" +
+	"\x04note\x18\x05 \x01(\tR\x04note\"]\n" +
+	"!ExpenseAccountSetDisabledResponse\x128\n" +
+	"\x04data\x18\x01 \x01(\v2$.warehouse_service.v1.ExpenseAccountR\x04data\"\xf9\x02\n" +
+	"\x19ExpenseHistoryPageRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\x04R\taccountId\x12!\n" +
+	"\fwarehouse_id\x18\x02 \x01(\x04R\vwarehouseId\x12$\n" +
+	"\x0eis_ops_account\x18\x03 \x01(\bR\fisOpsAccount\x12!\n" +
+	"\fexpense_type\x18\x04 \x01(\tR\vexpenseType\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x05 \x01(\x03R\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x06 \x01(\x03R\aendDate\x12\x1b\n" +
+	"\ttime_type\x18\a \x01(\tR\btimeType\x12\x16\n" +
+	"\x06status\x18\b \x01(\tR\x06status\x12'\n" +
+	"\x0finclude_deleted\x18\t \x01(\bR\x0eincludeDeleted\x129\n" +
+	"\x04page\x18\n" +
+	" \x01(\v2%.warehouse_service.v1.ExpenseListPageR\x04page\"\xc4\x01\n" +
+	"\x1aExpenseHistoryPageResponse\x128\n" +
+	"\x04data\x18\x01 \x03(\v2$.warehouse_service.v1.ExpenseHistoryR\x04data\x120\n" +
+	"\tpage_info\x18\x02 \x01(\v2\x13.common.v1.PageInfoR\bpageInfo\x12:\n" +
+	"\x06totals\x18\x03 \x03(\v2\".warehouse_service.v1.ExpenseTotalR\x06totals\"\x81\x01\n" +
+	"\x1bExpenseHistoryReviewRequest\x12\x1b\n" +
+	"\tdomain_id\x18\x01 \x01(\x04R\bdomainId\x12\x17\n" +
+	"\ahist_id\x18\x02 \x01(\x04R\x06histId\x12\x18\n" +
+	"\aapprove\x18\x03 \x01(\bR\aapprove\x12\x12\n" +
+	"\x04note\x18\x04 \x01(\tR\x04note\"X\n" +
+	"\x1cExpenseHistoryReviewResponse\x128\n" +
+	"\x04data\x18\x01 \x01(\v2$.warehouse_service.v1.ExpenseHistoryR\x04data\"\xeb\x01\n" +
+	"\x14ExpenseRevisionValue\x12!\n" +
+	"\fwarehouse_id\x18\x01 \x01(\x04R\vwarehouseId\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\x04R\taccountId\x12!\n" +
+	"\fexpense_type\x18\x03 \x01(\tR\vexpenseType\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x01R\x06amount\x12\x12\n" +
+	"\x04note\x18\x05 \x01(\tR\x04note\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12*\n" +
+	"\x02at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x02at\"\xe2\x02\n" +
+	"\x0fExpenseRevision\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12&\n" +
+	"\x0fexpense_hist_id\x18\x02 \x01(\x04R\rexpenseHistId\x12\x1b\n" +
+	"\teditor_id\x18\x03 \x01(\x04R\beditorId\x12\x1f\n" +
+	"\veditor_from\x18\x04 \x01(\tR\n" +
+	"editorFrom\x12B\n" +
+	"\x06before\x18\x05 \x01(\v2*.warehouse_service.v1.ExpenseRevisionValueR\x06before\x12@\n" +
+	"\x05after\x18\x06 \x01(\v2*.warehouse_service.v1.ExpenseRevisionValueR\x05after\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x18\n" +
+	"\achanges\x18\b \x03(\tR\achanges\"U\n" +
+	"\x1dExpenseHistoryRevisionRequest\x12\x1b\n" +
+	"\tdomain_id\x18\x01 \x01(\x04R\bdomainId\x12\x17\n" +
+	"\ahist_id\x18\x02 \x01(\x04R\x06histId\"[\n" +
+	"\x1eExpenseHistoryRevisionResponse\x129\n" +
+	"\x04data\x18\x01 \x03(\v2%.warehouse_service.v1.ExpenseRevisionR\x04data\"k\n" +
+	"\x1bExpenseHistoryDeleteRequest\x12\x1b\n" +
+	"\tdomain_id\x18\x01 \x01(\x04R\bdomainId\x12\x17\n" +
+	"\ahist_id\x18\x02 \x01(\x04R\x06histId\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"X\n" +
+	"\x1cExpenseHistoryDeleteResponse\x128\n" +
+	"\x04data\x18\x01 \x01(\v2$.warehouse_service.v1.ExpenseHistoryR\x04data\"l\n" +
+	"\x1cExpenseHistoryRestoreRequest\x12\x1b\n" +
+	"\tdomain_id\x18\x01 \x01(\x04R\bdomainId\x12\x17\n" +
+	"\ahist_id\x18\x02 \x01(\x04R\x06histId\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"Y\n" +
+	"\x1dExpenseHistoryRestoreResponse\x128\n" +
+	"\x04data\x18\x01 \x01(\v2$.warehouse_service.v1.ExpenseHistoryR\x04data\"\xf8\x02\n" +
+	"\x0fExpenseTransfer\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12*\n" +
+	"\x11from_warehouse_id\x18\x02 \x01(\x04R\x0ffromWarehouseId\x12&\n" +
+	"\x0ffrom_account_id\x18\x03 \x01(\x04R\rfromAccountId\x12&\n" +
+	"\x0fto_warehouse_id\x18\x04 \x01(\x04R\rtoWarehouseId\x12\"\n" +
+	"\rto_account_id\x18\x05 \x01(\x04R\vtoAccountId\x12\x16\n" +
+	"\x06amount\x18\x06 \x01(\x01R\x06amount\x12\x12\n" +
+	"\x04note\x18\a \x01(\tR\x04note\x12*\n" +
+	"\x02at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x02at\x12\"\n" +
+	"\rcreated_by_id\x18\t \x01(\x04R\vcreatedById\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x96\x02\n" +
+	"\x1cExpenseTransferCreateRequest\x12*\n" +
+	"\x11from_warehouse_id\x18\x01 \x01(\x04R\x0ffromWarehouseId\x12&\n" +
+	"\x0ffrom_account_id\x18\x02 \x01(\x04R\rfromAccountId\x12&\n" +
+	"\x0fto_warehouse_id\x18\x03 \x01(\x04R\rtoWarehouseId\x12\"\n" +
+	"\rto_account_id\x18\x04 \x01(\x04R\vtoAccountId\x12\x16\n" +
+	"\x06amount\x18\x05 \x01(\x01R\x06amount\x12\x12\n" +
+	"\x04note\x18\x06 \x01(\tR\x04note\x12*\n" +
+	"\x02at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x02at\"Z\n" +
+	"\x1dExpenseTransferCreateResponse\x129\n" +
+	"\x04data\x18\x01 \x01(\v2%.warehouse_service.v1.ExpenseTransferR\x04data\"\x85\x02\n" +
+	"\x0eBalanceHistory\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12!\n" +
+	"\fwarehouse_id\x18\x02 \x01(\x04R\vwarehouseId\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x03 \x01(\x04R\taccountId\x12\"\n" +
+	"\rcreated_by_id\x18\x04 \x01(\x04R\vcreatedById\x12\x16\n" +
+	"\x06amount\x18\x05 \x01(\x01R\x06amount\x12*\n" +
+	"\x02at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x02at\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x9d\x01\n" +
+	"\x1bBalanceHistoryCreateRequest\x12\x1b\n" +
+	"\tdomain_id\x18\x01 \x01(\x04R\bdomainId\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\x04R\taccountId\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x01R\x06amount\x12*\n" +
+	"\x02at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x02at\"X\n" +
+	"\x1cBalanceHistoryCreateResponse\x128\n" +
+	"\x04data\x18\x01 \x01(\v2$.warehouse_service.v1.BalanceHistoryR\x04data\"\x97\x01\n" +
+	"\x1bBalanceHistoryUpdateRequest\x12\x1b\n" +
+	"\tdomain_id\x18\x01 \x01(\x04R\bdomainId\x12\x17\n" +
+	"\ahist_id\x18\x02 \x01(\x04R\x06histId\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x01R\x06amount\x12*\n" +
+	"\x02at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x02at\"X\n" +
+	"\x1cBalanceHistoryUpdateResponse\x128\n" +
+	"\x04data\x18\x01 \x01(\v2$.warehouse_service.v1.BalanceHistoryR\x04data\"S\n" +
+	"\x1bBalanceHistoryDeleteRequest\x12\x1b\n" +
+	"\tdomain_id\x18\x01 \x01(\x04R\bdomainId\x12\x17\n" +
+	"\ahist_id\x18\x02 \x01(\x04R\x06histId\"\x1e\n" +
+	"\x1cBalanceHistoryDeleteResponse\"\xf6\x01\n" +
+	"\x19BalanceHistoryListRequest\x12!\n" +
+	"\fwarehouse_id\x18\x01 \x01(\x04R\vwarehouseId\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\x04R\taccountId\x12\"\n" +
+	"\rcreated_by_id\x18\x03 \x01(\x04R\vcreatedById\x12\x0e\n" +
+	"\x02at\x18\x04 \x01(\x03R\x02at\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x05 \x01(\x03R\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x06 \x01(\x03R\aendDate\x12)\n" +
+	"\x04page\x18\a \x01(\v2\x15.common.v1.PageFilterR\x04page\"\x88\x01\n" +
+	"\x1aBalanceHistoryListResponse\x128\n" +
+	"\x04data\x18\x01 \x03(\v2$.warehouse_service.v1.BalanceHistoryR\x04data\x120\n" +
+	"\tpage_info\x18\x02 \x01(\v2\x13.common.v1.PageInfoR\bpageInfo\"\xda\x02\n" +
+	"\x15AccountReconciliation\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\x04R\taccountId\x12\x10\n" +
+	"\x03day\x18\x02 \x01(\tR\x03day\x12!\n" +
+	"\fprevious_day\x18\x03 \x01(\tR\vpreviousDay\x12)\n" +
+	"\x10previous_balance\x18\x04 \x01(\x01R\x0fpreviousBalance\x12\x16\n" +
+	"\x06income\x18\x05 \x01(\x01R\x06income\x12\x18\n" +
+	"\aoutcome\x18\x06 \x01(\x01R\aoutcome\x12)\n" +
+	"\x10expected_balance\x18\a \x01(\x01R\x0fexpectedBalance\x12)\n" +
+	"\x10recorded_balance\x18\b \x01(\x01R\x0frecordedBalance\x12\x1e\n" +
+	"\n" +
+	"difference\x18\t \x01(\x01R\n" +
+	"difference\x12\x1a\n" +
+	"\bmismatch\x18\n" +
+	" \x01(\bR\bmismatch\"\xc6\x01\n" +
+	"#AccountBalanceReconciliationRequest\x12!\n" +
+	"\fwarehouse_id\x18\x01 \x01(\x04R\vwarehouseId\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\x04R\taccountId\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x03 \x01(\x03R\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x04 \x01(\x03R\aendDate\x12#\n" +
+	"\rmismatch_only\x18\x05 \x01(\bR\fmismatchOnly\"\xf2\x01\n" +
+	"$AccountBalanceReconciliationResponse\x12!\n" +
+	"\fwarehouse_id\x18\x01 \x01(\x04R\vwarehouseId\x12\x1a\n" +
+	"\btimezone\x18\x02 \x01(\tR\btimezone\x12#\n" +
+	"\rchecked_count\x18\x03 \x01(\x03R\fcheckedCount\x12%\n" +
+	"\x0emismatch_count\x18\x04 \x01(\x03R\rmismatchCount\x12?\n" +
+	"\x04data\x18\x05 \x03(\v2+.warehouse_service.v1.AccountReconciliationR\x04data\"\xba\x02\n" +
+	"\vExpenseType\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04flow\x18\x03 \x01(\tR\x04flow\x12\x1d\n" +
+	"\n" +
+	"admin_only\x18\x04 \x01(\bR\tadminOnly\x12!\n" +
+	"\fwarehouse_id\x18\x05 \x01(\x04R\vwarehouseId\x12\x1a\n" +
+	"\bdisabled\x18\x06 \x01(\bR\bdisabled\x12\x1d\n" +
+	"\n" +
+	"team_types\x18\a \x03(\tR\tteamTypes\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x83\x01\n" +
+	"\x16ExpenseTypeListRequest\x12!\n" +
+	"\fwarehouse_id\x18\x01 \x01(\x04R\vwarehouseId\x12\x1b\n" +
+	"\tteam_type\x18\x02 \x01(\tR\bteamType\x12)\n" +
+	"\x10include_disabled\x18\x03 \x01(\bR\x0fincludeDisabled\"P\n" +
+	"\x17ExpenseTypeListResponse\x125\n" +
+	"\x04data\x18\x01 \x03(\v2!.warehouse_service.v1.ExpenseTypeR\x04data\"n\n" +
+	"\x18ExpenseTypeCreateRequest\x12\x1b\n" +
+	"\tdomain_id\x18\x01 \x01(\x04R\bdomainId\x125\n" +
+	"\x04data\x18\x02 \x01(\v2!.warehouse_service.v1.ExpenseTypeR\x04data\"R\n" +
+	"\x19ExpenseTypeCreateResponse\x125\n" +
+	"\x04data\x18\x01 \x01(\v2!.warehouse_service.v1.ExpenseTypeR\x04data\"n\n" +
+	"\x18ExpenseTypeUpdateRequest\x12\x1b\n" +
+	"\tdomain_id\x18\x01 \x01(\x04R\bdomainId\x125\n" +
+	"\x04data\x18\x02 \x01(\v2!.warehouse_service.v1.ExpenseTypeR\x04data\"R\n" +
+	"\x19ExpenseTypeUpdateResponse\x125\n" +
+	"\x04data\x18\x01 \x01(\v2!.warehouse_service.v1.ExpenseTypeR\x04data\"\xec\x02\n" +
+	"\rExpenseBudget\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12!\n" +
+	"\fwarehouse_id\x18\x02 \x01(\x04R\vwarehouseId\x12!\n" +
+	"\fexpense_type\x18\x03 \x01(\tR\vexpenseType\x12\x14\n" +
+	"\x05month\x18\x04 \x01(\tR\x05month\x12\x16\n" +
+	"\x06amount\x18\x05 \x01(\x01R\x06amount\x12#\n" +
+	"\ralert_percent\x18\x06 \x01(\x01R\falertPercent\x12\x18\n" +
+	"\aenforce\x18\a \x01(\tR\aenforce\x12\"\n" +
+	"\rupdated_by_id\x18\b \x01(\x04R\vupdatedById\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xe9\x01\n" +
+	"\x17ExpenseBudgetSetRequest\x12\x1b\n" +
+	"\tdomain_id\x18\x01 \x01(\x04R\bdomainId\x12!\n" +
+	"\fwarehouse_id\x18\x02 \x01(\x04R\vwarehouseId\x12!\n" +
+	"\fexpense_type\x18\x03 \x01(\tR\vexpenseType\x12\x14\n" +
+	"\x05month\x18\x04 \x01(\tR\x05month\x12\x16\n" +
+	"\x06amount\x18\x05 \x01(\x01R\x06amount\x12#\n" +
+	"\ralert_percent\x18\x06 \x01(\x01R\falertPercent\x12\x18\n" +
+	"\aenforce\x18\a \x01(\tR\aenforce\"S\n" +
+	"\x18ExpenseBudgetSetResponse\x127\n" +
+	"\x04data\x18\x01 \x01(\v2#.warehouse_service.v1.ExpenseBudgetR\x04data\"\x96\x02\n" +
+	"\x12ExpenseBudgetUsage\x12!\n" +
+	"\fexpense_type\x18\x01 \x01(\tR\vexpenseType\x12\x16\n" +
+	"\x06budget\x18\x02 \x01(\x01R\x06budget\x12#\n" +
+	"\ralert_percent\x18\x03 \x01(\x01R\falertPercent\x12\x18\n" +
+	"\aenforce\x18\x04 \x01(\tR\aenforce\x12\x14\n" +
+	"\x05spent\x18\x05 \x01(\x01R\x05spent\x12\x1c\n" +
+	"\tremaining\x18\x06 \x01(\x01R\tremaining\x12 \n" +
+	"\vutilisation\x18\a \x01(\x01R\vutilisation\x12\x14\n" +
+	"\x05alert\x18\b \x01(\bR\x05alert\x12\x1a\n" +
+	"\bexceeded\x18\t \x01(\bR\bexceeded\"U\n" +
+	"\x1aExpenseBudgetReportRequest\x12!\n" +
+	"\fwarehouse_id\x18\x01 \x01(\x04R\vwarehouseId\x12\x14\n" +
+	"\x05month\x18\x02 \x01(\tR\x05month\"\x94\x01\n" +
+	"\x1bExpenseBudgetReportResponse\x12!\n" +
+	"\fwarehouse_id\x18\x01 \x01(\x04R\vwarehouseId\x12\x14\n" +
+	"\x05month\x18\x02 \x01(\tR\x05month\x12<\n" +
+	"\x04data\x18\x03 \x03(\v2(.warehouse_service.v1.ExpenseBudgetUsageR\x04data2\x8c\x13\n" +
+	"\x1aWarehouseFinanceExtService\x12\x89\x01\n" +
+	"\x18ExpenseReportDailyDetail\x125.warehouse_service.v1.ExpenseReportDailyDetailRequest\x1a6.warehouse_service.v1.ExpenseReportDailyDetailResponse\x12w\n" +
+	"\x12ExpenseAccountPage\x12/.warehouse_service.v1.ExpenseAccountPageRequest\x1a0.warehouse_service.v1.ExpenseAccountPageResponse\x12\x8c\x01\n" +
+	"\x19ExpenseAccountSetDisabled\x126.warehouse_service.v1.ExpenseAccountSetDisabledRequest\x1a7.warehouse_service.v1.ExpenseAccountSetDisabledResponse\x12w\n" +
+	"\x12ExpenseHistoryPage\x12/.warehouse_service.v1.ExpenseHistoryPageRequest\x1a0.warehouse_service.v1.ExpenseHistoryPageResponse\x12}\n" +
+	"\x14ExpenseHistoryReview\x121.warehouse_service.v1.ExpenseHistoryReviewRequest\x1a2.warehouse_service.v1.ExpenseHistoryReviewResponse\x12\x83\x01\n" +
+	"\x16ExpenseHistoryRevision\x123.warehouse_service.v1.ExpenseHistoryRevisionRequest\x1a4.warehouse_service.v1.ExpenseHistoryRevisionResponse\x12}\n" +
+	"\x14ExpenseHistoryDelete\x121.warehouse_service.v1.ExpenseHistoryDeleteRequest\x1a2.warehouse_service.v1.ExpenseHistoryDeleteResponse\x12\x80\x01\n" +
+	"\x15ExpenseHistoryRestore\x122.warehouse_service.v1.ExpenseHistoryRestoreRequest\x1a3.warehouse_service.v1.ExpenseHistoryRestoreResponse\x12\x80\x01\n" +
+	"\x15ExpenseTransferCreate\x122.warehouse_service.v1.ExpenseTransferCreateRequest\x1a3.warehouse_service.v1.ExpenseTransferCreateResponse\x12}\n" +
+	"\x14BalanceHistoryCreate\x121.warehouse_service.v1.BalanceHistoryCreateRequest\x1a2.warehouse_service.v1.BalanceHistoryCreateResponse\x12}\n" +
+	"\x14BalanceHistoryUpdate\x121.warehouse_service.v1.BalanceHistoryUpdateRequest\x1a2.warehouse_service.v1.BalanceHistoryUpdateResponse\x12}\n" +
+	"\x14BalanceHistoryDelete\x121.warehouse_service.v1.BalanceHistoryDeleteRequest\x1a2.warehouse_service.v1.BalanceHistoryDeleteResponse\x12w\n" +
+	"\x12BalanceHistoryList\x12/.warehouse_service.v1.BalanceHistoryListRequest\x1a0.warehouse_service.v1.BalanceHistoryListResponse\x12\x95\x01\n" +
+	"\x1cAccountBalanceReconciliation\x129.warehouse_service.v1.AccountBalanceReconciliationRequest\x1a:.warehouse_service.v1.AccountBalanceReconciliationResponse\x12n\n" +
+	"\x0fExpenseTypeList\x12,.warehouse_service.v1.ExpenseTypeListRequest\x1a-.warehouse_service.v1.ExpenseTypeListResponse\x12t\n" +
+	"\x11ExpenseTypeCreate\x12..warehouse_service.v1.ExpenseTypeCreateRequest\x1a/.warehouse_service.v1.ExpenseTypeCreateResponse\x12t\n" +
+	"\x11ExpenseTypeUpdate\x12..warehouse_service.v1.ExpenseTypeUpdateRequest\x1a/.warehouse_service.v1.ExpenseTypeUpdateResponse\x12q\n" +
+	"\x10ExpenseBudgetSet\x12-.warehouse_service.v1.ExpenseBudgetSetRequest\x1a..warehouse_service.v1.ExpenseBudgetSetResponse\x12z\n" +
+	"\x13ExpenseBudgetReport\x120.warehouse_service.v1.ExpenseBudgetReportRequest\x1a1.warehouse_service.v1.ExpenseBudgetReportResponseBZZXgithub.com/pdcgo/warehouse_service/services/warehouse_service/v1;warehouse_service_ifaceb\x06proto3"
+
+var (
+	file_warehouse_service_v1_finance_ext_proto_rawDescOnce sync.Once
+	file_warehouse_service_v1_finance_ext_proto_rawDescData []byte
+)
+
+func file_warehouse_service_v1_finance_ext_proto_rawDescGZIP() []byte {
+	file_warehouse_service_v1_finance_ext_proto_rawDescOnce.Do(func() {
+		file_warehouse_service_v1_finance_ext_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_warehouse_service_v1_finance_ext_proto_rawDesc), len(file_warehouse_service_v1_finance_ext_proto_rawDesc)))
+	})
+	return file_warehouse_service_v1_finance_ext_proto_rawDescData
+}
+
+var file_warehouse_service_v1_finance_ext_proto_msgTypes = make([]protoimpl.MessageInfo, 51)
+var file_warehouse_service_v1_finance_ext_proto_goTypes = []any{
+	(*ExpenseListPage)(nil),                      // 0: warehouse_service.v1.ExpenseListPage
+	(*ExpenseAccount)(nil),                       // 1: warehouse_service.v1.ExpenseAccount
+	(*ExpenseHistory)(nil),                       // 2: warehouse_service.v1.ExpenseHistory
+	(*ExpenseTotal)(nil),                         // 3: warehouse_service.v1.ExpenseTotal
+	(*ExpenseReportDay)(nil),                     // 4: warehouse_service.v1.ExpenseReportDay
+	(*ExpenseReportDailyDetailRequest)(nil),      // 5: warehouse_service.v1.ExpenseReportDailyDetailRequest
+	(*ExpenseReportDailyDetailResponse)(nil),     // 6: warehouse_service.v1.ExpenseReportDailyDetailResponse
+	(*ExpenseAccountPageRequest)(nil),            // 7: warehouse_service.v1.ExpenseAccountPageRequest
+	(*ExpenseAccountPageResponse)(nil),           // 8: warehouse_service.v1.ExpenseAccountPageResponse
+	(*ExpenseAccountSetDisabledRequest)(nil),     // 9: warehouse_service.v1.ExpenseAccountSetDisabledRequest
+	(*ExpenseAccountSetDisabledResponse)(nil),    // 10: warehouse_service.v1.ExpenseAccountSetDisabledResponse
+	(*ExpenseHistoryPageRequest)(nil),            // 11: warehouse_service.v1.ExpenseHistoryPageRequest
+	(*ExpenseHistoryPageResponse)(nil),           // 12: warehouse_service.v1.ExpenseHistoryPageResponse
+	(*ExpenseHistoryReviewRequest)(nil),          // 13: warehouse_service.v1.ExpenseHistoryReviewRequest
+	(*ExpenseHistoryReviewResponse)(nil),         // 14: warehouse_service.v1.ExpenseHistoryReviewResponse
+	(*ExpenseRevisionValue)(nil),                 // 15: warehouse_service.v1.ExpenseRevisionValue
+	(*ExpenseRevision)(nil),                      // 16: warehouse_service.v1.ExpenseRevision
+	(*ExpenseHistoryRevisionRequest)(nil),        // 17: warehouse_service.v1.ExpenseHistoryRevisionRequest
+	(*ExpenseHistoryRevisionResponse)(nil),       // 18: warehouse_service.v1.ExpenseHistoryRevisionResponse
+	(*ExpenseHistoryDeleteRequest)(nil),          // 19: warehouse_service.v1.ExpenseHistoryDeleteRequest
+	(*ExpenseHistoryDeleteResponse)(nil),         // 20: warehouse_service.v1.ExpenseHistoryDeleteResponse
+	(*ExpenseHistoryRestoreRequest)(nil),         // 21: warehouse_service.v1.ExpenseHistoryRestoreRequest
+	(*ExpenseHistoryRestoreResponse)(nil),        // 22: warehouse_service.v1.ExpenseHistoryRestoreResponse
+	(*ExpenseTransfer)(nil),                      // 23: warehouse_service.v1.ExpenseTransfer
+	(*ExpenseTransferCreateRequest)(nil),         // 24: warehouse_service.v1.ExpenseTransferCreateRequest
+	(*ExpenseTransferCreateResponse)(nil),        // 25: warehouse_service.v1.ExpenseTransferCreateResponse
+	(*BalanceHistory)(nil),                       // 26: warehouse_service.v1.BalanceHistory
+	(*BalanceHistoryCreateRequest)(nil),          // 27: warehouse_service.v1.BalanceHistoryCreateRequest
+	(*BalanceHistoryCreateResponse)(nil),         // 28: warehouse_service.v1.BalanceHistoryCreateResponse
+	(*BalanceHistoryUpdateRequest)(nil),          // 29: warehouse_service.v1.BalanceHistoryUpdateRequest
+	(*BalanceHistoryUpdateResponse)(nil),         // 30: warehouse_service.v1.BalanceHistoryUpdateResponse
+	(*BalanceHistoryDeleteRequest)(nil),          // 31: warehouse_service.v1.BalanceHistoryDeleteRequest
+	(*BalanceHistoryDeleteResponse)(nil),         // 32: warehouse_service.v1.BalanceHistoryDeleteResponse
+	(*BalanceHistoryListRequest)(nil),            // 33: warehouse_service.v1.BalanceHistoryListRequest
+	(*BalanceHistoryListResponse)(nil),           // 34: warehouse_service.v1.BalanceHistoryListResponse
+	(*AccountReconciliation)(nil),                // 35: warehouse_service.v1.AccountReconciliation
+	(*AccountBalanceReconciliationRequest)(nil),  // 36: warehouse_service.v1.AccountBalanceReconciliationRequest
+	(*AccountBalanceReconciliationResponse)(nil), // 37: warehouse_service.v1.AccountBalanceReconciliationResponse
+	(*ExpenseType)(nil),                          // 38: warehouse_service.v1.ExpenseType
+	(*ExpenseTypeListRequest)(nil),               // 39: warehouse_service.v1.ExpenseTypeListRequest
+	(*ExpenseTypeListResponse)(nil),              // 40: warehouse_service.v1.ExpenseTypeListResponse
+	(*ExpenseTypeCreateRequest)(nil),             // 41: warehouse_service.v1.ExpenseTypeCreateRequest
+	(*ExpenseTypeCreateResponse)(nil),            // 42: warehouse_service.v1.ExpenseTypeCreateResponse
+	(*ExpenseTypeUpdateRequest)(nil),             // 43: warehouse_service.v1.ExpenseTypeUpdateRequest
+	(*ExpenseTypeUpdateResponse)(nil),            // 44: warehouse_service.v1.ExpenseTypeUpdateResponse
+	(*ExpenseBudget)(nil),                        // 45: warehouse_service.v1.ExpenseBudget
+	(*ExpenseBudgetSetRequest)(nil),              // 46: warehouse_service.v1.ExpenseBudgetSetRequest
+	(*ExpenseBudgetSetResponse)(nil),             // 47: warehouse_service.v1.ExpenseBudgetSetResponse
+	(*ExpenseBudgetUsage)(nil),                   // 48: warehouse_service.v1.ExpenseBudgetUsage
+	(*ExpenseBudgetReportRequest)(nil),           // 49: warehouse_service.v1.ExpenseBudgetReportRequest
+	(*ExpenseBudgetReportResponse)(nil),          // 50: warehouse_service.v1.ExpenseBudgetReportResponse
+	(*v1.PageFilter)(nil),                        // 51: common.v1.PageFilter
+	(*timestamppb.Timestamp)(nil),                // 52: google.protobuf.Timestamp
+	(*v1.PageInfo)(nil),                          // 53: common.v1.PageInfo
+}
+var file_warehouse_service_v1_finance_ext_proto_depIdxs = []int32{
+	51, // 0: warehouse_service.v1.ExpenseListPage.page:type_name -> common.v1.PageFilter
+	52, // 1: warehouse_service.v1.ExpenseAccount.created_at:type_name -> google.protobuf.Timestamp
+	52, // 2: warehouse_service.v1.ExpenseHistory.at:type_name -> google.protobuf.Timestamp
+	52, // 3: warehouse_service.v1.ExpenseHistory.created_at:type_name -> google.protobuf.Timestamp
+	3,  // 4: warehouse_service.v1.ExpenseReportDay.types:type_name -> warehouse_service.v1.ExpenseTotal
+	4,  // 5: warehouse_service.v1.ExpenseReportDailyDetailResponse.data:type_name -> warehouse_service.v1.ExpenseReportDay
+	0,  // 6: warehouse_service.v1.ExpenseAccountPageRequest.page:type_name -> warehouse_service.v1.ExpenseListPage
+	1,  // 7: warehouse_service.v1.ExpenseAccountPageResponse.data:type_name -> warehouse_service.v1.ExpenseAccount
+	53, // 8: warehouse_service.v1.ExpenseAccountPageResponse.page_info:type_name -> common.v1.PageInfo
+	1,  // 9: warehouse_service.v1.ExpenseAccountSetDisabledResponse.data:type_name -> warehouse_service.v1.ExpenseAccount
+	0,  // 10: warehouse_service.v1.ExpenseHistoryPageRequest.page:type_name -> warehouse_service.v1.ExpenseListPage
+	2,  // 11: warehouse_service.v1.ExpenseHistoryPageResponse.data:type_name -> warehouse_service.v1.ExpenseHistory
+	53, // 12: warehouse_service.v1.ExpenseHistoryPageResponse.page_info:type_name -> common.v1.PageInfo
+	3,  // 13: warehouse_service.v1.ExpenseHistoryPageResponse.totals:type_name -> warehouse_service.v1.ExpenseTotal
+	2,  // 14: warehouse_service.v1.ExpenseHistoryReviewResponse.data:type_name -> warehouse_service.v1.ExpenseHistory
+	52, // 15: warehouse_service.v1.ExpenseRevisionValue.at:type_name -> google.protobuf.Timestamp
+	15, // 16: warehouse_service.v1.ExpenseRevision.before:type_name -> warehouse_service.v1.ExpenseRevisionValue
+	15, // 17: warehouse_service.v1.ExpenseRevision.after:type_name -> warehouse_service.v1.ExpenseRevisionValue
+	52, // 18: warehouse_service.v1.ExpenseRevision.created_at:type_name -> google.protobuf.Timestamp
+	16, // 19: warehouse_service.v1.ExpenseHistoryRevisionResponse.data:type_name -> warehouse_service.v1.ExpenseRevision
+	2,  // 20: warehouse_service.v1.ExpenseHistoryDeleteResponse.data:type_name -> warehouse_service.v1.ExpenseHistory
+	2,  // 21: warehouse_service.v1.ExpenseHistoryRestoreResponse.data:type_name -> warehouse_service.v1.ExpenseHistory
+	52, // 22: warehouse_service.v1.ExpenseTransfer.at:type_name -> google.protobuf.Timestamp
+	52, // 23: warehouse_service.v1.ExpenseTransfer.created_at:type_name -> google.protobuf.Timestamp
+	52, // 24: warehouse_service.v1.ExpenseTransferCreateRequest.at:type_name -> google.protobuf.Timestamp
+	23, // 25: warehouse_service.v1.ExpenseTransferCreateResponse.data:type_name -> warehouse_service.v1.ExpenseTransfer
+	52, // 26: warehouse_service.v1.BalanceHistory.at:type_name -> google.protobuf.Timestamp
+	52, // 27: warehouse_service.v1.BalanceHistory.created_at:type_name -> google.protobuf.Timestamp
+	52, // 28: warehouse_service.v1.BalanceHistoryCreateRequest.at:type_name -> google.protobuf.Timestamp
+	26, // 29: warehouse_service.v1.BalanceHistoryCreateResponse.data:type_name -> warehouse_service.v1.BalanceHistory
+	52, // 30: warehouse_service.v1.BalanceHistoryUpdateRequest.at:type_name -> google.protobuf.Timestamp
+	26, // 31: warehouse_service.v1.BalanceHistoryUpdateResponse.data:type_name -> warehouse_service.v1.BalanceHistory
+	51, // 32: warehouse_service.v1.BalanceHistoryListRequest.page:type_name -> common.v1.PageFilter
+	26, // 33: warehouse_service.v1.BalanceHistoryListResponse.data:type_name -> warehouse_service.v1.BalanceHistory
+	53, // 34: warehouse_service.v1.BalanceHistoryListResponse.page_info:type_name -> common.v1.PageInfo
+	35, // 35: warehouse_service.v1.AccountBalanceReconciliationResponse.data:type_name -> warehouse_service.v1.AccountReconciliation
+	52, // 36: warehouse_service.v1.ExpenseType.created_at:type_name -> google.protobuf.Timestamp
+	52, // 37: warehouse_service.v1.ExpenseType.updated_at:type_name -> google.protobuf.Timestamp
+	38, // 38: warehouse_service.v1.ExpenseTypeListResponse.data:type_name -> warehouse_service.v1.ExpenseType
+	38, // 39: warehouse_service.v1.ExpenseTypeCreateRequest.data:type_name -> warehouse_service.v1.ExpenseType
+	38, // 40: warehouse_service.v1.ExpenseTypeCreateResponse.data:type_name -> warehouse_service.v1.ExpenseType
+	38, // 41: warehouse_service.v1.ExpenseTypeUpdateRequest.data:type_name -> warehouse_service.v1.ExpenseType
+	38, // 42: warehouse_service.v1.ExpenseTypeUpdateResponse.data:type_name -> warehouse_service.v1.ExpenseType
+	52, // 43: warehouse_service.v1.ExpenseBudget.created_at:type_name -> google.protobuf.Timestamp
+	52, // 44: warehouse_service.v1.ExpenseBudget.updated_at:type_name -> google.protobuf.Timestamp
+	45, // 45: warehouse_service.v1.ExpenseBudgetSetResponse.data:type_name -> warehouse_service.v1.ExpenseBudget
+	48, // 46: warehouse_service.v1.ExpenseBudgetReportResponse.data:type_name -> warehouse_service.v1.ExpenseBudgetUsage
+	5,  // 47: warehouse_service.v1.WarehouseFinanceExtService.ExpenseReportDailyDetail:input_type -> warehouse_service.v1.ExpenseReportDailyDetailRequest
+	7,  // 48: warehouse_service.v1.WarehouseFinanceExtService.ExpenseAccountPage:input_type -> warehouse_service.v1.ExpenseAccountPageRequest
+	9,  // 49: warehouse_service.v1.WarehouseFinanceExtService.ExpenseAccountSetDisabled:input_type -> warehouse_service.v1.ExpenseAccountSetDisabledRequest
+	11, // 50: warehouse_service.v1.WarehouseFinanceExtService.ExpenseHistoryPage:input_type -> warehouse_service.v1.ExpenseHistoryPageRequest
+	13, // 51: warehouse_service.v1.WarehouseFinanceExtService.ExpenseHistoryReview:input_type -> warehouse_service.v1.ExpenseHistoryReviewRequest
+	17, // 52: warehouse_service.v1.WarehouseFinanceExtService.ExpenseHistoryRevision:input_type -> warehouse_service.v1.ExpenseHistoryRevisionRequest
+	19, // 53: warehouse_service.v1.WarehouseFinanceExtService.ExpenseHistoryDelete:input_type -> warehouse_service.v1.ExpenseHistoryDeleteRequest
+	21, // 54: warehouse_service.v1.WarehouseFinanceExtService.ExpenseHistoryRestore:input_type -> warehouse_service.v1.ExpenseHistoryRestoreRequest
+	24, // 55: warehouse_service.v1.WarehouseFinanceExtService.ExpenseTransferCreate:input_type -> warehouse_service.v1.ExpenseTransferCreateRequest
+	27, // 56: warehouse_service.v1.WarehouseFinanceExtService.BalanceHistoryCreate:input_type -> warehouse_service.v1.BalanceHistoryCreateRequest
+	29, // 57: warehouse_service.v1.WarehouseFinanceExtService.BalanceHistoryUpdate:input_type -> warehouse_service.v1.BalanceHistoryUpdateRequest
+	31, // 58: warehouse_service.v1.WarehouseFinanceExtService.BalanceHistoryDelete:input_type -> warehouse_service.v1.BalanceHistoryDeleteRequest
+	33, // 59: warehouse_service.v1.WarehouseFinanceExtService.BalanceHistoryList:input_type -> warehouse_service.v1.BalanceHistoryListRequest
+	36, // 60: warehouse_service.v1.WarehouseFinanceExtService.AccountBalanceReconciliation:input_type -> warehouse_service.v1.AccountBalanceReconciliationRequest
+	39, // 61: warehouse_service.v1.WarehouseFinanceExtService.ExpenseTypeList:input_type -> warehouse_service.v1.ExpenseTypeListRequest
+	41, // 62: warehouse_service.v1.WarehouseFinanceExtService.ExpenseTypeCreate:input_type -> warehouse_service.v1.ExpenseTypeCreateRequest
+	43, // 63: warehouse_service.v1.WarehouseFinanceExtService.ExpenseTypeUpdate:input_type -> warehouse_service.v1.ExpenseTypeUpdateRequest
+	46, // 64: warehouse_service.v1.WarehouseFinanceExtService.ExpenseBudgetSet:input_type -> warehouse_service.v1.ExpenseBudgetSetRequest
+	49, // 65: warehouse_service.v1.WarehouseFinanceExtService.ExpenseBudgetReport:input_type -> warehouse_service.v1.ExpenseBudgetReportRequest
+	6,  // 66: warehouse_service.v1.WarehouseFinanceExtService.ExpenseReportDailyDetail:output_type -> warehouse_service.v1.ExpenseReportDailyDetailResponse
+	8,  // 67: warehouse_service.v1.WarehouseFinanceExtService.ExpenseAccountPage:output_type -> warehouse_service.v1.ExpenseAccountPageResponse
+	10, // 68: warehouse_service.v1.WarehouseFinanceExtService.ExpenseAccountSetDisabled:output_type -> warehouse_service.v1.ExpenseAccountSetDisabledResponse
+	12, // 69: warehouse_service.v1.WarehouseFinanceExtService.ExpenseHistoryPage:output_type -> warehouse_service.v1.ExpenseHistoryPageResponse
+	14, // 70: warehouse_service.v1.WarehouseFinanceExtService.ExpenseHistoryReview:output_type -> warehouse_service.v1.ExpenseHistoryReviewResponse
+	18, // 71: warehouse_service.v1.WarehouseFinanceExtService.ExpenseHistoryRevision:output_type -> warehouse_service.v1.ExpenseHistoryRevisionResponse
+	20, // 72: warehouse_service.v1.WarehouseFinanceExtService.ExpenseHistoryDelete:output_type -> warehouse_service.v1.ExpenseHistoryDeleteResponse
+	22, // 73: warehouse_service.v1.WarehouseFinanceExtService.ExpenseHistoryRestore:output_type -> warehouse_service.v1.ExpenseHistoryRestoreResponse
+	25, // 74: warehouse_service.v1.WarehouseFinanceExtService.ExpenseTransferCreate:output_type -> warehouse_service.v1.ExpenseTransferCreateResponse
+	28, // 75: warehouse_service.v1.WarehouseFinanceExtService.BalanceHistoryCreate:output_type -> warehouse_service.v1.BalanceHistoryCreateResponse
+	30, // 76: warehouse_service.v1.WarehouseFinanceExtService.BalanceHistoryUpdate:output_type -> warehouse_service.v1.BalanceHistoryUpdateResponse
+	32, // 77: warehouse_service.v1.WarehouseFinanceExtService.BalanceHistoryDelete:output_type -> warehouse_service.v1.BalanceHistoryDeleteResponse
+	34, // 78: warehouse_service.v1.WarehouseFinanceExtService.BalanceHistoryList:output_type -> warehouse_service.v1.BalanceHistoryListResponse
+	37, // 79: warehouse_service.v1.WarehouseFinanceExtService.AccountBalanceReconciliation:output_type -> warehouse_service.v1.AccountBalanceReconciliationResponse
+	40, // 80: warehouse_service.v1.WarehouseFinanceExtService.ExpenseTypeList:output_type -> warehouse_service.v1.ExpenseTypeListResponse
+	42, // 81: warehouse_service.v1.WarehouseFinanceExtService.ExpenseTypeCreate:output_type -> warehouse_service.v1.ExpenseTypeCreateResponse
+	44, // 82: warehouse_service.v1.WarehouseFinanceExtService.ExpenseTypeUpdate:output_type -> warehouse_service.v1.ExpenseTypeUpdateResponse
+	47, // 83: warehouse_service.v1.WarehouseFinanceExtService.ExpenseBudgetSet:output_type -> warehouse_service.v1.ExpenseBudgetSetResponse
+	50, // 84: warehouse_service.v1.WarehouseFinanceExtService.ExpenseBudgetReport:output_type -> warehouse_service.v1.ExpenseBudgetReportResponse
+	66, // [66:85] is the sub-list for method output_type
+	47, // [47:66] is the sub-list for method input_type
+	47, // [47:47] is the sub-list for extension type_name
+	47, // [47:47] is the sub-list for extension extendee
+	0,  // [0:47] is the sub-list for field type_name
+}
+
+func init() { file_warehouse_service_v1_finance_ext_proto_init() }
+func file_warehouse_service_v1_finance_ext_proto_init() {
+	if File_warehouse_service_v1_finance_ext_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_warehouse_service_v1_finance_ext_proto_rawDesc), len(file_warehouse_service_v1_finance_ext_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   51,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_warehouse_service_v1_finance_ext_proto_goTypes,
+		DependencyIndexes: file_warehouse_service_v1_finance_ext_proto_depIdxs,
+		MessageInfos:      file_warehouse_service_v1_finance_ext_proto_msgTypes,
+	}.Build()
+	File_warehouse_service_v1_finance_ext_proto = out.File
+	file_warehouse_service_v1_finance_ext_proto_goTypes = nil
+	file_warehouse_service_v1_finance_ext_proto_depIdxs = nil
+}
@@ -0,0 +1,473 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: warehouse_service/v1/inventory_detail.proto
+
+package warehouse_service_iface
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ProductStockDetailRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	WarehouseId uint64                 `protobuf:"varint,1,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	ProductId   uint64                 `protobuf:"varint,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	// outbound volume is counted from this many day ago, 0 is 30 day
+	OutboundDays  int64 `protobuf:"varint,3,opt,name=outbound_days,json=outboundDays,proto3" json:"outbound_days,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductStockDetailRequest) Reset() {
+	*x = ProductStockDetailRequest{}
+	mi := &file_warehouse_service_v1_inventory_detail_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductStockDetailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductStockDetailRequest) ProtoMessage() {}
+
+func (x *ProductStockDetailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_v1_inventory_detail_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductStockDetailRequest.ProtoReflect.Descriptor instead.
+func (*ProductStockDetailRequest) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_v1_inventory_detail_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ProductStockDetailRequest) GetWarehouseId() uint64 {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return 0
+}
+
+func (x *ProductStockDetailRequest) GetProductId() uint64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *ProductStockDetailRequest) GetOutboundDays() int64 {
+	if x != nil {
+		return x.OutboundDays
+	}
+	return 0
+}
+
+type ProductStockRack struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RackId        uint64                 `protobuf:"varint,1,opt,name=rack_id,json=rackId,proto3" json:"rack_id,omitempty"`
+	RackName      string                 `protobuf:"bytes,2,opt,name=rack_name,json=rackName,proto3" json:"rack_name,omitempty"`
+	ItemCount     int64                  `protobuf:"varint,3,opt,name=item_count,json=itemCount,proto3" json:"item_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductStockRack) Reset() {
+	*x = ProductStockRack{}
+	mi := &file_warehouse_service_v1_inventory_detail_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductStockRack) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductStockRack) ProtoMessage() {}
+
+func (x *ProductStockRack) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_v1_inventory_detail_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductStockRack.ProtoReflect.Descriptor instead.
+func (*ProductStockRack) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_v1_inventory_detail_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ProductStockRack) GetRackId() uint64 {
+	if x != nil {
+		return x.RackId
+	}
+	return 0
+}
+
+func (x *ProductStockRack) GetRackName() string {
+	if x != nil {
+		return x.RackName
+	}
+	return ""
+}
+
+func (x *ProductStockRack) GetItemCount() int64 {
+	if x != nil {
+		return x.ItemCount
+	}
+	return 0
+}
+
+type ProductStockVariant struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SkuId         string                 `protobuf:"bytes,1,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	VariantId     uint64                 `protobuf:"varint,2,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
+	VariantRefId  string                 `protobuf:"bytes,3,opt,name=variant_ref_id,json=variantRefId,proto3" json:"variant_ref_id,omitempty"`
+	StockReady    int64                  `protobuf:"varint,4,opt,name=stock_ready,json=stockReady,proto3" json:"stock_ready,omitempty"`
+	StockPending  int64                  `protobuf:"varint,5,opt,name=stock_pending,json=stockPending,proto3" json:"stock_pending,omitempty"`
+	IsBlacklisted bool                   `protobuf:"varint,6,opt,name=is_blacklisted,json=isBlacklisted,proto3" json:"is_blacklisted,omitempty"`
+	// stock value from latest daily sku history
+	StockValue        float64                `protobuf:"fixed64,7,opt,name=stock_value,json=stockValue,proto3" json:"stock_value,omitempty"`
+	StockValueAt      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=stock_value_at,json=stockValueAt,proto3" json:"stock_value_at,omitempty"`
+	Racks             []*ProductStockRack    `protobuf:"bytes,9,rep,name=racks,proto3" json:"racks,omitempty"`
+	OutboundTxCount   int64                  `protobuf:"varint,10,opt,name=outbound_tx_count,json=outboundTxCount,proto3" json:"outbound_tx_count,omitempty"`
+	OutboundItemCount int64                  `protobuf:"varint,11,opt,name=outbound_item_count,json=outboundItemCount,proto3" json:"outbound_item_count,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ProductStockVariant) Reset() {
+	*x = ProductStockVariant{}
+	mi := &file_warehouse_service_v1_inventory_detail_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductStockVariant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductStockVariant) ProtoMessage() {}
+
+func (x *ProductStockVariant) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_v1_inventory_detail_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductStockVariant.ProtoReflect.Descriptor instead.
+func (*ProductStockVariant) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_v1_inventory_detail_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ProductStockVariant) GetSkuId() string {
+	if x != nil {
+		return x.SkuId
+	}
+	return ""
+}
+
+func (x *ProductStockVariant) GetVariantId() uint64 {
+	if x != nil {
+		return x.VariantId
+	}
+	return 0
+}
+
+func (x *ProductStockVariant) GetVariantRefId() string {
+	if x != nil {
+		return x.VariantRefId
+	}
+	return ""
+}
+
+func (x *ProductStockVariant) GetStockReady() int64 {
+	if x != nil {
+		return x.StockReady
+	}
+	return 0
+}
+
+func (x *ProductStockVariant) GetStockPending() int64 {
+	if x != nil {
+		return x.StockPending
+	}
+	return 0
+}
+
+func (x *ProductStockVariant) GetIsBlacklisted() bool {
+	if x != nil {
+		return x.IsBlacklisted
+	}
+	return false
+}
+
+func (x *ProductStockVariant) GetStockValue() float64 {
+	if x != nil {
+		return x.StockValue
+	}
+	return 0
+}
+
+func (x *ProductStockVariant) GetStockValueAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StockValueAt
+	}
+	return nil
+}
+
+func (x *ProductStockVariant) GetRacks() []*ProductStockRack {
+	if x != nil {
+		return x.Racks
+	}
+	return nil
+}
+
+func (x *ProductStockVariant) GetOutboundTxCount() int64 {
+	if x != nil {
+		return x.OutboundTxCount
+	}
+	return 0
+}
+
+func (x *ProductStockVariant) GetOutboundItemCount() int64 {
+	if x != nil {
+		return x.OutboundItemCount
+	}
+	return 0
+}
+
+type ProductStockDetailResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     uint64                 `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	WarehouseId   uint64                 `protobuf:"varint,2,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	TeamId        uint64                 `protobuf:"varint,3,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Image         string                 `protobuf:"bytes,5,opt,name=image,proto3" json:"image,omitempty"`
+	RefId         string                 `protobuf:"bytes,6,opt,name=ref_id,json=refId,proto3" json:"ref_id,omitempty"`
+	StockValue    float64                `protobuf:"fixed64,7,opt,name=stock_value,json=stockValue,proto3" json:"stock_value,omitempty"`
+	Variants      []*ProductStockVariant `protobuf:"bytes,8,rep,name=variants,proto3" json:"variants,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductStockDetailResponse) Reset() {
+	*x = ProductStockDetailResponse{}
+	mi := &file_warehouse_service_v1_inventory_detail_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductStockDetailResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductStockDetailResponse) ProtoMessage() {}
+
+func (x *ProductStockDetailResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_v1_inventory_detail_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductStockDetailResponse.ProtoReflect.Descriptor instead.
+func (*ProductStockDetailResponse) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_v1_inventory_detail_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ProductStockDetailResponse) GetProductId() uint64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *ProductStockDetailResponse) GetWarehouseId() uint64 {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return 0
+}
+
+func (x *ProductStockDetailResponse) GetTeamId() uint64 {
+	if x != nil {
+		return x.TeamId
+	}
+	return 0
+}
+
+func (x *ProductStockDetailResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ProductStockDetailResponse) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *ProductStockDetailResponse) GetRefId() string {
+	if x != nil {
+		return x.RefId
+	}
+	return ""
+}
+
+func (x *ProductStockDetailResponse) GetStockValue() float64 {
+	if x != nil {
+		return x.StockValue
+	}
+	return 0
+}
+
+func (x *ProductStockDetailResponse) GetVariants() []*ProductStockVariant {
+	if x != nil {
+		return x.Variants
+	}
+	return nil
+}
+
+var File_warehouse_service_v1_inventory_detail_proto protoreflect.FileDescriptor
+
+const file_warehouse_service_v1_inventory_detail_proto_rawDesc = "" +
+	"\n" +
+	"+warehouse_service/v1/inventory_detail.proto\x12\x14warehouse_service.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xa0\x01\n" +
+	"\x19ProductStockDetailRequest\x12*\n" +
+	"\fwarehouse_id\x18\x01 \x01(\x04B\a\xbaH\x042\x02 \x00R\vwarehouseId\x12&\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\x04B\a\xbaH\x042\x02 \x00R\tproductId\x12/\n" +
+	"\routbound_days\x18\x03 \x01(\x03B\n" +
+	"\xbaH\a\"\x05\x18\xee\x02(\x00R\foutboundDays\"g\n" +
+	"\x10ProductStockRack\x12\x17\n" +
+	"\arack_id\x18\x01 \x01(\x04R\x06rackId\x12\x1b\n" +
+	"\track_name\x18\x02 \x01(\tR\brackName\x12\x1d\n" +
+	"\n" +
+	"item_count\x18\x03 \x01(\x03R\titemCount\"\xdb\x03\n" +
+	"\x13ProductStockVariant\x12\x15\n" +
+	"\x06sku_id\x18\x01 \x01(\tR\x05skuId\x12\x1d\n" +
+	"\n" +
+	"variant_id\x18\x02 \x01(\x04R\tvariantId\x12$\n" +
+	"\x0evariant_ref_id\x18\x03 \x01(\tR\fvariantRefId\x12\x1f\n" +
+	"\vstock_ready\x18\x04 \x01(\x03R\n" +
+	"stockReady\x12#\n" +
+	"\rstock_pending\x18\x05 \x01(\x03R\fstockPending\x12%\n" +
+	"\x0eis_blacklisted\x18\x06 \x01(\bR\risBlacklisted\x12\x1f\n" +
+	"\vstock_value\x18\a \x01(\x01R\n" +
+	"stockValue\x12@\n" +
+	"\x0estock_value_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\fstockValueAt\x12<\n" +
+	"\x05racks\x18\t \x03(\v2&.warehouse_service.v1.ProductStockRackR\x05racks\x12*\n" +
+	"\x11outbound_tx_count\x18\n" +
+	" \x01(\x03R\x0foutboundTxCount\x12.\n" +
+	"\x13outbound_item_count\x18\v \x01(\x03R\x11outboundItemCount\"\xa0\x02\n" +
+	"\x1aProductStockDetailResponse\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x04R\tproductId\x12!\n" +
+	"\fwarehouse_id\x18\x02 \x01(\x04R\vwarehouseId\x12\x17\n" +
+	"\ateam_id\x18\x03 \x01(\x04R\x06teamId\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12\x14\n" +
+	"\x05image\x18\x05 \x01(\tR\x05image\x12\x15\n" +
+	"\x06ref_id\x18\x06 \x01(\tR\x05refId\x12\x1f\n" +
+	"\vstock_value\x18\a \x01(\x01R\n" +
+	"stockValue\x12E\n" +
+	"\bvariants\x18\b \x03(\v2).warehouse_service.v1.ProductStockVariantR\bvariants2\x91\x01\n" +
+	"\x16InventoryDetailService\x12w\n" +
+	"\x12ProductStockDetail\x12/.warehouse_service.v1.ProductStockDetailRequest\x1a0.warehouse_service.v1.ProductStockDetailResponseBZZXgithub.com/pdcgo/warehouse_service/services/warehouse_service/v1;warehouse_service_ifaceb\x06proto3"
+
+var (
+	file_warehouse_service_v1_inventory_detail_proto_rawDescOnce sync.Once
+	file_warehouse_service_v1_inventory_detail_proto_rawDescData []byte
+)
+
+func file_warehouse_service_v1_inventory_detail_proto_rawDescGZIP() []byte {
+	file_warehouse_service_v1_inventory_detail_proto_rawDescOnce.Do(func() {
+		file_warehouse_service_v1_inventory_detail_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_warehouse_service_v1_inventory_detail_proto_rawDesc), len(file_warehouse_service_v1_inventory_detail_proto_rawDesc)))
+	})
+	return file_warehouse_service_v1_inventory_detail_proto_rawDescData
+}
+
+var file_warehouse_service_v1_inventory_detail_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_warehouse_service_v1_inventory_detail_proto_goTypes = []any{
+	(*ProductStockDetailRequest)(nil),  // 0: warehouse_service.v1.ProductStockDetailRequest
+	(*ProductStockRack)(nil),           // 1: warehouse_service.v1.ProductStockRack
+	(*ProductStockVariant)(nil),        // 2: warehouse_service.v1.ProductStockVariant
+	(*ProductStockDetailResponse)(nil), // 3: warehouse_service.v1.ProductStockDetailResponse
+	(*timestamppb.Timestamp)(nil),      // 4: google.protobuf.Timestamp
+}
+var file_warehouse_service_v1_inventory_detail_proto_depIdxs = []int32{
+	4, // 0: warehouse_service.v1.ProductStockVariant.stock_value_at:type_name -> google.protobuf.Timestamp
+	1, // 1: warehouse_service.v1.ProductStockVariant.racks:type_name -> warehouse_service.v1.ProductStockRack
+	2, // 2: warehouse_service.v1.ProductStockDetailResponse.variants:type_name -> warehouse_service.v1.ProductStockVariant
+	0, // 3: warehouse_service.v1.InventoryDetailService.ProductStockDetail:input_type -> warehouse_service.v1.ProductStockDetailRequest
+	3, // 4: warehouse_service.v1.InventoryDetailService.ProductStockDetail:output_type -> warehouse_service.v1.ProductStockDetailResponse
+	4, // [4:5] is the sub-list for method output_type
+	3, // [3:4] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_warehouse_service_v1_inventory_detail_proto_init() }
+func file_warehouse_service_v1_inventory_detail_proto_init() {
+	if File_warehouse_service_v1_inventory_detail_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_warehouse_service_v1_inventory_detail_proto_rawDesc), len(file_warehouse_service_v1_inventory_detail_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_warehouse_service_v1_inventory_detail_proto_goTypes,
+		DependencyIndexes: file_warehouse_service_v1_inventory_detail_proto_depIdxs,
+		MessageInfos:      file_warehouse_service_v1_inventory_detail_proto_msgTypes,
+	}.Build()
+	File_warehouse_service_v1_inventory_detail_proto = out.File
+	file_warehouse_service_v1_inventory_detail_proto_goTypes = nil
+	file_warehouse_service_v1_inventory_detail_proto_depIdxs = nil
+}
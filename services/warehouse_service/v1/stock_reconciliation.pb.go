@@ -0,0 +1,718 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: warehouse_service/v1/stock_reconciliation.proto
+
+package warehouse_service_iface
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	_ "github.com/pdcgo/schema/services/role_base/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ReconciliationSeverity int32
+
+const (
+	ReconciliationSeverity_RECONCILIATION_SEVERITY_UNSPECIFIED ReconciliationSeverity = 0
+	ReconciliationSeverity_RECONCILIATION_SEVERITY_OK          ReconciliationSeverity = 1
+	ReconciliationSeverity_RECONCILIATION_SEVERITY_WARNING     ReconciliationSeverity = 2
+	ReconciliationSeverity_RECONCILIATION_SEVERITY_CRITICAL    ReconciliationSeverity = 3
+)
+
+// Enum value maps for ReconciliationSeverity.
+var (
+	ReconciliationSeverity_name = map[int32]string{
+		0: "RECONCILIATION_SEVERITY_UNSPECIFIED",
+		1: "RECONCILIATION_SEVERITY_OK",
+		2: "RECONCILIATION_SEVERITY_WARNING",
+		3: "RECONCILIATION_SEVERITY_CRITICAL",
+	}
+	ReconciliationSeverity_value = map[string]int32{
+		"RECONCILIATION_SEVERITY_UNSPECIFIED": 0,
+		"RECONCILIATION_SEVERITY_OK":          1,
+		"RECONCILIATION_SEVERITY_WARNING":     2,
+		"RECONCILIATION_SEVERITY_CRITICAL":    3,
+	}
+)
+
+func (x ReconciliationSeverity) Enum() *ReconciliationSeverity {
+	p := new(ReconciliationSeverity)
+	*p = x
+	return p
+}
+
+func (x ReconciliationSeverity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReconciliationSeverity) Descriptor() protoreflect.EnumDescriptor {
+	return file_warehouse_service_v1_stock_reconciliation_proto_enumTypes[0].Descriptor()
+}
+
+func (ReconciliationSeverity) Type() protoreflect.EnumType {
+	return &file_warehouse_service_v1_stock_reconciliation_proto_enumTypes[0]
+}
+
+func (x ReconciliationSeverity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReconciliationSeverity.Descriptor instead.
+func (ReconciliationSeverity) EnumDescriptor() ([]byte, []int) {
+	return file_warehouse_service_v1_stock_reconciliation_proto_rawDescGZIP(), []int{0}
+}
+
+type StockReconciliationRun struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	WarehouseId   uint64                 `protobuf:"varint,2,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	SkuCount      int64                  `protobuf:"varint,3,opt,name=sku_count,json=skuCount,proto3" json:"sku_count,omitempty"`
+	WarningCount  int64                  `protobuf:"varint,4,opt,name=warning_count,json=warningCount,proto3" json:"warning_count,omitempty"`
+	CriticalCount int64                  `protobuf:"varint,5,opt,name=critical_count,json=criticalCount,proto3" json:"critical_count,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StockReconciliationRun) Reset() {
+	*x = StockReconciliationRun{}
+	mi := &file_warehouse_service_v1_stock_reconciliation_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockReconciliationRun) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockReconciliationRun) ProtoMessage() {}
+
+func (x *StockReconciliationRun) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_v1_stock_reconciliation_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockReconciliationRun.ProtoReflect.Descriptor instead.
+func (*StockReconciliationRun) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_v1_stock_reconciliation_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *StockReconciliationRun) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *StockReconciliationRun) GetWarehouseId() uint64 {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return 0
+}
+
+func (x *StockReconciliationRun) GetSkuCount() int64 {
+	if x != nil {
+		return x.SkuCount
+	}
+	return 0
+}
+
+func (x *StockReconciliationRun) GetWarningCount() int64 {
+	if x != nil {
+		return x.WarningCount
+	}
+	return 0
+}
+
+func (x *StockReconciliationRun) GetCriticalCount() int64 {
+	if x != nil {
+		return x.CriticalCount
+	}
+	return 0
+}
+
+func (x *StockReconciliationRun) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type StockReconciliationItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	RunId         uint64                 `protobuf:"varint,2,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	SkuId         string                 `protobuf:"bytes,3,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	WarehouseId   uint64                 `protobuf:"varint,4,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	StockTotal    int64                  `protobuf:"varint,5,opt,name=stock_total,json=stockTotal,proto3" json:"stock_total,omitempty"`
+	StockReady    int64                  `protobuf:"varint,6,opt,name=stock_ready,json=stockReady,proto3" json:"stock_ready,omitempty"`
+	HistoryCount  int64                  `protobuf:"varint,7,opt,name=history_count,json=historyCount,proto3" json:"history_count,omitempty"`
+	DailyEndCount *int64                 `protobuf:"varint,8,opt,name=daily_end_count,json=dailyEndCount,proto3,oneof" json:"daily_end_count,omitempty"`
+	DailyT        *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=daily_t,json=dailyT,proto3" json:"daily_t,omitempty"`
+	Severity      ReconciliationSeverity `protobuf:"varint,10,opt,name=severity,proto3,enum=warehouse_service.v1.ReconciliationSeverity" json:"severity,omitempty"`
+	Note          string                 `protobuf:"bytes,11,opt,name=note,proto3" json:"note,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StockReconciliationItem) Reset() {
+	*x = StockReconciliationItem{}
+	mi := &file_warehouse_service_v1_stock_reconciliation_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockReconciliationItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockReconciliationItem) ProtoMessage() {}
+
+func (x *StockReconciliationItem) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_v1_stock_reconciliation_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockReconciliationItem.ProtoReflect.Descriptor instead.
+func (*StockReconciliationItem) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_v1_stock_reconciliation_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *StockReconciliationItem) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *StockReconciliationItem) GetRunId() uint64 {
+	if x != nil {
+		return x.RunId
+	}
+	return 0
+}
+
+func (x *StockReconciliationItem) GetSkuId() string {
+	if x != nil {
+		return x.SkuId
+	}
+	return ""
+}
+
+func (x *StockReconciliationItem) GetWarehouseId() uint64 {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return 0
+}
+
+func (x *StockReconciliationItem) GetStockTotal() int64 {
+	if x != nil {
+		return x.StockTotal
+	}
+	return 0
+}
+
+func (x *StockReconciliationItem) GetStockReady() int64 {
+	if x != nil {
+		return x.StockReady
+	}
+	return 0
+}
+
+func (x *StockReconciliationItem) GetHistoryCount() int64 {
+	if x != nil {
+		return x.HistoryCount
+	}
+	return 0
+}
+
+func (x *StockReconciliationItem) GetDailyEndCount() int64 {
+	if x != nil && x.DailyEndCount != nil {
+		return *x.DailyEndCount
+	}
+	return 0
+}
+
+func (x *StockReconciliationItem) GetDailyT() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DailyT
+	}
+	return nil
+}
+
+func (x *StockReconciliationItem) GetSeverity() ReconciliationSeverity {
+	if x != nil {
+		return x.Severity
+	}
+	return ReconciliationSeverity_RECONCILIATION_SEVERITY_UNSPECIFIED
+}
+
+func (x *StockReconciliationItem) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *StockReconciliationItem) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type StockReconciliationCreateRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 0 for all warehouse
+	WarehouseId   uint64   `protobuf:"varint,1,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	SkuIds        []string `protobuf:"bytes,2,rep,name=sku_ids,json=skuIds,proto3" json:"sku_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StockReconciliationCreateRequest) Reset() {
+	*x = StockReconciliationCreateRequest{}
+	mi := &file_warehouse_service_v1_stock_reconciliation_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockReconciliationCreateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockReconciliationCreateRequest) ProtoMessage() {}
+
+func (x *StockReconciliationCreateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_v1_stock_reconciliation_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockReconciliationCreateRequest.ProtoReflect.Descriptor instead.
+func (*StockReconciliationCreateRequest) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_v1_stock_reconciliation_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *StockReconciliationCreateRequest) GetWarehouseId() uint64 {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return 0
+}
+
+func (x *StockReconciliationCreateRequest) GetSkuIds() []string {
+	if x != nil {
+		return x.SkuIds
+	}
+	return nil
+}
+
+type StockReconciliationCreateResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Run           *StockReconciliationRun    `protobuf:"bytes,1,opt,name=run,proto3" json:"run,omitempty"`
+	Items         []*StockReconciliationItem `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StockReconciliationCreateResponse) Reset() {
+	*x = StockReconciliationCreateResponse{}
+	mi := &file_warehouse_service_v1_stock_reconciliation_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockReconciliationCreateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockReconciliationCreateResponse) ProtoMessage() {}
+
+func (x *StockReconciliationCreateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_v1_stock_reconciliation_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockReconciliationCreateResponse.ProtoReflect.Descriptor instead.
+func (*StockReconciliationCreateResponse) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_v1_stock_reconciliation_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *StockReconciliationCreateResponse) GetRun() *StockReconciliationRun {
+	if x != nil {
+		return x.Run
+	}
+	return nil
+}
+
+func (x *StockReconciliationCreateResponse) GetItems() []*StockReconciliationItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type StockReconciliationListRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 0 is run for all warehouse
+	WarehouseId uint64 `protobuf:"varint,1,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	// 0 is 30
+	Limit         int64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StockReconciliationListRequest) Reset() {
+	*x = StockReconciliationListRequest{}
+	mi := &file_warehouse_service_v1_stock_reconciliation_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockReconciliationListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockReconciliationListRequest) ProtoMessage() {}
+
+func (x *StockReconciliationListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_v1_stock_reconciliation_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockReconciliationListRequest.ProtoReflect.Descriptor instead.
+func (*StockReconciliationListRequest) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_v1_stock_reconciliation_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *StockReconciliationListRequest) GetWarehouseId() uint64 {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return 0
+}
+
+func (x *StockReconciliationListRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type StockReconciliationListResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Data          []*StockReconciliationRun `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StockReconciliationListResponse) Reset() {
+	*x = StockReconciliationListResponse{}
+	mi := &file_warehouse_service_v1_stock_reconciliation_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockReconciliationListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockReconciliationListResponse) ProtoMessage() {}
+
+func (x *StockReconciliationListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_v1_stock_reconciliation_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockReconciliationListResponse.ProtoReflect.Descriptor instead.
+func (*StockReconciliationListResponse) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_v1_stock_reconciliation_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *StockReconciliationListResponse) GetData() []*StockReconciliationRun {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type StockReconciliationItemListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RunId         uint64                 `protobuf:"varint,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StockReconciliationItemListRequest) Reset() {
+	*x = StockReconciliationItemListRequest{}
+	mi := &file_warehouse_service_v1_stock_reconciliation_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockReconciliationItemListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockReconciliationItemListRequest) ProtoMessage() {}
+
+func (x *StockReconciliationItemListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_v1_stock_reconciliation_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockReconciliationItemListRequest.ProtoReflect.Descriptor instead.
+func (*StockReconciliationItemListRequest) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_v1_stock_reconciliation_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *StockReconciliationItemListRequest) GetRunId() uint64 {
+	if x != nil {
+		return x.RunId
+	}
+	return 0
+}
+
+type StockReconciliationItemListResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Data          []*StockReconciliationItem `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StockReconciliationItemListResponse) Reset() {
+	*x = StockReconciliationItemListResponse{}
+	mi := &file_warehouse_service_v1_stock_reconciliation_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockReconciliationItemListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockReconciliationItemListResponse) ProtoMessage() {}
+
+func (x *StockReconciliationItemListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_v1_stock_reconciliation_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockReconciliationItemListResponse.ProtoReflect.Descriptor instead.
+func (*StockReconciliationItemListResponse) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_v1_stock_reconciliation_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *StockReconciliationItemListResponse) GetData() []*StockReconciliationItem {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+var File_warehouse_service_v1_stock_reconciliation_proto protoreflect.FileDescriptor
+
+const file_warehouse_service_v1_stock_reconciliation_proto_rawDesc = "" +
+	"\n" +
+	"/warehouse_service/v1/stock_reconciliation.proto\x12\x14warehouse_service.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17role_base/v1/role.proto\"\xef\x01\n" +
+	"\x16StockReconciliationRun\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12!\n" +
+	"\fwarehouse_id\x18\x02 \x01(\x04R\vwarehouseId\x12\x1b\n" +
+	"\tsku_count\x18\x03 \x01(\x03R\bskuCount\x12#\n" +
+	"\rwarning_count\x18\x04 \x01(\x03R\fwarningCount\x12%\n" +
+	"\x0ecritical_count\x18\x05 \x01(\x03R\rcriticalCount\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xf0\x03\n" +
+	"\x17StockReconciliationItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x15\n" +
+	"\x06run_id\x18\x02 \x01(\x04R\x05runId\x12\x15\n" +
+	"\x06sku_id\x18\x03 \x01(\tR\x05skuId\x12!\n" +
+	"\fwarehouse_id\x18\x04 \x01(\x04R\vwarehouseId\x12\x1f\n" +
+	"\vstock_total\x18\x05 \x01(\x03R\n" +
+	"stockTotal\x12\x1f\n" +
+	"\vstock_ready\x18\x06 \x01(\x03R\n" +
+	"stockReady\x12#\n" +
+	"\rhistory_count\x18\a \x01(\x03R\fhistoryCount\x12+\n" +
+	"\x0fdaily_end_count\x18\b \x01(\x03H\x00R\rdailyEndCount\x88\x01\x01\x123\n" +
+	"\adaily_t\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\x06dailyT\x12H\n" +
+	"\bseverity\x18\n" +
+	" \x01(\x0e2,.warehouse_service.v1.ReconciliationSeverityR\bseverity\x12\x12\n" +
+	"\x04note\x18\v \x01(\tR\x04note\x129\n" +
+	"\n" +
+	"created_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\x12\n" +
+	"\x10_daily_end_count\"h\n" +
+	" StockReconciliationCreateRequest\x12!\n" +
+	"\fwarehouse_id\x18\x01 \x01(\x04R\vwarehouseId\x12\x17\n" +
+	"\asku_ids\x18\x02 \x03(\tR\x06skuIds:\b\x92\xb5\x18\x04\n" +
+	"\x02\x01\x02\"\xa8\x01\n" +
+	"!StockReconciliationCreateResponse\x12>\n" +
+	"\x03run\x18\x01 \x01(\v2,.warehouse_service.v1.StockReconciliationRunR\x03run\x12C\n" +
+	"\x05items\x18\x02 \x03(\v2-.warehouse_service.v1.StockReconciliationItemR\x05items\"o\n" +
+	"\x1eStockReconciliationListRequest\x12!\n" +
+	"\fwarehouse_id\x18\x01 \x01(\x04R\vwarehouseId\x12 \n" +
+	"\x05limit\x18\x02 \x01(\x03B\n" +
+	"\xbaH\a\"\x05\x18\xe8\a(\x00R\x05limit:\b\x92\xb5\x18\x04\n" +
+	"\x02\x01\x02\"c\n" +
+	"\x1fStockReconciliationListResponse\x12@\n" +
+	"\x04data\x18\x01 \x03(\v2,.warehouse_service.v1.StockReconciliationRunR\x04data\"N\n" +
+	"\"StockReconciliationItemListRequest\x12\x1e\n" +
+	"\x06run_id\x18\x01 \x01(\x04B\a\xbaH\x042\x02 \x00R\x05runId:\b\x92\xb5\x18\x04\n" +
+	"\x02\x01\x02\"h\n" +
+	"#StockReconciliationItemListResponse\x12A\n" +
+	"\x04data\x18\x01 \x03(\v2-.warehouse_service.v1.StockReconciliationItemR\x04data*\xac\x01\n" +
+	"\x16ReconciliationSeverity\x12'\n" +
+	"#RECONCILIATION_SEVERITY_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aRECONCILIATION_SEVERITY_OK\x10\x01\x12#\n" +
+	"\x1fRECONCILIATION_SEVERITY_WARNING\x10\x02\x12$\n" +
+	" RECONCILIATION_SEVERITY_CRITICAL\x10\x032\xc9\x03\n" +
+	"\x1aStockReconciliationService\x12\x8c\x01\n" +
+	"\x19StockReconciliationCreate\x126.warehouse_service.v1.StockReconciliationCreateRequest\x1a7.warehouse_service.v1.StockReconciliationCreateResponse\x12\x86\x01\n" +
+	"\x17StockReconciliationList\x124.warehouse_service.v1.StockReconciliationListRequest\x1a5.warehouse_service.v1.StockReconciliationListResponse\x12\x92\x01\n" +
+	"\x1bStockReconciliationItemList\x128.warehouse_service.v1.StockReconciliationItemListRequest\x1a9.warehouse_service.v1.StockReconciliationItemListResponseBZZXgithub.com/pdcgo/warehouse_service/services/warehouse_service/v1;warehouse_service_ifaceb\x06proto3"
+
+var (
+	file_warehouse_service_v1_stock_reconciliation_proto_rawDescOnce sync.Once
+	file_warehouse_service_v1_stock_reconciliation_proto_rawDescData []byte
+)
+
+func file_warehouse_service_v1_stock_reconciliation_proto_rawDescGZIP() []byte {
+	file_warehouse_service_v1_stock_reconciliation_proto_rawDescOnce.Do(func() {
+		file_warehouse_service_v1_stock_reconciliation_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_warehouse_service_v1_stock_reconciliation_proto_rawDesc), len(file_warehouse_service_v1_stock_reconciliation_proto_rawDesc)))
+	})
+	return file_warehouse_service_v1_stock_reconciliation_proto_rawDescData
+}
+
+var file_warehouse_service_v1_stock_reconciliation_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_warehouse_service_v1_stock_reconciliation_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_warehouse_service_v1_stock_reconciliation_proto_goTypes = []any{
+	(ReconciliationSeverity)(0),                 // 0: warehouse_service.v1.ReconciliationSeverity
+	(*StockReconciliationRun)(nil),              // 1: warehouse_service.v1.StockReconciliationRun
+	(*StockReconciliationItem)(nil),             // 2: warehouse_service.v1.StockReconciliationItem
+	(*StockReconciliationCreateRequest)(nil),    // 3: warehouse_service.v1.StockReconciliationCreateRequest
+	(*StockReconciliationCreateResponse)(nil),   // 4: warehouse_service.v1.StockReconciliationCreateResponse
+	(*StockReconciliationListRequest)(nil),      // 5: warehouse_service.v1.StockReconciliationListRequest
+	(*StockReconciliationListResponse)(nil),     // 6: warehouse_service.v1.StockReconciliationListResponse
+	(*StockReconciliationItemListRequest)(nil),  // 7: warehouse_service.v1.StockReconciliationItemListRequest
+	(*StockReconciliationItemListResponse)(nil), // 8: warehouse_service.v1.StockReconciliationItemListResponse
+	(*timestamppb.Timestamp)(nil),               // 9: google.protobuf.Timestamp
+}
+var file_warehouse_service_v1_stock_reconciliation_proto_depIdxs = []int32{
+	9,  // 0: warehouse_service.v1.StockReconciliationRun.created_at:type_name -> google.protobuf.Timestamp
+	9,  // 1: warehouse_service.v1.StockReconciliationItem.daily_t:type_name -> google.protobuf.Timestamp
+	0,  // 2: warehouse_service.v1.StockReconciliationItem.severity:type_name -> warehouse_service.v1.ReconciliationSeverity
+	9,  // 3: warehouse_service.v1.StockReconciliationItem.created_at:type_name -> google.protobuf.Timestamp
+	1,  // 4: warehouse_service.v1.StockReconciliationCreateResponse.run:type_name -> warehouse_service.v1.StockReconciliationRun
+	2,  // 5: warehouse_service.v1.StockReconciliationCreateResponse.items:type_name -> warehouse_service.v1.StockReconciliationItem
+	1,  // 6: warehouse_service.v1.StockReconciliationListResponse.data:type_name -> warehouse_service.v1.StockReconciliationRun
+	2,  // 7: warehouse_service.v1.StockReconciliationItemListResponse.data:type_name -> warehouse_service.v1.StockReconciliationItem
+	3,  // 8: warehouse_service.v1.StockReconciliationService.StockReconciliationCreate:input_type -> warehouse_service.v1.StockReconciliationCreateRequest
+	5,  // 9: warehouse_service.v1.StockReconciliationService.StockReconciliationList:input_type -> warehouse_service.v1.StockReconciliationListRequest
+	7,  // 10: warehouse_service.v1.StockReconciliationService.StockReconciliationItemList:input_type -> warehouse_service.v1.StockReconciliationItemListRequest
+	4,  // 11: warehouse_service.v1.StockReconciliationService.StockReconciliationCreate:output_type -> warehouse_service.v1.StockReconciliationCreateResponse
+	6,  // 12: warehouse_service.v1.StockReconciliationService.StockReconciliationList:output_type -> warehouse_service.v1.StockReconciliationListResponse
+	8,  // 13: warehouse_service.v1.StockReconciliationService.StockReconciliationItemList:output_type -> warehouse_service.v1.StockReconciliationItemListResponse
+	11, // [11:14] is the sub-list for method output_type
+	8,  // [8:11] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_warehouse_service_v1_stock_reconciliation_proto_init() }
+func file_warehouse_service_v1_stock_reconciliation_proto_init() {
+	if File_warehouse_service_v1_stock_reconciliation_proto != nil {
+		return
+	}
+	file_warehouse_service_v1_stock_reconciliation_proto_msgTypes[1].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_warehouse_service_v1_stock_reconciliation_proto_rawDesc), len(file_warehouse_service_v1_stock_reconciliation_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_warehouse_service_v1_stock_reconciliation_proto_goTypes,
+		DependencyIndexes: file_warehouse_service_v1_stock_reconciliation_proto_depIdxs,
+		EnumInfos:         file_warehouse_service_v1_stock_reconciliation_proto_enumTypes,
+		MessageInfos:      file_warehouse_service_v1_stock_reconciliation_proto_msgTypes,
+	}.Build()
+	File_warehouse_service_v1_stock_reconciliation_proto = out.File
+	file_warehouse_service_v1_stock_reconciliation_proto_goTypes = nil
+	file_warehouse_service_v1_stock_reconciliation_proto_depIdxs = nil
+}
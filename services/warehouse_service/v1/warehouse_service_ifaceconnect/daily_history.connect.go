@@ -0,0 +1,110 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: warehouse_service/v1/daily_history.proto
+
+package warehouse_service_ifaceconnect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/pdcgo/warehouse_service/services/warehouse_service/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// DailyHistoryServiceName is the fully-qualified name of the DailyHistoryService service.
+	DailyHistoryServiceName = "warehouse_service.v1.DailyHistoryService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// DailyHistoryServiceDailyHistoryRebuildProcedure is the fully-qualified name of the
+	// DailyHistoryService's DailyHistoryRebuild RPC.
+	DailyHistoryServiceDailyHistoryRebuildProcedure = "/warehouse_service.v1.DailyHistoryService/DailyHistoryRebuild"
+)
+
+// DailyHistoryServiceClient is a client for the warehouse_service.v1.DailyHistoryService service.
+type DailyHistoryServiceClient interface {
+	DailyHistoryRebuild(context.Context, *connect.Request[v1.DailyHistoryRebuildRequest]) (*connect.Response[v1.DailyHistoryRebuildResponse], error)
+}
+
+// NewDailyHistoryServiceClient constructs a client for the warehouse_service.v1.DailyHistoryService
+// service. By default, it uses the Connect protocol with the binary Protobuf Codec, asks for
+// gzipped responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply
+// the connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewDailyHistoryServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) DailyHistoryServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	dailyHistoryServiceMethods := v1.File_warehouse_service_v1_daily_history_proto.Services().ByName("DailyHistoryService").Methods()
+	return &dailyHistoryServiceClient{
+		dailyHistoryRebuild: connect.NewClient[v1.DailyHistoryRebuildRequest, v1.DailyHistoryRebuildResponse](
+			httpClient,
+			baseURL+DailyHistoryServiceDailyHistoryRebuildProcedure,
+			connect.WithSchema(dailyHistoryServiceMethods.ByName("DailyHistoryRebuild")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// dailyHistoryServiceClient implements DailyHistoryServiceClient.
+type dailyHistoryServiceClient struct {
+	dailyHistoryRebuild *connect.Client[v1.DailyHistoryRebuildRequest, v1.DailyHistoryRebuildResponse]
+}
+
+// DailyHistoryRebuild calls warehouse_service.v1.DailyHistoryService.DailyHistoryRebuild.
+func (c *dailyHistoryServiceClient) DailyHistoryRebuild(ctx context.Context, req *connect.Request[v1.DailyHistoryRebuildRequest]) (*connect.Response[v1.DailyHistoryRebuildResponse], error) {
+	return c.dailyHistoryRebuild.CallUnary(ctx, req)
+}
+
+// DailyHistoryServiceHandler is an implementation of the warehouse_service.v1.DailyHistoryService
+// service.
+type DailyHistoryServiceHandler interface {
+	DailyHistoryRebuild(context.Context, *connect.Request[v1.DailyHistoryRebuildRequest]) (*connect.Response[v1.DailyHistoryRebuildResponse], error)
+}
+
+// NewDailyHistoryServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewDailyHistoryServiceHandler(svc DailyHistoryServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	dailyHistoryServiceMethods := v1.File_warehouse_service_v1_daily_history_proto.Services().ByName("DailyHistoryService").Methods()
+	dailyHistoryServiceDailyHistoryRebuildHandler := connect.NewUnaryHandler(
+		DailyHistoryServiceDailyHistoryRebuildProcedure,
+		svc.DailyHistoryRebuild,
+		connect.WithSchema(dailyHistoryServiceMethods.ByName("DailyHistoryRebuild")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/warehouse_service.v1.DailyHistoryService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case DailyHistoryServiceDailyHistoryRebuildProcedure:
+			dailyHistoryServiceDailyHistoryRebuildHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedDailyHistoryServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedDailyHistoryServiceHandler struct{}
+
+func (UnimplementedDailyHistoryServiceHandler) DailyHistoryRebuild(context.Context, *connect.Request[v1.DailyHistoryRebuildRequest]) (*connect.Response[v1.DailyHistoryRebuildResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("warehouse_service.v1.DailyHistoryService.DailyHistoryRebuild is not implemented"))
+}
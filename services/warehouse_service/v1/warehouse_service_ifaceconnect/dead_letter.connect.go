@@ -0,0 +1,168 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: warehouse_service/v1/dead_letter.proto
+
+package warehouse_service_ifaceconnect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/pdcgo/warehouse_service/services/warehouse_service/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// DeadLetterServiceName is the fully-qualified name of the DeadLetterService service.
+	DeadLetterServiceName = "warehouse_service.v1.DeadLetterService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// DeadLetterServiceDeadLetterListProcedure is the fully-qualified name of the DeadLetterService's
+	// DeadLetterList RPC.
+	DeadLetterServiceDeadLetterListProcedure = "/warehouse_service.v1.DeadLetterService/DeadLetterList"
+	// DeadLetterServiceDeadLetterGetProcedure is the fully-qualified name of the DeadLetterService's
+	// DeadLetterGet RPC.
+	DeadLetterServiceDeadLetterGetProcedure = "/warehouse_service.v1.DeadLetterService/DeadLetterGet"
+	// DeadLetterServiceDeadLetterReplayProcedure is the fully-qualified name of the DeadLetterService's
+	// DeadLetterReplay RPC.
+	DeadLetterServiceDeadLetterReplayProcedure = "/warehouse_service.v1.DeadLetterService/DeadLetterReplay"
+)
+
+// DeadLetterServiceClient is a client for the warehouse_service.v1.DeadLetterService service.
+type DeadLetterServiceClient interface {
+	DeadLetterList(context.Context, *connect.Request[v1.DeadLetterListRequest]) (*connect.Response[v1.DeadLetterListResponse], error)
+	DeadLetterGet(context.Context, *connect.Request[v1.DeadLetterGetRequest]) (*connect.Response[v1.DeadLetterGetResponse], error)
+	DeadLetterReplay(context.Context, *connect.Request[v1.DeadLetterReplayRequest]) (*connect.Response[v1.DeadLetterReplayResponse], error)
+}
+
+// NewDeadLetterServiceClient constructs a client for the warehouse_service.v1.DeadLetterService
+// service. By default, it uses the Connect protocol with the binary Protobuf Codec, asks for
+// gzipped responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply
+// the connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewDeadLetterServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) DeadLetterServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	deadLetterServiceMethods := v1.File_warehouse_service_v1_dead_letter_proto.Services().ByName("DeadLetterService").Methods()
+	return &deadLetterServiceClient{
+		deadLetterList: connect.NewClient[v1.DeadLetterListRequest, v1.DeadLetterListResponse](
+			httpClient,
+			baseURL+DeadLetterServiceDeadLetterListProcedure,
+			connect.WithSchema(deadLetterServiceMethods.ByName("DeadLetterList")),
+			connect.WithClientOptions(opts...),
+		),
+		deadLetterGet: connect.NewClient[v1.DeadLetterGetRequest, v1.DeadLetterGetResponse](
+			httpClient,
+			baseURL+DeadLetterServiceDeadLetterGetProcedure,
+			connect.WithSchema(deadLetterServiceMethods.ByName("DeadLetterGet")),
+			connect.WithClientOptions(opts...),
+		),
+		deadLetterReplay: connect.NewClient[v1.DeadLetterReplayRequest, v1.DeadLetterReplayResponse](
+			httpClient,
+			baseURL+DeadLetterServiceDeadLetterReplayProcedure,
+			connect.WithSchema(deadLetterServiceMethods.ByName("DeadLetterReplay")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// deadLetterServiceClient implements DeadLetterServiceClient.
+type deadLetterServiceClient struct {
+	deadLetterList   *connect.Client[v1.DeadLetterListRequest, v1.DeadLetterListResponse]
+	deadLetterGet    *connect.Client[v1.DeadLetterGetRequest, v1.DeadLetterGetResponse]
+	deadLetterReplay *connect.Client[v1.DeadLetterReplayRequest, v1.DeadLetterReplayResponse]
+}
+
+// DeadLetterList calls warehouse_service.v1.DeadLetterService.DeadLetterList.
+func (c *deadLetterServiceClient) DeadLetterList(ctx context.Context, req *connect.Request[v1.DeadLetterListRequest]) (*connect.Response[v1.DeadLetterListResponse], error) {
+	return c.deadLetterList.CallUnary(ctx, req)
+}
+
+// DeadLetterGet calls warehouse_service.v1.DeadLetterService.DeadLetterGet.
+func (c *deadLetterServiceClient) DeadLetterGet(ctx context.Context, req *connect.Request[v1.DeadLetterGetRequest]) (*connect.Response[v1.DeadLetterGetResponse], error) {
+	return c.deadLetterGet.CallUnary(ctx, req)
+}
+
+// DeadLetterReplay calls warehouse_service.v1.DeadLetterService.DeadLetterReplay.
+func (c *deadLetterServiceClient) DeadLetterReplay(ctx context.Context, req *connect.Request[v1.DeadLetterReplayRequest]) (*connect.Response[v1.DeadLetterReplayResponse], error) {
+	return c.deadLetterReplay.CallUnary(ctx, req)
+}
+
+// DeadLetterServiceHandler is an implementation of the warehouse_service.v1.DeadLetterService
+// service.
+type DeadLetterServiceHandler interface {
+	DeadLetterList(context.Context, *connect.Request[v1.DeadLetterListRequest]) (*connect.Response[v1.DeadLetterListResponse], error)
+	DeadLetterGet(context.Context, *connect.Request[v1.DeadLetterGetRequest]) (*connect.Response[v1.DeadLetterGetResponse], error)
+	DeadLetterReplay(context.Context, *connect.Request[v1.DeadLetterReplayRequest]) (*connect.Response[v1.DeadLetterReplayResponse], error)
+}
+
+// NewDeadLetterServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewDeadLetterServiceHandler(svc DeadLetterServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	deadLetterServiceMethods := v1.File_warehouse_service_v1_dead_letter_proto.Services().ByName("DeadLetterService").Methods()
+	deadLetterServiceDeadLetterListHandler := connect.NewUnaryHandler(
+		DeadLetterServiceDeadLetterListProcedure,
+		svc.DeadLetterList,
+		connect.WithSchema(deadLetterServiceMethods.ByName("DeadLetterList")),
+		connect.WithHandlerOptions(opts...),
+	)
+	deadLetterServiceDeadLetterGetHandler := connect.NewUnaryHandler(
+		DeadLetterServiceDeadLetterGetProcedure,
+		svc.DeadLetterGet,
+		connect.WithSchema(deadLetterServiceMethods.ByName("DeadLetterGet")),
+		connect.WithHandlerOptions(opts...),
+	)
+	deadLetterServiceDeadLetterReplayHandler := connect.NewUnaryHandler(
+		DeadLetterServiceDeadLetterReplayProcedure,
+		svc.DeadLetterReplay,
+		connect.WithSchema(deadLetterServiceMethods.ByName("DeadLetterReplay")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/warehouse_service.v1.DeadLetterService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case DeadLetterServiceDeadLetterListProcedure:
+			deadLetterServiceDeadLetterListHandler.ServeHTTP(w, r)
+		case DeadLetterServiceDeadLetterGetProcedure:
+			deadLetterServiceDeadLetterGetHandler.ServeHTTP(w, r)
+		case DeadLetterServiceDeadLetterReplayProcedure:
+			deadLetterServiceDeadLetterReplayHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedDeadLetterServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedDeadLetterServiceHandler struct{}
+
+func (UnimplementedDeadLetterServiceHandler) DeadLetterList(context.Context, *connect.Request[v1.DeadLetterListRequest]) (*connect.Response[v1.DeadLetterListResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("warehouse_service.v1.DeadLetterService.DeadLetterList is not implemented"))
+}
+
+func (UnimplementedDeadLetterServiceHandler) DeadLetterGet(context.Context, *connect.Request[v1.DeadLetterGetRequest]) (*connect.Response[v1.DeadLetterGetResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("warehouse_service.v1.DeadLetterService.DeadLetterGet is not implemented"))
+}
+
+func (UnimplementedDeadLetterServiceHandler) DeadLetterReplay(context.Context, *connect.Request[v1.DeadLetterReplayRequest]) (*connect.Response[v1.DeadLetterReplayResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("warehouse_service.v1.DeadLetterService.DeadLetterReplay is not implemented"))
+}
@@ -0,0 +1,174 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: warehouse_service/v1/expense_attachment.proto
+
+package warehouse_service_ifaceconnect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/pdcgo/warehouse_service/services/warehouse_service/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// ExpenseAttachmentServiceName is the fully-qualified name of the ExpenseAttachmentService service.
+	ExpenseAttachmentServiceName = "warehouse_service.v1.ExpenseAttachmentService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// ExpenseAttachmentServiceExpenseAttachmentUploadProcedure is the fully-qualified name of the
+	// ExpenseAttachmentService's ExpenseAttachmentUpload RPC.
+	ExpenseAttachmentServiceExpenseAttachmentUploadProcedure = "/warehouse_service.v1.ExpenseAttachmentService/ExpenseAttachmentUpload"
+	// ExpenseAttachmentServiceExpenseAttachmentListProcedure is the fully-qualified name of the
+	// ExpenseAttachmentService's ExpenseAttachmentList RPC.
+	ExpenseAttachmentServiceExpenseAttachmentListProcedure = "/warehouse_service.v1.ExpenseAttachmentService/ExpenseAttachmentList"
+	// ExpenseAttachmentServiceExpenseAttachmentDownloadProcedure is the fully-qualified name of the
+	// ExpenseAttachmentService's ExpenseAttachmentDownload RPC.
+	ExpenseAttachmentServiceExpenseAttachmentDownloadProcedure = "/warehouse_service.v1.ExpenseAttachmentService/ExpenseAttachmentDownload"
+)
+
+// ExpenseAttachmentServiceClient is a client for the warehouse_service.v1.ExpenseAttachmentService
+// service.
+type ExpenseAttachmentServiceClient interface {
+	ExpenseAttachmentUpload(context.Context, *connect.Request[v1.ExpenseAttachmentUploadRequest]) (*connect.Response[v1.ExpenseAttachmentUploadResponse], error)
+	ExpenseAttachmentList(context.Context, *connect.Request[v1.ExpenseAttachmentListRequest]) (*connect.Response[v1.ExpenseAttachmentListResponse], error)
+	// first message carries attachment, content follow as chunk
+	ExpenseAttachmentDownload(context.Context, *connect.Request[v1.ExpenseAttachmentDownloadRequest]) (*connect.ServerStreamForClient[v1.ExpenseAttachmentDownloadResponse], error)
+}
+
+// NewExpenseAttachmentServiceClient constructs a client for the
+// warehouse_service.v1.ExpenseAttachmentService service. By default, it uses the Connect protocol
+// with the binary Protobuf Codec, asks for gzipped responses, and sends uncompressed requests. To
+// use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or connect.WithGRPCWeb()
+// options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewExpenseAttachmentServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ExpenseAttachmentServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	expenseAttachmentServiceMethods := v1.File_warehouse_service_v1_expense_attachment_proto.Services().ByName("ExpenseAttachmentService").Methods()
+	return &expenseAttachmentServiceClient{
+		expenseAttachmentUpload: connect.NewClient[v1.ExpenseAttachmentUploadRequest, v1.ExpenseAttachmentUploadResponse](
+			httpClient,
+			baseURL+ExpenseAttachmentServiceExpenseAttachmentUploadProcedure,
+			connect.WithSchema(expenseAttachmentServiceMethods.ByName("ExpenseAttachmentUpload")),
+			connect.WithClientOptions(opts...),
+		),
+		expenseAttachmentList: connect.NewClient[v1.ExpenseAttachmentListRequest, v1.ExpenseAttachmentListResponse](
+			httpClient,
+			baseURL+ExpenseAttachmentServiceExpenseAttachmentListProcedure,
+			connect.WithSchema(expenseAttachmentServiceMethods.ByName("ExpenseAttachmentList")),
+			connect.WithClientOptions(opts...),
+		),
+		expenseAttachmentDownload: connect.NewClient[v1.ExpenseAttachmentDownloadRequest, v1.ExpenseAttachmentDownloadResponse](
+			httpClient,
+			baseURL+ExpenseAttachmentServiceExpenseAttachmentDownloadProcedure,
+			connect.WithSchema(expenseAttachmentServiceMethods.ByName("ExpenseAttachmentDownload")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// expenseAttachmentServiceClient implements ExpenseAttachmentServiceClient.
+type expenseAttachmentServiceClient struct {
+	expenseAttachmentUpload   *connect.Client[v1.ExpenseAttachmentUploadRequest, v1.ExpenseAttachmentUploadResponse]
+	expenseAttachmentList     *connect.Client[v1.ExpenseAttachmentListRequest, v1.ExpenseAttachmentListResponse]
+	expenseAttachmentDownload *connect.Client[v1.ExpenseAttachmentDownloadRequest, v1.ExpenseAttachmentDownloadResponse]
+}
+
+// ExpenseAttachmentUpload calls
+// warehouse_service.v1.ExpenseAttachmentService.ExpenseAttachmentUpload.
+func (c *expenseAttachmentServiceClient) ExpenseAttachmentUpload(ctx context.Context, req *connect.Request[v1.ExpenseAttachmentUploadRequest]) (*connect.Response[v1.ExpenseAttachmentUploadResponse], error) {
+	return c.expenseAttachmentUpload.CallUnary(ctx, req)
+}
+
+// ExpenseAttachmentList calls warehouse_service.v1.ExpenseAttachmentService.ExpenseAttachmentList.
+func (c *expenseAttachmentServiceClient) ExpenseAttachmentList(ctx context.Context, req *connect.Request[v1.ExpenseAttachmentListRequest]) (*connect.Response[v1.ExpenseAttachmentListResponse], error) {
+	return c.expenseAttachmentList.CallUnary(ctx, req)
+}
+
+// ExpenseAttachmentDownload calls
+// warehouse_service.v1.ExpenseAttachmentService.ExpenseAttachmentDownload.
+func (c *expenseAttachmentServiceClient) ExpenseAttachmentDownload(ctx context.Context, req *connect.Request[v1.ExpenseAttachmentDownloadRequest]) (*connect.ServerStreamForClient[v1.ExpenseAttachmentDownloadResponse], error) {
+	return c.expenseAttachmentDownload.CallServerStream(ctx, req)
+}
+
+// ExpenseAttachmentServiceHandler is an implementation of the
+// warehouse_service.v1.ExpenseAttachmentService service.
+type ExpenseAttachmentServiceHandler interface {
+	ExpenseAttachmentUpload(context.Context, *connect.Request[v1.ExpenseAttachmentUploadRequest]) (*connect.Response[v1.ExpenseAttachmentUploadResponse], error)
+	ExpenseAttachmentList(context.Context, *connect.Request[v1.ExpenseAttachmentListRequest]) (*connect.Response[v1.ExpenseAttachmentListResponse], error)
+	// first message carries attachment, content follow as chunk
+	ExpenseAttachmentDownload(context.Context, *connect.Request[v1.ExpenseAttachmentDownloadRequest], *connect.ServerStream[v1.ExpenseAttachmentDownloadResponse]) error
+}
+
+// NewExpenseAttachmentServiceHandler builds an HTTP handler from the service implementation. It
+// returns the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewExpenseAttachmentServiceHandler(svc ExpenseAttachmentServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	expenseAttachmentServiceMethods := v1.File_warehouse_service_v1_expense_attachment_proto.Services().ByName("ExpenseAttachmentService").Methods()
+	expenseAttachmentServiceExpenseAttachmentUploadHandler := connect.NewUnaryHandler(
+		ExpenseAttachmentServiceExpenseAttachmentUploadProcedure,
+		svc.ExpenseAttachmentUpload,
+		connect.WithSchema(expenseAttachmentServiceMethods.ByName("ExpenseAttachmentUpload")),
+		connect.WithHandlerOptions(opts...),
+	)
+	expenseAttachmentServiceExpenseAttachmentListHandler := connect.NewUnaryHandler(
+		ExpenseAttachmentServiceExpenseAttachmentListProcedure,
+		svc.ExpenseAttachmentList,
+		connect.WithSchema(expenseAttachmentServiceMethods.ByName("ExpenseAttachmentList")),
+		connect.WithHandlerOptions(opts...),
+	)
+	expenseAttachmentServiceExpenseAttachmentDownloadHandler := connect.NewServerStreamHandler(
+		ExpenseAttachmentServiceExpenseAttachmentDownloadProcedure,
+		svc.ExpenseAttachmentDownload,
+		connect.WithSchema(expenseAttachmentServiceMethods.ByName("ExpenseAttachmentDownload")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/warehouse_service.v1.ExpenseAttachmentService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case ExpenseAttachmentServiceExpenseAttachmentUploadProcedure:
+			expenseAttachmentServiceExpenseAttachmentUploadHandler.ServeHTTP(w, r)
+		case ExpenseAttachmentServiceExpenseAttachmentListProcedure:
+			expenseAttachmentServiceExpenseAttachmentListHandler.ServeHTTP(w, r)
+		case ExpenseAttachmentServiceExpenseAttachmentDownloadProcedure:
+			expenseAttachmentServiceExpenseAttachmentDownloadHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedExpenseAttachmentServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedExpenseAttachmentServiceHandler struct{}
+
+func (UnimplementedExpenseAttachmentServiceHandler) ExpenseAttachmentUpload(context.Context, *connect.Request[v1.ExpenseAttachmentUploadRequest]) (*connect.Response[v1.ExpenseAttachmentUploadResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("warehouse_service.v1.ExpenseAttachmentService.ExpenseAttachmentUpload is not implemented"))
+}
+
+func (UnimplementedExpenseAttachmentServiceHandler) ExpenseAttachmentList(context.Context, *connect.Request[v1.ExpenseAttachmentListRequest]) (*connect.Response[v1.ExpenseAttachmentListResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("warehouse_service.v1.ExpenseAttachmentService.ExpenseAttachmentList is not implemented"))
+}
+
+func (UnimplementedExpenseAttachmentServiceHandler) ExpenseAttachmentDownload(context.Context, *connect.Request[v1.ExpenseAttachmentDownloadRequest], *connect.ServerStream[v1.ExpenseAttachmentDownloadResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("warehouse_service.v1.ExpenseAttachmentService.ExpenseAttachmentDownload is not implemented"))
+}
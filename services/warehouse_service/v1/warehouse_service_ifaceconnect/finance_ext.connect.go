@@ -0,0 +1,641 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: warehouse_service/v1/finance_ext.proto
+
+package warehouse_service_ifaceconnect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/pdcgo/warehouse_service/services/warehouse_service/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// WarehouseFinanceExtServiceName is the fully-qualified name of the WarehouseFinanceExtService
+	// service.
+	WarehouseFinanceExtServiceName = "warehouse_service.v1.WarehouseFinanceExtService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// WarehouseFinanceExtServiceExpenseReportDailyDetailProcedure is the fully-qualified name of the
+	// WarehouseFinanceExtService's ExpenseReportDailyDetail RPC.
+	WarehouseFinanceExtServiceExpenseReportDailyDetailProcedure = "/warehouse_service.v1.WarehouseFinanceExtService/ExpenseReportDailyDetail"
+	// WarehouseFinanceExtServiceExpenseAccountPageProcedure is the fully-qualified name of the
+	// WarehouseFinanceExtService's ExpenseAccountPage RPC.
+	WarehouseFinanceExtServiceExpenseAccountPageProcedure = "/warehouse_service.v1.WarehouseFinanceExtService/ExpenseAccountPage"
+	// WarehouseFinanceExtServiceExpenseAccountSetDisabledProcedure is the fully-qualified name of the
+	// WarehouseFinanceExtService's ExpenseAccountSetDisabled RPC.
+	WarehouseFinanceExtServiceExpenseAccountSetDisabledProcedure = "/warehouse_service.v1.WarehouseFinanceExtService/ExpenseAccountSetDisabled"
+	// WarehouseFinanceExtServiceExpenseHistoryPageProcedure is the fully-qualified name of the
+	// WarehouseFinanceExtService's ExpenseHistoryPage RPC.
+	WarehouseFinanceExtServiceExpenseHistoryPageProcedure = "/warehouse_service.v1.WarehouseFinanceExtService/ExpenseHistoryPage"
+	// WarehouseFinanceExtServiceExpenseHistoryReviewProcedure is the fully-qualified name of the
+	// WarehouseFinanceExtService's ExpenseHistoryReview RPC.
+	WarehouseFinanceExtServiceExpenseHistoryReviewProcedure = "/warehouse_service.v1.WarehouseFinanceExtService/ExpenseHistoryReview"
+	// WarehouseFinanceExtServiceExpenseHistoryRevisionProcedure is the fully-qualified name of the
+	// WarehouseFinanceExtService's ExpenseHistoryRevision RPC.
+	WarehouseFinanceExtServiceExpenseHistoryRevisionProcedure = "/warehouse_service.v1.WarehouseFinanceExtService/ExpenseHistoryRevision"
+	// WarehouseFinanceExtServiceExpenseHistoryDeleteProcedure is the fully-qualified name of the
+	// WarehouseFinanceExtService's ExpenseHistoryDelete RPC.
+	WarehouseFinanceExtServiceExpenseHistoryDeleteProcedure = "/warehouse_service.v1.WarehouseFinanceExtService/ExpenseHistoryDelete"
+	// WarehouseFinanceExtServiceExpenseHistoryRestoreProcedure is the fully-qualified name of the
+	// WarehouseFinanceExtService's ExpenseHistoryRestore RPC.
+	WarehouseFinanceExtServiceExpenseHistoryRestoreProcedure = "/warehouse_service.v1.WarehouseFinanceExtService/ExpenseHistoryRestore"
+	// WarehouseFinanceExtServiceExpenseTransferCreateProcedure is the fully-qualified name of the
+	// WarehouseFinanceExtService's ExpenseTransferCreate RPC.
+	WarehouseFinanceExtServiceExpenseTransferCreateProcedure = "/warehouse_service.v1.WarehouseFinanceExtService/ExpenseTransferCreate"
+	// WarehouseFinanceExtServiceBalanceHistoryCreateProcedure is the fully-qualified name of the
+	// WarehouseFinanceExtService's BalanceHistoryCreate RPC.
+	WarehouseFinanceExtServiceBalanceHistoryCreateProcedure = "/warehouse_service.v1.WarehouseFinanceExtService/BalanceHistoryCreate"
+	// WarehouseFinanceExtServiceBalanceHistoryUpdateProcedure is the fully-qualified name of the
+	// WarehouseFinanceExtService's BalanceHistoryUpdate RPC.
+	WarehouseFinanceExtServiceBalanceHistoryUpdateProcedure = "/warehouse_service.v1.WarehouseFinanceExtService/BalanceHistoryUpdate"
+	// WarehouseFinanceExtServiceBalanceHistoryDeleteProcedure is the fully-qualified name of the
+	// WarehouseFinanceExtService's BalanceHistoryDelete RPC.
+	WarehouseFinanceExtServiceBalanceHistoryDeleteProcedure = "/warehouse_service.v1.WarehouseFinanceExtService/BalanceHistoryDelete"
+	// WarehouseFinanceExtServiceBalanceHistoryListProcedure is the fully-qualified name of the
+	// WarehouseFinanceExtService's BalanceHistoryList RPC.
+	WarehouseFinanceExtServiceBalanceHistoryListProcedure = "/warehouse_service.v1.WarehouseFinanceExtService/BalanceHistoryList"
+	// WarehouseFinanceExtServiceAccountBalanceReconciliationProcedure is the fully-qualified name of
+	// the WarehouseFinanceExtService's AccountBalanceReconciliation RPC.
+	WarehouseFinanceExtServiceAccountBalanceReconciliationProcedure = "/warehouse_service.v1.WarehouseFinanceExtService/AccountBalanceReconciliation"
+	// WarehouseFinanceExtServiceExpenseTypeListProcedure is the fully-qualified name of the
+	// WarehouseFinanceExtService's ExpenseTypeList RPC.
+	WarehouseFinanceExtServiceExpenseTypeListProcedure = "/warehouse_service.v1.WarehouseFinanceExtService/ExpenseTypeList"
+	// WarehouseFinanceExtServiceExpenseTypeCreateProcedure is the fully-qualified name of the
+	// WarehouseFinanceExtService's ExpenseTypeCreate RPC.
+	WarehouseFinanceExtServiceExpenseTypeCreateProcedure = "/warehouse_service.v1.WarehouseFinanceExtService/ExpenseTypeCreate"
+	// WarehouseFinanceExtServiceExpenseTypeUpdateProcedure is the fully-qualified name of the
+	// WarehouseFinanceExtService's ExpenseTypeUpdate RPC.
+	WarehouseFinanceExtServiceExpenseTypeUpdateProcedure = "/warehouse_service.v1.WarehouseFinanceExtService/ExpenseTypeUpdate"
+	// WarehouseFinanceExtServiceExpenseBudgetSetProcedure is the fully-qualified name of the
+	// WarehouseFinanceExtService's ExpenseBudgetSet RPC.
+	WarehouseFinanceExtServiceExpenseBudgetSetProcedure = "/warehouse_service.v1.WarehouseFinanceExtService/ExpenseBudgetSet"
+	// WarehouseFinanceExtServiceExpenseBudgetReportProcedure is the fully-qualified name of the
+	// WarehouseFinanceExtService's ExpenseBudgetReport RPC.
+	WarehouseFinanceExtServiceExpenseBudgetReportProcedure = "/warehouse_service.v1.WarehouseFinanceExtService/ExpenseBudgetReport"
+)
+
+// WarehouseFinanceExtServiceClient is a client for the
+// warehouse_service.v1.WarehouseFinanceExtService service.
+type WarehouseFinanceExtServiceClient interface {
+	ExpenseReportDailyDetail(context.Context, *connect.Request[v1.ExpenseReportDailyDetailRequest]) (*connect.Response[v1.ExpenseReportDailyDetailResponse], error)
+	ExpenseAccountPage(context.Context, *connect.Request[v1.ExpenseAccountPageRequest]) (*connect.Response[v1.ExpenseAccountPageResponse], error)
+	ExpenseAccountSetDisabled(context.Context, *connect.Request[v1.ExpenseAccountSetDisabledRequest]) (*connect.Response[v1.ExpenseAccountSetDisabledResponse], error)
+	ExpenseHistoryPage(context.Context, *connect.Request[v1.ExpenseHistoryPageRequest]) (*connect.Response[v1.ExpenseHistoryPageResponse], error)
+	ExpenseHistoryReview(context.Context, *connect.Request[v1.ExpenseHistoryReviewRequest]) (*connect.Response[v1.ExpenseHistoryReviewResponse], error)
+	ExpenseHistoryRevision(context.Context, *connect.Request[v1.ExpenseHistoryRevisionRequest]) (*connect.Response[v1.ExpenseHistoryRevisionResponse], error)
+	ExpenseHistoryDelete(context.Context, *connect.Request[v1.ExpenseHistoryDeleteRequest]) (*connect.Response[v1.ExpenseHistoryDeleteResponse], error)
+	ExpenseHistoryRestore(context.Context, *connect.Request[v1.ExpenseHistoryRestoreRequest]) (*connect.Response[v1.ExpenseHistoryRestoreResponse], error)
+	ExpenseTransferCreate(context.Context, *connect.Request[v1.ExpenseTransferCreateRequest]) (*connect.Response[v1.ExpenseTransferCreateResponse], error)
+	BalanceHistoryCreate(context.Context, *connect.Request[v1.BalanceHistoryCreateRequest]) (*connect.Response[v1.BalanceHistoryCreateResponse], error)
+	BalanceHistoryUpdate(context.Context, *connect.Request[v1.BalanceHistoryUpdateRequest]) (*connect.Response[v1.BalanceHistoryUpdateResponse], error)
+	BalanceHistoryDelete(context.Context, *connect.Request[v1.BalanceHistoryDeleteRequest]) (*connect.Response[v1.BalanceHistoryDeleteResponse], error)
+	BalanceHistoryList(context.Context, *connect.Request[v1.BalanceHistoryListRequest]) (*connect.Response[v1.BalanceHistoryListResponse], error)
+	AccountBalanceReconciliation(context.Context, *connect.Request[v1.AccountBalanceReconciliationRequest]) (*connect.Response[v1.AccountBalanceReconciliationResponse], error)
+	ExpenseTypeList(context.Context, *connect.Request[v1.ExpenseTypeListRequest]) (*connect.Response[v1.ExpenseTypeListResponse], error)
+	ExpenseTypeCreate(context.Context, *connect.Request[v1.ExpenseTypeCreateRequest]) (*connect.Response[v1.ExpenseTypeCreateResponse], error)
+	ExpenseTypeUpdate(context.Context, *connect.Request[v1.ExpenseTypeUpdateRequest]) (*connect.Response[v1.ExpenseTypeUpdateResponse], error)
+	ExpenseBudgetSet(context.Context, *connect.Request[v1.ExpenseBudgetSetRequest]) (*connect.Response[v1.ExpenseBudgetSetResponse], error)
+	ExpenseBudgetReport(context.Context, *connect.Request[v1.ExpenseBudgetReportRequest]) (*connect.Response[v1.ExpenseBudgetReportResponse], error)
+}
+
+// NewWarehouseFinanceExtServiceClient constructs a client for the
+// warehouse_service.v1.WarehouseFinanceExtService service. By default, it uses the Connect protocol
+// with the binary Protobuf Codec, asks for gzipped responses, and sends uncompressed requests. To
+// use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or connect.WithGRPCWeb()
+// options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewWarehouseFinanceExtServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) WarehouseFinanceExtServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	warehouseFinanceExtServiceMethods := v1.File_warehouse_service_v1_finance_ext_proto.Services().ByName("WarehouseFinanceExtService").Methods()
+	return &warehouseFinanceExtServiceClient{
+		expenseReportDailyDetail: connect.NewClient[v1.ExpenseReportDailyDetailRequest, v1.ExpenseReportDailyDetailResponse](
+			httpClient,
+			baseURL+WarehouseFinanceExtServiceExpenseReportDailyDetailProcedure,
+			connect.WithSchema(warehouseFinanceExtServiceMethods.ByName("ExpenseReportDailyDetail")),
+			connect.WithClientOptions(opts...),
+		),
+		expenseAccountPage: connect.NewClient[v1.ExpenseAccountPageRequest, v1.ExpenseAccountPageResponse](
+			httpClient,
+			baseURL+WarehouseFinanceExtServiceExpenseAccountPageProcedure,
+			connect.WithSchema(warehouseFinanceExtServiceMethods.ByName("ExpenseAccountPage")),
+			connect.WithClientOptions(opts...),
+		),
+		expenseAccountSetDisabled: connect.NewClient[v1.ExpenseAccountSetDisabledRequest, v1.ExpenseAccountSetDisabledResponse](
+			httpClient,
+			baseURL+WarehouseFinanceExtServiceExpenseAccountSetDisabledProcedure,
+			connect.WithSchema(warehouseFinanceExtServiceMethods.ByName("ExpenseAccountSetDisabled")),
+			connect.WithClientOptions(opts...),
+		),
+		expenseHistoryPage: connect.NewClient[v1.ExpenseHistoryPageRequest, v1.ExpenseHistoryPageResponse](
+			httpClient,
+			baseURL+WarehouseFinanceExtServiceExpenseHistoryPageProcedure,
+			connect.WithSchema(warehouseFinanceExtServiceMethods.ByName("ExpenseHistoryPage")),
+			connect.WithClientOptions(opts...),
+		),
+		expenseHistoryReview: connect.NewClient[v1.ExpenseHistoryReviewRequest, v1.ExpenseHistoryReviewResponse](
+			httpClient,
+			baseURL+WarehouseFinanceExtServiceExpenseHistoryReviewProcedure,
+			connect.WithSchema(warehouseFinanceExtServiceMethods.ByName("ExpenseHistoryReview")),
+			connect.WithClientOptions(opts...),
+		),
+		expenseHistoryRevision: connect.NewClient[v1.ExpenseHistoryRevisionRequest, v1.ExpenseHistoryRevisionResponse](
+			httpClient,
+			baseURL+WarehouseFinanceExtServiceExpenseHistoryRevisionProcedure,
+			connect.WithSchema(warehouseFinanceExtServiceMethods.ByName("ExpenseHistoryRevision")),
+			connect.WithClientOptions(opts...),
+		),
+		expenseHistoryDelete: connect.NewClient[v1.ExpenseHistoryDeleteRequest, v1.ExpenseHistoryDeleteResponse](
+			httpClient,
+			baseURL+WarehouseFinanceExtServiceExpenseHistoryDeleteProcedure,
+			connect.WithSchema(warehouseFinanceExtServiceMethods.ByName("ExpenseHistoryDelete")),
+			connect.WithClientOptions(opts...),
+		),
+		expenseHistoryRestore: connect.NewClient[v1.ExpenseHistoryRestoreRequest, v1.ExpenseHistoryRestoreResponse](
+			httpClient,
+			baseURL+WarehouseFinanceExtServiceExpenseHistoryRestoreProcedure,
+			connect.WithSchema(warehouseFinanceExtServiceMethods.ByName("ExpenseHistoryRestore")),
+			connect.WithClientOptions(opts...),
+		),
+		expenseTransferCreate: connect.NewClient[v1.ExpenseTransferCreateRequest, v1.ExpenseTransferCreateResponse](
+			httpClient,
+			baseURL+WarehouseFinanceExtServiceExpenseTransferCreateProcedure,
+			connect.WithSchema(warehouseFinanceExtServiceMethods.ByName("ExpenseTransferCreate")),
+			connect.WithClientOptions(opts...),
+		),
+		balanceHistoryCreate: connect.NewClient[v1.BalanceHistoryCreateRequest, v1.BalanceHistoryCreateResponse](
+			httpClient,
+			baseURL+WarehouseFinanceExtServiceBalanceHistoryCreateProcedure,
+			connect.WithSchema(warehouseFinanceExtServiceMethods.ByName("BalanceHistoryCreate")),
+			connect.WithClientOptions(opts...),
+		),
+		balanceHistoryUpdate: connect.NewClient[v1.BalanceHistoryUpdateRequest, v1.BalanceHistoryUpdateResponse](
+			httpClient,
+			baseURL+WarehouseFinanceExtServiceBalanceHistoryUpdateProcedure,
+			connect.WithSchema(warehouseFinanceExtServiceMethods.ByName("BalanceHistoryUpdate")),
+			connect.WithClientOptions(opts...),
+		),
+		balanceHistoryDelete: connect.NewClient[v1.BalanceHistoryDeleteRequest, v1.BalanceHistoryDeleteResponse](
+			httpClient,
+			baseURL+WarehouseFinanceExtServiceBalanceHistoryDeleteProcedure,
+			connect.WithSchema(warehouseFinanceExtServiceMethods.ByName("BalanceHistoryDelete")),
+			connect.WithClientOptions(opts...),
+		),
+		balanceHistoryList: connect.NewClient[v1.BalanceHistoryListRequest, v1.BalanceHistoryListResponse](
+			httpClient,
+			baseURL+WarehouseFinanceExtServiceBalanceHistoryListProcedure,
+			connect.WithSchema(warehouseFinanceExtServiceMethods.ByName("BalanceHistoryList")),
+			connect.WithClientOptions(opts...),
+		),
+		accountBalanceReconciliation: connect.NewClient[v1.AccountBalanceReconciliationRequest, v1.AccountBalanceReconciliationResponse](
+			httpClient,
+			baseURL+WarehouseFinanceExtServiceAccountBalanceReconciliationProcedure,
+			connect.WithSchema(warehouseFinanceExtServiceMethods.ByName("AccountBalanceReconciliation")),
+			connect.WithClientOptions(opts...),
+		),
+		expenseTypeList: connect.NewClient[v1.ExpenseTypeListRequest, v1.ExpenseTypeListResponse](
+			httpClient,
+			baseURL+WarehouseFinanceExtServiceExpenseTypeListProcedure,
+			connect.WithSchema(warehouseFinanceExtServiceMethods.ByName("ExpenseTypeList")),
+			connect.WithClientOptions(opts...),
+		),
+		expenseTypeCreate: connect.NewClient[v1.ExpenseTypeCreateRequest, v1.ExpenseTypeCreateResponse](
+			httpClient,
+			baseURL+WarehouseFinanceExtServiceExpenseTypeCreateProcedure,
+			connect.WithSchema(warehouseFinanceExtServiceMethods.ByName("ExpenseTypeCreate")),
+			connect.WithClientOptions(opts...),
+		),
+		expenseTypeUpdate: connect.NewClient[v1.ExpenseTypeUpdateRequest, v1.ExpenseTypeUpdateResponse](
+			httpClient,
+			baseURL+WarehouseFinanceExtServiceExpenseTypeUpdateProcedure,
+			connect.WithSchema(warehouseFinanceExtServiceMethods.ByName("ExpenseTypeUpdate")),
+			connect.WithClientOptions(opts...),
+		),
+		expenseBudgetSet: connect.NewClient[v1.ExpenseBudgetSetRequest, v1.ExpenseBudgetSetResponse](
+			httpClient,
+			baseURL+WarehouseFinanceExtServiceExpenseBudgetSetProcedure,
+			connect.WithSchema(warehouseFinanceExtServiceMethods.ByName("ExpenseBudgetSet")),
+			connect.WithClientOptions(opts...),
+		),
+		expenseBudgetReport: connect.NewClient[v1.ExpenseBudgetReportRequest, v1.ExpenseBudgetReportResponse](
+			httpClient,
+			baseURL+WarehouseFinanceExtServiceExpenseBudgetReportProcedure,
+			connect.WithSchema(warehouseFinanceExtServiceMethods.ByName("ExpenseBudgetReport")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// warehouseFinanceExtServiceClient implements WarehouseFinanceExtServiceClient.
+type warehouseFinanceExtServiceClient struct {
+	expenseReportDailyDetail     *connect.Client[v1.ExpenseReportDailyDetailRequest, v1.ExpenseReportDailyDetailResponse]
+	expenseAccountPage           *connect.Client[v1.ExpenseAccountPageRequest, v1.ExpenseAccountPageResponse]
+	expenseAccountSetDisabled    *connect.Client[v1.ExpenseAccountSetDisabledRequest, v1.ExpenseAccountSetDisabledResponse]
+	expenseHistoryPage           *connect.Client[v1.ExpenseHistoryPageRequest, v1.ExpenseHistoryPageResponse]
+	expenseHistoryReview         *connect.Client[v1.ExpenseHistoryReviewRequest, v1.ExpenseHistoryReviewResponse]
+	expenseHistoryRevision       *connect.Client[v1.ExpenseHistoryRevisionRequest, v1.ExpenseHistoryRevisionResponse]
+	expenseHistoryDelete         *connect.Client[v1.ExpenseHistoryDeleteRequest, v1.ExpenseHistoryDeleteResponse]
+	expenseHistoryRestore        *connect.Client[v1.ExpenseHistoryRestoreRequest, v1.ExpenseHistoryRestoreResponse]
+	expenseTransferCreate        *connect.Client[v1.ExpenseTransferCreateRequest, v1.ExpenseTransferCreateResponse]
+	balanceHistoryCreate         *connect.Client[v1.BalanceHistoryCreateRequest, v1.BalanceHistoryCreateResponse]
+	balanceHistoryUpdate         *connect.Client[v1.BalanceHistoryUpdateRequest, v1.BalanceHistoryUpdateResponse]
+	balanceHistoryDelete         *connect.Client[v1.BalanceHistoryDeleteRequest, v1.BalanceHistoryDeleteResponse]
+	balanceHistoryList           *connect.Client[v1.BalanceHistoryListRequest, v1.BalanceHistoryListResponse]
+	accountBalanceReconciliation *connect.Client[v1.AccountBalanceReconciliationRequest, v1.AccountBalanceReconciliationResponse]
+	expenseTypeList              *connect.Client[v1.ExpenseTypeListRequest, v1.ExpenseTypeListResponse]
+	expenseTypeCreate            *connect.Client[v1.ExpenseTypeCreateRequest, v1.ExpenseTypeCreateResponse]
+	expenseTypeUpdate            *connect.Client[v1.ExpenseTypeUpdateRequest, v1.ExpenseTypeUpdateResponse]
+	expenseBudgetSet             *connect.Client[v1.ExpenseBudgetSetRequest, v1.ExpenseBudgetSetResponse]
+	expenseBudgetReport          *connect.Client[v1.ExpenseBudgetReportRequest, v1.ExpenseBudgetReportResponse]
+}
+
+// ExpenseReportDailyDetail calls
+// warehouse_service.v1.WarehouseFinanceExtService.ExpenseReportDailyDetail.
+func (c *warehouseFinanceExtServiceClient) ExpenseReportDailyDetail(ctx context.Context, req *connect.Request[v1.ExpenseReportDailyDetailRequest]) (*connect.Response[v1.ExpenseReportDailyDetailResponse], error) {
+	return c.expenseReportDailyDetail.CallUnary(ctx, req)
+}
+
+// ExpenseAccountPage calls warehouse_service.v1.WarehouseFinanceExtService.ExpenseAccountPage.
+func (c *warehouseFinanceExtServiceClient) ExpenseAccountPage(ctx context.Context, req *connect.Request[v1.ExpenseAccountPageRequest]) (*connect.Response[v1.ExpenseAccountPageResponse], error) {
+	return c.expenseAccountPage.CallUnary(ctx, req)
+}
+
+// ExpenseAccountSetDisabled calls
+// warehouse_service.v1.WarehouseFinanceExtService.ExpenseAccountSetDisabled.
+func (c *warehouseFinanceExtServiceClient) ExpenseAccountSetDisabled(ctx context.Context, req *connect.Request[v1.ExpenseAccountSetDisabledRequest]) (*connect.Response[v1.ExpenseAccountSetDisabledResponse], error) {
+	return c.expenseAccountSetDisabled.CallUnary(ctx, req)
+}
+
+// ExpenseHistoryPage calls warehouse_service.v1.WarehouseFinanceExtService.ExpenseHistoryPage.
+func (c *warehouseFinanceExtServiceClient) ExpenseHistoryPage(ctx context.Context, req *connect.Request[v1.ExpenseHistoryPageRequest]) (*connect.Response[v1.ExpenseHistoryPageResponse], error) {
+	return c.expenseHistoryPage.CallUnary(ctx, req)
+}
+
+// ExpenseHistoryReview calls warehouse_service.v1.WarehouseFinanceExtService.ExpenseHistoryReview.
+func (c *warehouseFinanceExtServiceClient) ExpenseHistoryReview(ctx context.Context, req *connect.Request[v1.ExpenseHistoryReviewRequest]) (*connect.Response[v1.ExpenseHistoryReviewResponse], error) {
+	return c.expenseHistoryReview.CallUnary(ctx, req)
+}
+
+// ExpenseHistoryRevision calls
+// warehouse_service.v1.WarehouseFinanceExtService.ExpenseHistoryRevision.
+func (c *warehouseFinanceExtServiceClient) ExpenseHistoryRevision(ctx context.Context, req *connect.Request[v1.ExpenseHistoryRevisionRequest]) (*connect.Response[v1.ExpenseHistoryRevisionResponse], error) {
+	return c.expenseHistoryRevision.CallUnary(ctx, req)
+}
+
+// ExpenseHistoryDelete calls warehouse_service.v1.WarehouseFinanceExtService.ExpenseHistoryDelete.
+func (c *warehouseFinanceExtServiceClient) ExpenseHistoryDelete(ctx context.Context, req *connect.Request[v1.ExpenseHistoryDeleteRequest]) (*connect.Response[v1.ExpenseHistoryDeleteResponse], error) {
+	return c.expenseHistoryDelete.CallUnary(ctx, req)
+}
+
+// ExpenseHistoryRestore calls
+// warehouse_service.v1.WarehouseFinanceExtService.ExpenseHistoryRestore.
+func (c *warehouseFinanceExtServiceClient) ExpenseHistoryRestore(ctx context.Context, req *connect.Request[v1.ExpenseHistoryRestoreRequest]) (*connect.Response[v1.ExpenseHistoryRestoreResponse], error) {
+	return c.expenseHistoryRestore.CallUnary(ctx, req)
+}
+
+// ExpenseTransferCreate calls
+// warehouse_service.v1.WarehouseFinanceExtService.ExpenseTransferCreate.
+func (c *warehouseFinanceExtServiceClient) ExpenseTransferCreate(ctx context.Context, req *connect.Request[v1.ExpenseTransferCreateRequest]) (*connect.Response[v1.ExpenseTransferCreateResponse], error) {
+	return c.expenseTransferCreate.CallUnary(ctx, req)
+}
+
+// BalanceHistoryCreate calls warehouse_service.v1.WarehouseFinanceExtService.BalanceHistoryCreate.
+func (c *warehouseFinanceExtServiceClient) BalanceHistoryCreate(ctx context.Context, req *connect.Request[v1.BalanceHistoryCreateRequest]) (*connect.Response[v1.BalanceHistoryCreateResponse], error) {
+	return c.balanceHistoryCreate.CallUnary(ctx, req)
+}
+
+// BalanceHistoryUpdate calls warehouse_service.v1.WarehouseFinanceExtService.BalanceHistoryUpdate.
+func (c *warehouseFinanceExtServiceClient) BalanceHistoryUpdate(ctx context.Context, req *connect.Request[v1.BalanceHistoryUpdateRequest]) (*connect.Response[v1.BalanceHistoryUpdateResponse], error) {
+	return c.balanceHistoryUpdate.CallUnary(ctx, req)
+}
+
+// BalanceHistoryDelete calls warehouse_service.v1.WarehouseFinanceExtService.BalanceHistoryDelete.
+func (c *warehouseFinanceExtServiceClient) BalanceHistoryDelete(ctx context.Context, req *connect.Request[v1.BalanceHistoryDeleteRequest]) (*connect.Response[v1.BalanceHistoryDeleteResponse], error) {
+	return c.balanceHistoryDelete.CallUnary(ctx, req)
+}
+
+// BalanceHistoryList calls warehouse_service.v1.WarehouseFinanceExtService.BalanceHistoryList.
+func (c *warehouseFinanceExtServiceClient) BalanceHistoryList(ctx context.Context, req *connect.Request[v1.BalanceHistoryListRequest]) (*connect.Response[v1.BalanceHistoryListResponse], error) {
+	return c.balanceHistoryList.CallUnary(ctx, req)
+}
+
+// AccountBalanceReconciliation calls
+// warehouse_service.v1.WarehouseFinanceExtService.AccountBalanceReconciliation.
+func (c *warehouseFinanceExtServiceClient) AccountBalanceReconciliation(ctx context.Context, req *connect.Request[v1.AccountBalanceReconciliationRequest]) (*connect.Response[v1.AccountBalanceReconciliationResponse], error) {
+	return c.accountBalanceReconciliation.CallUnary(ctx, req)
+}
+
+// ExpenseTypeList calls warehouse_service.v1.WarehouseFinanceExtService.ExpenseTypeList.
+func (c *warehouseFinanceExtServiceClient) ExpenseTypeList(ctx context.Context, req *connect.Request[v1.ExpenseTypeListRequest]) (*connect.Response[v1.ExpenseTypeListResponse], error) {
+	return c.expenseTypeList.CallUnary(ctx, req)
+}
+
+// ExpenseTypeCreate calls warehouse_service.v1.WarehouseFinanceExtService.ExpenseTypeCreate.
+func (c *warehouseFinanceExtServiceClient) ExpenseTypeCreate(ctx context.Context, req *connect.Request[v1.ExpenseTypeCreateRequest]) (*connect.Response[v1.ExpenseTypeCreateResponse], error) {
+	return c.expenseTypeCreate.CallUnary(ctx, req)
+}
+
+// ExpenseTypeUpdate calls warehouse_service.v1.WarehouseFinanceExtService.ExpenseTypeUpdate.
+func (c *warehouseFinanceExtServiceClient) ExpenseTypeUpdate(ctx context.Context, req *connect.Request[v1.ExpenseTypeUpdateRequest]) (*connect.Response[v1.ExpenseTypeUpdateResponse], error) {
+	return c.expenseTypeUpdate.CallUnary(ctx, req)
+}
+
+// ExpenseBudgetSet calls warehouse_service.v1.WarehouseFinanceExtService.ExpenseBudgetSet.
+func (c *warehouseFinanceExtServiceClient) ExpenseBudgetSet(ctx context.Context, req *connect.Request[v1.ExpenseBudgetSetRequest]) (*connect.Response[v1.ExpenseBudgetSetResponse], error) {
+	return c.expenseBudgetSet.CallUnary(ctx, req)
+}
+
+// ExpenseBudgetReport calls warehouse_service.v1.WarehouseFinanceExtService.ExpenseBudgetReport.
+func (c *warehouseFinanceExtServiceClient) ExpenseBudgetReport(ctx context.Context, req *connect.Request[v1.ExpenseBudgetReportRequest]) (*connect.Response[v1.ExpenseBudgetReportResponse], error) {
+	return c.expenseBudgetReport.CallUnary(ctx, req)
+}
+
+// WarehouseFinanceExtServiceHandler is an implementation of the
+// warehouse_service.v1.WarehouseFinanceExtService service.
+type WarehouseFinanceExtServiceHandler interface {
+	ExpenseReportDailyDetail(context.Context, *connect.Request[v1.ExpenseReportDailyDetailRequest]) (*connect.Response[v1.ExpenseReportDailyDetailResponse], error)
+	ExpenseAccountPage(context.Context, *connect.Request[v1.ExpenseAccountPageRequest]) (*connect.Response[v1.ExpenseAccountPageResponse], error)
+	ExpenseAccountSetDisabled(context.Context, *connect.Request[v1.ExpenseAccountSetDisabledRequest]) (*connect.Response[v1.ExpenseAccountSetDisabledResponse], error)
+	ExpenseHistoryPage(context.Context, *connect.Request[v1.ExpenseHistoryPageRequest]) (*connect.Response[v1.ExpenseHistoryPageResponse], error)
+	ExpenseHistoryReview(context.Context, *connect.Request[v1.ExpenseHistoryReviewRequest]) (*connect.Response[v1.ExpenseHistoryReviewResponse], error)
+	ExpenseHistoryRevision(context.Context, *connect.Request[v1.ExpenseHistoryRevisionRequest]) (*connect.Response[v1.ExpenseHistoryRevisionResponse], error)
+	ExpenseHistoryDelete(context.Context, *connect.Request[v1.ExpenseHistoryDeleteRequest]) (*connect.Response[v1.ExpenseHistoryDeleteResponse], error)
+	ExpenseHistoryRestore(context.Context, *connect.Request[v1.ExpenseHistoryRestoreRequest]) (*connect.Response[v1.ExpenseHistoryRestoreResponse], error)
+	ExpenseTransferCreate(context.Context, *connect.Request[v1.ExpenseTransferCreateRequest]) (*connect.Response[v1.ExpenseTransferCreateResponse], error)
+	BalanceHistoryCreate(context.Context, *connect.Request[v1.BalanceHistoryCreateRequest]) (*connect.Response[v1.BalanceHistoryCreateResponse], error)
+	BalanceHistoryUpdate(context.Context, *connect.Request[v1.BalanceHistoryUpdateRequest]) (*connect.Response[v1.BalanceHistoryUpdateResponse], error)
+	BalanceHistoryDelete(context.Context, *connect.Request[v1.BalanceHistoryDeleteRequest]) (*connect.Response[v1.BalanceHistoryDeleteResponse], error)
+	BalanceHistoryList(context.Context, *connect.Request[v1.BalanceHistoryListRequest]) (*connect.Response[v1.BalanceHistoryListResponse], error)
+	AccountBalanceReconciliation(context.Context, *connect.Request[v1.AccountBalanceReconciliationRequest]) (*connect.Response[v1.AccountBalanceReconciliationResponse], error)
+	ExpenseTypeList(context.Context, *connect.Request[v1.ExpenseTypeListRequest]) (*connect.Response[v1.ExpenseTypeListResponse], error)
+	ExpenseTypeCreate(context.Context, *connect.Request[v1.ExpenseTypeCreateRequest]) (*connect.Response[v1.ExpenseTypeCreateResponse], error)
+	ExpenseTypeUpdate(context.Context, *connect.Request[v1.ExpenseTypeUpdateRequest]) (*connect.Response[v1.ExpenseTypeUpdateResponse], error)
+	ExpenseBudgetSet(context.Context, *connect.Request[v1.ExpenseBudgetSetRequest]) (*connect.Response[v1.ExpenseBudgetSetResponse], error)
+	ExpenseBudgetReport(context.Context, *connect.Request[v1.ExpenseBudgetReportRequest]) (*connect.Response[v1.ExpenseBudgetReportResponse], error)
+}
+
+// NewWarehouseFinanceExtServiceHandler builds an HTTP handler from the service implementation. It
+// returns the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewWarehouseFinanceExtServiceHandler(svc WarehouseFinanceExtServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	warehouseFinanceExtServiceMethods := v1.File_warehouse_service_v1_finance_ext_proto.Services().ByName("WarehouseFinanceExtService").Methods()
+	warehouseFinanceExtServiceExpenseReportDailyDetailHandler := connect.NewUnaryHandler(
+		WarehouseFinanceExtServiceExpenseReportDailyDetailProcedure,
+		svc.ExpenseReportDailyDetail,
+		connect.WithSchema(warehouseFinanceExtServiceMethods.ByName("ExpenseReportDailyDetail")),
+		connect.WithHandlerOptions(opts...),
+	)
+	warehouseFinanceExtServiceExpenseAccountPageHandler := connect.NewUnaryHandler(
+		WarehouseFinanceExtServiceExpenseAccountPageProcedure,
+		svc.ExpenseAccountPage,
+		connect.WithSchema(warehouseFinanceExtServiceMethods.ByName("ExpenseAccountPage")),
+		connect.WithHandlerOptions(opts...),
+	)
+	warehouseFinanceExtServiceExpenseAccountSetDisabledHandler := connect.NewUnaryHandler(
+		WarehouseFinanceExtServiceExpenseAccountSetDisabledProcedure,
+		svc.ExpenseAccountSetDisabled,
+		connect.WithSchema(warehouseFinanceExtServiceMethods.ByName("ExpenseAccountSetDisabled")),
+		connect.WithHandlerOptions(opts...),
+	)
+	warehouseFinanceExtServiceExpenseHistoryPageHandler := connect.NewUnaryHandler(
+		WarehouseFinanceExtServiceExpenseHistoryPageProcedure,
+		svc.ExpenseHistoryPage,
+		connect.WithSchema(warehouseFinanceExtServiceMethods.ByName("ExpenseHistoryPage")),
+		connect.WithHandlerOptions(opts...),
+	)
+	warehouseFinanceExtServiceExpenseHistoryReviewHandler := connect.NewUnaryHandler(
+		WarehouseFinanceExtServiceExpenseHistoryReviewProcedure,
+		svc.ExpenseHistoryReview,
+		connect.WithSchema(warehouseFinanceExtServiceMethods.ByName("ExpenseHistoryReview")),
+		connect.WithHandlerOptions(opts...),
+	)
+	warehouseFinanceExtServiceExpenseHistoryRevisionHandler := connect.NewUnaryHandler(
+		WarehouseFinanceExtServiceExpenseHistoryRevisionProcedure,
+		svc.ExpenseHistoryRevision,
+		connect.WithSchema(warehouseFinanceExtServiceMethods.ByName("ExpenseHistoryRevision")),
+		connect.WithHandlerOptions(opts...),
+	)
+	warehouseFinanceExtServiceExpenseHistoryDeleteHandler := connect.NewUnaryHandler(
+		WarehouseFinanceExtServiceExpenseHistoryDeleteProcedure,
+		svc.ExpenseHistoryDelete,
+		connect.WithSchema(warehouseFinanceExtServiceMethods.ByName("ExpenseHistoryDelete")),
+		connect.WithHandlerOptions(opts...),
+	)
+	warehouseFinanceExtServiceExpenseHistoryRestoreHandler := connect.NewUnaryHandler(
+		WarehouseFinanceExtServiceExpenseHistoryRestoreProcedure,
+		svc.ExpenseHistoryRestore,
+		connect.WithSchema(warehouseFinanceExtServiceMethods.ByName("ExpenseHistoryRestore")),
+		connect.WithHandlerOptions(opts...),
+	)
+	warehouseFinanceExtServiceExpenseTransferCreateHandler := connect.NewUnaryHandler(
+		WarehouseFinanceExtServiceExpenseTransferCreateProcedure,
+		svc.ExpenseTransferCreate,
+		connect.WithSchema(warehouseFinanceExtServiceMethods.ByName("ExpenseTransferCreate")),
+		connect.WithHandlerOptions(opts...),
+	)
+	warehouseFinanceExtServiceBalanceHistoryCreateHandler := connect.NewUnaryHandler(
+		WarehouseFinanceExtServiceBalanceHistoryCreateProcedure,
+		svc.BalanceHistoryCreate,
+		connect.WithSchema(warehouseFinanceExtServiceMethods.ByName("BalanceHistoryCreate")),
+		connect.WithHandlerOptions(opts...),
+	)
+	warehouseFinanceExtServiceBalanceHistoryUpdateHandler := connect.NewUnaryHandler(
+		WarehouseFinanceExtServiceBalanceHistoryUpdateProcedure,
+		svc.BalanceHistoryUpdate,
+		connect.WithSchema(warehouseFinanceExtServiceMethods.ByName("BalanceHistoryUpdate")),
+		connect.WithHandlerOptions(opts...),
+	)
+	warehouseFinanceExtServiceBalanceHistoryDeleteHandler := connect.NewUnaryHandler(
+		WarehouseFinanceExtServiceBalanceHistoryDeleteProcedure,
+		svc.BalanceHistoryDelete,
+		connect.WithSchema(warehouseFinanceExtServiceMethods.ByName("BalanceHistoryDelete")),
+		connect.WithHandlerOptions(opts...),
+	)
+	warehouseFinanceExtServiceBalanceHistoryListHandler := connect.NewUnaryHandler(
+		WarehouseFinanceExtServiceBalanceHistoryListProcedure,
+		svc.BalanceHistoryList,
+		connect.WithSchema(warehouseFinanceExtServiceMethods.ByName("BalanceHistoryList")),
+		connect.WithHandlerOptions(opts...),
+	)
+	warehouseFinanceExtServiceAccountBalanceReconciliationHandler := connect.NewUnaryHandler(
+		WarehouseFinanceExtServiceAccountBalanceReconciliationProcedure,
+		svc.AccountBalanceReconciliation,
+		connect.WithSchema(warehouseFinanceExtServiceMethods.ByName("AccountBalanceReconciliation")),
+		connect.WithHandlerOptions(opts...),
+	)
+	warehouseFinanceExtServiceExpenseTypeListHandler := connect.NewUnaryHandler(
+		WarehouseFinanceExtServiceExpenseTypeListProcedure,
+		svc.ExpenseTypeList,
+		connect.WithSchema(warehouseFinanceExtServiceMethods.ByName("ExpenseTypeList")),
+		connect.WithHandlerOptions(opts...),
+	)
+	warehouseFinanceExtServiceExpenseTypeCreateHandler := connect.NewUnaryHandler(
+		WarehouseFinanceExtServiceExpenseTypeCreateProcedure,
+		svc.ExpenseTypeCreate,
+		connect.WithSchema(warehouseFinanceExtServiceMethods.ByName("ExpenseTypeCreate")),
+		connect.WithHandlerOptions(opts...),
+	)
+	warehouseFinanceExtServiceExpenseTypeUpdateHandler := connect.NewUnaryHandler(
+		WarehouseFinanceExtServiceExpenseTypeUpdateProcedure,
+		svc.ExpenseTypeUpdate,
+		connect.WithSchema(warehouseFinanceExtServiceMethods.ByName("ExpenseTypeUpdate")),
+		connect.WithHandlerOptions(opts...),
+	)
+	warehouseFinanceExtServiceExpenseBudgetSetHandler := connect.NewUnaryHandler(
+		WarehouseFinanceExtServiceExpenseBudgetSetProcedure,
+		svc.ExpenseBudgetSet,
+		connect.WithSchema(warehouseFinanceExtServiceMethods.ByName("ExpenseBudgetSet")),
+		connect.WithHandlerOptions(opts...),
+	)
+	warehouseFinanceExtServiceExpenseBudgetReportHandler := connect.NewUnaryHandler(
+		WarehouseFinanceExtServiceExpenseBudgetReportProcedure,
+		svc.ExpenseBudgetReport,
+		connect.WithSchema(warehouseFinanceExtServiceMethods.ByName("ExpenseBudgetReport")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/warehouse_service.v1.WarehouseFinanceExtService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case WarehouseFinanceExtServiceExpenseReportDailyDetailProcedure:
+			warehouseFinanceExtServiceExpenseReportDailyDetailHandler.ServeHTTP(w, r)
+		case WarehouseFinanceExtServiceExpenseAccountPageProcedure:
+			warehouseFinanceExtServiceExpenseAccountPageHandler.ServeHTTP(w, r)
+		case WarehouseFinanceExtServiceExpenseAccountSetDisabledProcedure:
+			warehouseFinanceExtServiceExpenseAccountSetDisabledHandler.ServeHTTP(w, r)
+		case WarehouseFinanceExtServiceExpenseHistoryPageProcedure:
+			warehouseFinanceExtServiceExpenseHistoryPageHandler.ServeHTTP(w, r)
+		case WarehouseFinanceExtServiceExpenseHistoryReviewProcedure:
+			warehouseFinanceExtServiceExpenseHistoryReviewHandler.ServeHTTP(w, r)
+		case WarehouseFinanceExtServiceExpenseHistoryRevisionProcedure:
+			warehouseFinanceExtServiceExpenseHistoryRevisionHandler.ServeHTTP(w, r)
+		case WarehouseFinanceExtServiceExpenseHistoryDeleteProcedure:
+			warehouseFinanceExtServiceExpenseHistoryDeleteHandler.ServeHTTP(w, r)
+		case WarehouseFinanceExtServiceExpenseHistoryRestoreProcedure:
+			warehouseFinanceExtServiceExpenseHistoryRestoreHandler.ServeHTTP(w, r)
+		case WarehouseFinanceExtServiceExpenseTransferCreateProcedure:
+			warehouseFinanceExtServiceExpenseTransferCreateHandler.ServeHTTP(w, r)
+		case WarehouseFinanceExtServiceBalanceHistoryCreateProcedure:
+			warehouseFinanceExtServiceBalanceHistoryCreateHandler.ServeHTTP(w, r)
+		case WarehouseFinanceExtServiceBalanceHistoryUpdateProcedure:
+			warehouseFinanceExtServiceBalanceHistoryUpdateHandler.ServeHTTP(w, r)
+		case WarehouseFinanceExtServiceBalanceHistoryDeleteProcedure:
+			warehouseFinanceExtServiceBalanceHistoryDeleteHandler.ServeHTTP(w, r)
+		case WarehouseFinanceExtServiceBalanceHistoryListProcedure:
+			warehouseFinanceExtServiceBalanceHistoryListHandler.ServeHTTP(w, r)
+		case WarehouseFinanceExtServiceAccountBalanceReconciliationProcedure:
+			warehouseFinanceExtServiceAccountBalanceReconciliationHandler.ServeHTTP(w, r)
+		case WarehouseFinanceExtServiceExpenseTypeListProcedure:
+			warehouseFinanceExtServiceExpenseTypeListHandler.ServeHTTP(w, r)
+		case WarehouseFinanceExtServiceExpenseTypeCreateProcedure:
+			warehouseFinanceExtServiceExpenseTypeCreateHandler.ServeHTTP(w, r)
+		case WarehouseFinanceExtServiceExpenseTypeUpdateProcedure:
+			warehouseFinanceExtServiceExpenseTypeUpdateHandler.ServeHTTP(w, r)
+		case WarehouseFinanceExtServiceExpenseBudgetSetProcedure:
+			warehouseFinanceExtServiceExpenseBudgetSetHandler.ServeHTTP(w, r)
+		case WarehouseFinanceExtServiceExpenseBudgetReportProcedure:
+			warehouseFinanceExtServiceExpenseBudgetReportHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedWarehouseFinanceExtServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedWarehouseFinanceExtServiceHandler struct{}
+
+func (UnimplementedWarehouseFinanceExtServiceHandler) ExpenseReportDailyDetail(context.Context, *connect.Request[v1.ExpenseReportDailyDetailRequest]) (*connect.Response[v1.ExpenseReportDailyDetailResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("warehouse_service.v1.WarehouseFinanceExtService.ExpenseReportDailyDetail is not implemented"))
+}
+
+func (UnimplementedWarehouseFinanceExtServiceHandler) ExpenseAccountPage(context.Context, *connect.Request[v1.ExpenseAccountPageRequest]) (*connect.Response[v1.ExpenseAccountPageResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("warehouse_service.v1.WarehouseFinanceExtService.ExpenseAccountPage is not implemented"))
+}
+
+func (UnimplementedWarehouseFinanceExtServiceHandler) ExpenseAccountSetDisabled(context.Context, *connect.Request[v1.ExpenseAccountSetDisabledRequest]) (*connect.Response[v1.ExpenseAccountSetDisabledResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("warehouse_service.v1.WarehouseFinanceExtService.ExpenseAccountSetDisabled is not implemented"))
+}
+
+func (UnimplementedWarehouseFinanceExtServiceHandler) ExpenseHistoryPage(context.Context, *connect.Request[v1.ExpenseHistoryPageRequest]) (*connect.Response[v1.ExpenseHistoryPageResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("warehouse_service.v1.WarehouseFinanceExtService.ExpenseHistoryPage is not implemented"))
+}
+
+func (UnimplementedWarehouseFinanceExtServiceHandler) ExpenseHistoryReview(context.Context, *connect.Request[v1.ExpenseHistoryReviewRequest]) (*connect.Response[v1.ExpenseHistoryReviewResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("warehouse_service.v1.WarehouseFinanceExtService.ExpenseHistoryReview is not implemented"))
+}
+
+func (UnimplementedWarehouseFinanceExtServiceHandler) ExpenseHistoryRevision(context.Context, *connect.Request[v1.ExpenseHistoryRevisionRequest]) (*connect.Response[v1.ExpenseHistoryRevisionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("warehouse_service.v1.WarehouseFinanceExtService.ExpenseHistoryRevision is not implemented"))
+}
+
+func (UnimplementedWarehouseFinanceExtServiceHandler) ExpenseHistoryDelete(context.Context, *connect.Request[v1.ExpenseHistoryDeleteRequest]) (*connect.Response[v1.ExpenseHistoryDeleteResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("warehouse_service.v1.WarehouseFinanceExtService.ExpenseHistoryDelete is not implemented"))
+}
+
+func (UnimplementedWarehouseFinanceExtServiceHandler) ExpenseHistoryRestore(context.Context, *connect.Request[v1.ExpenseHistoryRestoreRequest]) (*connect.Response[v1.ExpenseHistoryRestoreResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("warehouse_service.v1.WarehouseFinanceExtService.ExpenseHistoryRestore is not implemented"))
+}
+
+func (UnimplementedWarehouseFinanceExtServiceHandler) ExpenseTransferCreate(context.Context, *connect.Request[v1.ExpenseTransferCreateRequest]) (*connect.Response[v1.ExpenseTransferCreateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("warehouse_service.v1.WarehouseFinanceExtService.ExpenseTransferCreate is not implemented"))
+}
+
+func (UnimplementedWarehouseFinanceExtServiceHandler) BalanceHistoryCreate(context.Context, *connect.Request[v1.BalanceHistoryCreateRequest]) (*connect.Response[v1.BalanceHistoryCreateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("warehouse_service.v1.WarehouseFinanceExtService.BalanceHistoryCreate is not implemented"))
+}
+
+func (UnimplementedWarehouseFinanceExtServiceHandler) BalanceHistoryUpdate(context.Context, *connect.Request[v1.BalanceHistoryUpdateRequest]) (*connect.Response[v1.BalanceHistoryUpdateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("warehouse_service.v1.WarehouseFinanceExtService.BalanceHistoryUpdate is not implemented"))
+}
+
+func (UnimplementedWarehouseFinanceExtServiceHandler) BalanceHistoryDelete(context.Context, *connect.Request[v1.BalanceHistoryDeleteRequest]) (*connect.Response[v1.BalanceHistoryDeleteResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("warehouse_service.v1.WarehouseFinanceExtService.BalanceHistoryDelete is not implemented"))
+}
+
+func (UnimplementedWarehouseFinanceExtServiceHandler) BalanceHistoryList(context.Context, *connect.Request[v1.BalanceHistoryListRequest]) (*connect.Response[v1.BalanceHistoryListResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("warehouse_service.v1.WarehouseFinanceExtService.BalanceHistoryList is not implemented"))
+}
+
+func (UnimplementedWarehouseFinanceExtServiceHandler) AccountBalanceReconciliation(context.Context, *connect.Request[v1.AccountBalanceReconciliationRequest]) (*connect.Response[v1.AccountBalanceReconciliationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("warehouse_service.v1.WarehouseFinanceExtService.AccountBalanceReconciliation is not implemented"))
+}
+
+func (UnimplementedWarehouseFinanceExtServiceHandler) ExpenseTypeList(context.Context, *connect.Request[v1.ExpenseTypeListRequest]) (*connect.Response[v1.ExpenseTypeListResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("warehouse_service.v1.WarehouseFinanceExtService.ExpenseTypeList is not implemented"))
+}
+
+func (UnimplementedWarehouseFinanceExtServiceHandler) ExpenseTypeCreate(context.Context, *connect.Request[v1.ExpenseTypeCreateRequest]) (*connect.Response[v1.ExpenseTypeCreateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("warehouse_service.v1.WarehouseFinanceExtService.ExpenseTypeCreate is not implemented"))
+}
+
+func (UnimplementedWarehouseFinanceExtServiceHandler) ExpenseTypeUpdate(context.Context, *connect.Request[v1.ExpenseTypeUpdateRequest]) (*connect.Response[v1.ExpenseTypeUpdateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("warehouse_service.v1.WarehouseFinanceExtService.ExpenseTypeUpdate is not implemented"))
+}
+
+func (UnimplementedWarehouseFinanceExtServiceHandler) ExpenseBudgetSet(context.Context, *connect.Request[v1.ExpenseBudgetSetRequest]) (*connect.Response[v1.ExpenseBudgetSetResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("warehouse_service.v1.WarehouseFinanceExtService.ExpenseBudgetSet is not implemented"))
+}
+
+func (UnimplementedWarehouseFinanceExtServiceHandler) ExpenseBudgetReport(context.Context, *connect.Request[v1.ExpenseBudgetReportRequest]) (*connect.Response[v1.ExpenseBudgetReportResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("warehouse_service.v1.WarehouseFinanceExtService.ExpenseBudgetReport is not implemented"))
+}
@@ -0,0 +1,112 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: warehouse_service/v1/inventory_detail.proto
+
+package warehouse_service_ifaceconnect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/pdcgo/warehouse_service/services/warehouse_service/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// InventoryDetailServiceName is the fully-qualified name of the InventoryDetailService service.
+	InventoryDetailServiceName = "warehouse_service.v1.InventoryDetailService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// InventoryDetailServiceProductStockDetailProcedure is the fully-qualified name of the
+	// InventoryDetailService's ProductStockDetail RPC.
+	InventoryDetailServiceProductStockDetailProcedure = "/warehouse_service.v1.InventoryDetailService/ProductStockDetail"
+)
+
+// InventoryDetailServiceClient is a client for the warehouse_service.v1.InventoryDetailService
+// service.
+type InventoryDetailServiceClient interface {
+	ProductStockDetail(context.Context, *connect.Request[v1.ProductStockDetailRequest]) (*connect.Response[v1.ProductStockDetailResponse], error)
+}
+
+// NewInventoryDetailServiceClient constructs a client for the
+// warehouse_service.v1.InventoryDetailService service. By default, it uses the Connect protocol
+// with the binary Protobuf Codec, asks for gzipped responses, and sends uncompressed requests. To
+// use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or connect.WithGRPCWeb()
+// options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewInventoryDetailServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) InventoryDetailServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	inventoryDetailServiceMethods := v1.File_warehouse_service_v1_inventory_detail_proto.Services().ByName("InventoryDetailService").Methods()
+	return &inventoryDetailServiceClient{
+		productStockDetail: connect.NewClient[v1.ProductStockDetailRequest, v1.ProductStockDetailResponse](
+			httpClient,
+			baseURL+InventoryDetailServiceProductStockDetailProcedure,
+			connect.WithSchema(inventoryDetailServiceMethods.ByName("ProductStockDetail")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// inventoryDetailServiceClient implements InventoryDetailServiceClient.
+type inventoryDetailServiceClient struct {
+	productStockDetail *connect.Client[v1.ProductStockDetailRequest, v1.ProductStockDetailResponse]
+}
+
+// ProductStockDetail calls warehouse_service.v1.InventoryDetailService.ProductStockDetail.
+func (c *inventoryDetailServiceClient) ProductStockDetail(ctx context.Context, req *connect.Request[v1.ProductStockDetailRequest]) (*connect.Response[v1.ProductStockDetailResponse], error) {
+	return c.productStockDetail.CallUnary(ctx, req)
+}
+
+// InventoryDetailServiceHandler is an implementation of the
+// warehouse_service.v1.InventoryDetailService service.
+type InventoryDetailServiceHandler interface {
+	ProductStockDetail(context.Context, *connect.Request[v1.ProductStockDetailRequest]) (*connect.Response[v1.ProductStockDetailResponse], error)
+}
+
+// NewInventoryDetailServiceHandler builds an HTTP handler from the service implementation. It
+// returns the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewInventoryDetailServiceHandler(svc InventoryDetailServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	inventoryDetailServiceMethods := v1.File_warehouse_service_v1_inventory_detail_proto.Services().ByName("InventoryDetailService").Methods()
+	inventoryDetailServiceProductStockDetailHandler := connect.NewUnaryHandler(
+		InventoryDetailServiceProductStockDetailProcedure,
+		svc.ProductStockDetail,
+		connect.WithSchema(inventoryDetailServiceMethods.ByName("ProductStockDetail")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/warehouse_service.v1.InventoryDetailService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case InventoryDetailServiceProductStockDetailProcedure:
+			inventoryDetailServiceProductStockDetailHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedInventoryDetailServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedInventoryDetailServiceHandler struct{}
+
+func (UnimplementedInventoryDetailServiceHandler) ProductStockDetail(context.Context, *connect.Request[v1.ProductStockDetailRequest]) (*connect.Response[v1.ProductStockDetailResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("warehouse_service.v1.InventoryDetailService.ProductStockDetail is not implemented"))
+}
@@ -0,0 +1,174 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: warehouse_service/v1/stock_reconciliation.proto
+
+package warehouse_service_ifaceconnect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/pdcgo/warehouse_service/services/warehouse_service/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// StockReconciliationServiceName is the fully-qualified name of the StockReconciliationService
+	// service.
+	StockReconciliationServiceName = "warehouse_service.v1.StockReconciliationService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// StockReconciliationServiceStockReconciliationCreateProcedure is the fully-qualified name of the
+	// StockReconciliationService's StockReconciliationCreate RPC.
+	StockReconciliationServiceStockReconciliationCreateProcedure = "/warehouse_service.v1.StockReconciliationService/StockReconciliationCreate"
+	// StockReconciliationServiceStockReconciliationListProcedure is the fully-qualified name of the
+	// StockReconciliationService's StockReconciliationList RPC.
+	StockReconciliationServiceStockReconciliationListProcedure = "/warehouse_service.v1.StockReconciliationService/StockReconciliationList"
+	// StockReconciliationServiceStockReconciliationItemListProcedure is the fully-qualified name of the
+	// StockReconciliationService's StockReconciliationItemList RPC.
+	StockReconciliationServiceStockReconciliationItemListProcedure = "/warehouse_service.v1.StockReconciliationService/StockReconciliationItemList"
+)
+
+// StockReconciliationServiceClient is a client for the
+// warehouse_service.v1.StockReconciliationService service.
+type StockReconciliationServiceClient interface {
+	StockReconciliationCreate(context.Context, *connect.Request[v1.StockReconciliationCreateRequest]) (*connect.Response[v1.StockReconciliationCreateResponse], error)
+	StockReconciliationList(context.Context, *connect.Request[v1.StockReconciliationListRequest]) (*connect.Response[v1.StockReconciliationListResponse], error)
+	StockReconciliationItemList(context.Context, *connect.Request[v1.StockReconciliationItemListRequest]) (*connect.Response[v1.StockReconciliationItemListResponse], error)
+}
+
+// NewStockReconciliationServiceClient constructs a client for the
+// warehouse_service.v1.StockReconciliationService service. By default, it uses the Connect protocol
+// with the binary Protobuf Codec, asks for gzipped responses, and sends uncompressed requests. To
+// use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or connect.WithGRPCWeb()
+// options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewStockReconciliationServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) StockReconciliationServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	stockReconciliationServiceMethods := v1.File_warehouse_service_v1_stock_reconciliation_proto.Services().ByName("StockReconciliationService").Methods()
+	return &stockReconciliationServiceClient{
+		stockReconciliationCreate: connect.NewClient[v1.StockReconciliationCreateRequest, v1.StockReconciliationCreateResponse](
+			httpClient,
+			baseURL+StockReconciliationServiceStockReconciliationCreateProcedure,
+			connect.WithSchema(stockReconciliationServiceMethods.ByName("StockReconciliationCreate")),
+			connect.WithClientOptions(opts...),
+		),
+		stockReconciliationList: connect.NewClient[v1.StockReconciliationListRequest, v1.StockReconciliationListResponse](
+			httpClient,
+			baseURL+StockReconciliationServiceStockReconciliationListProcedure,
+			connect.WithSchema(stockReconciliationServiceMethods.ByName("StockReconciliationList")),
+			connect.WithClientOptions(opts...),
+		),
+		stockReconciliationItemList: connect.NewClient[v1.StockReconciliationItemListRequest, v1.StockReconciliationItemListResponse](
+			httpClient,
+			baseURL+StockReconciliationServiceStockReconciliationItemListProcedure,
+			connect.WithSchema(stockReconciliationServiceMethods.ByName("StockReconciliationItemList")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// stockReconciliationServiceClient implements StockReconciliationServiceClient.
+type stockReconciliationServiceClient struct {
+	stockReconciliationCreate   *connect.Client[v1.StockReconciliationCreateRequest, v1.StockReconciliationCreateResponse]
+	stockReconciliationList     *connect.Client[v1.StockReconciliationListRequest, v1.StockReconciliationListResponse]
+	stockReconciliationItemList *connect.Client[v1.StockReconciliationItemListRequest, v1.StockReconciliationItemListResponse]
+}
+
+// StockReconciliationCreate calls
+// warehouse_service.v1.StockReconciliationService.StockReconciliationCreate.
+func (c *stockReconciliationServiceClient) StockReconciliationCreate(ctx context.Context, req *connect.Request[v1.StockReconciliationCreateRequest]) (*connect.Response[v1.StockReconciliationCreateResponse], error) {
+	return c.stockReconciliationCreate.CallUnary(ctx, req)
+}
+
+// StockReconciliationList calls
+// warehouse_service.v1.StockReconciliationService.StockReconciliationList.
+func (c *stockReconciliationServiceClient) StockReconciliationList(ctx context.Context, req *connect.Request[v1.StockReconciliationListRequest]) (*connect.Response[v1.StockReconciliationListResponse], error) {
+	return c.stockReconciliationList.CallUnary(ctx, req)
+}
+
+// StockReconciliationItemList calls
+// warehouse_service.v1.StockReconciliationService.StockReconciliationItemList.
+func (c *stockReconciliationServiceClient) StockReconciliationItemList(ctx context.Context, req *connect.Request[v1.StockReconciliationItemListRequest]) (*connect.Response[v1.StockReconciliationItemListResponse], error) {
+	return c.stockReconciliationItemList.CallUnary(ctx, req)
+}
+
+// StockReconciliationServiceHandler is an implementation of the
+// warehouse_service.v1.StockReconciliationService service.
+type StockReconciliationServiceHandler interface {
+	StockReconciliationCreate(context.Context, *connect.Request[v1.StockReconciliationCreateRequest]) (*connect.Response[v1.StockReconciliationCreateResponse], error)
+	StockReconciliationList(context.Context, *connect.Request[v1.StockReconciliationListRequest]) (*connect.Response[v1.StockReconciliationListResponse], error)
+	StockReconciliationItemList(context.Context, *connect.Request[v1.StockReconciliationItemListRequest]) (*connect.Response[v1.StockReconciliationItemListResponse], error)
+}
+
+// NewStockReconciliationServiceHandler builds an HTTP handler from the service implementation. It
+// returns the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewStockReconciliationServiceHandler(svc StockReconciliationServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	stockReconciliationServiceMethods := v1.File_warehouse_service_v1_stock_reconciliation_proto.Services().ByName("StockReconciliationService").Methods()
+	stockReconciliationServiceStockReconciliationCreateHandler := connect.NewUnaryHandler(
+		StockReconciliationServiceStockReconciliationCreateProcedure,
+		svc.StockReconciliationCreate,
+		connect.WithSchema(stockReconciliationServiceMethods.ByName("StockReconciliationCreate")),
+		connect.WithHandlerOptions(opts...),
+	)
+	stockReconciliationServiceStockReconciliationListHandler := connect.NewUnaryHandler(
+		StockReconciliationServiceStockReconciliationListProcedure,
+		svc.StockReconciliationList,
+		connect.WithSchema(stockReconciliationServiceMethods.ByName("StockReconciliationList")),
+		connect.WithHandlerOptions(opts...),
+	)
+	stockReconciliationServiceStockReconciliationItemListHandler := connect.NewUnaryHandler(
+		StockReconciliationServiceStockReconciliationItemListProcedure,
+		svc.StockReconciliationItemList,
+		connect.WithSchema(stockReconciliationServiceMethods.ByName("StockReconciliationItemList")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/warehouse_service.v1.StockReconciliationService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case StockReconciliationServiceStockReconciliationCreateProcedure:
+			stockReconciliationServiceStockReconciliationCreateHandler.ServeHTTP(w, r)
+		case StockReconciliationServiceStockReconciliationListProcedure:
+			stockReconciliationServiceStockReconciliationListHandler.ServeHTTP(w, r)
+		case StockReconciliationServiceStockReconciliationItemListProcedure:
+			stockReconciliationServiceStockReconciliationItemListHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedStockReconciliationServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedStockReconciliationServiceHandler struct{}
+
+func (UnimplementedStockReconciliationServiceHandler) StockReconciliationCreate(context.Context, *connect.Request[v1.StockReconciliationCreateRequest]) (*connect.Response[v1.StockReconciliationCreateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("warehouse_service.v1.StockReconciliationService.StockReconciliationCreate is not implemented"))
+}
+
+func (UnimplementedStockReconciliationServiceHandler) StockReconciliationList(context.Context, *connect.Request[v1.StockReconciliationListRequest]) (*connect.Response[v1.StockReconciliationListResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("warehouse_service.v1.StockReconciliationService.StockReconciliationList is not implemented"))
+}
+
+func (UnimplementedStockReconciliationServiceHandler) StockReconciliationItemList(context.Context, *connect.Request[v1.StockReconciliationItemListRequest]) (*connect.Response[v1.StockReconciliationItemListResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("warehouse_service.v1.StockReconciliationService.StockReconciliationItemList is not implemented"))
+}
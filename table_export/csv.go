@@ -0,0 +1,46 @@
+package table_export
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+)
+
+func NewCSVWriter(out io.Writer) Writer {
+	return &csvWriter{
+		writer: csv.NewWriter(out),
+	}
+}
+
+type csvWriter struct {
+	writer *csv.Writer
+}
+
+func formatFloat(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
+
+func (c *csvWriter) WriteHeader(header ...string) error {
+	return c.writer.Write(header)
+}
+
+func (c *csvWriter) WriteRow(cells ...any) error {
+	row := make([]string, len(cells))
+	for i, cell := range cells {
+		row[i] = cellString(cell)
+	}
+
+	err := c.writer.Write(row)
+	if err != nil {
+		return err
+	}
+
+	// flushing every row so large export is streamed to client
+	c.writer.Flush()
+	return c.writer.Error()
+}
+
+func (c *csvWriter) Close() error {
+	c.writer.Flush()
+	return c.writer.Error()
+}
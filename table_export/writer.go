@@ -0,0 +1,75 @@
+package table_export
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"time"
+)
+
+type Format string
+
+const (
+	FormatCSV  Format = "csv"
+	FormatXLSX Format = "xlsx"
+)
+
+func (Format) EnumList() []string {
+	return []string{
+		"csv",
+		"xlsx",
+	}
+}
+
+func (f Format) ContentType() string {
+	switch f {
+	case FormatXLSX:
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	default:
+		return "text/csv"
+	}
+}
+
+var ErrFormatNotSupported = errors.New("export format not supported")
+
+// Writer is writing table row by row, nothing is kept in memory except current row. cell is string, number,
+// bool or time.Time. Close must be called to finish the file, it is not closing underlying writer.
+type Writer interface {
+	WriteHeader(header ...string) error
+	WriteRow(cells ...any) error
+	Close() error
+}
+
+// NewWriter empty format is csv.
+func NewWriter(format Format, out io.Writer, sheetName string) (Writer, error) {
+	switch format {
+	case "", FormatCSV:
+		return NewCSVWriter(out), nil
+	case FormatXLSX:
+		return NewXLSXWriter(out, sheetName)
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrFormatNotSupported, format)
+	}
+}
+
+const TimeLayout = "2006-01-02 15:04:05"
+
+func cellString(cell any) string {
+	switch value := cell.(type) {
+	case nil:
+		return ""
+	case string:
+		return value
+	case time.Time:
+		if value.IsZero() {
+			return ""
+		}
+		return value.Format(TimeLayout)
+	case float64:
+		return formatFloat(value)
+	case fmt.Stringer:
+		return value.String()
+	default:
+		return fmt.Sprint(value)
+	}
+}
@@ -0,0 +1,79 @@
+package table_export_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/pdcgo/warehouse_service/table_export"
+	"github.com/stretchr/testify/assert"
+)
+
+func writeTable(t *testing.T, format table_export.Format) []byte {
+	out := bytes.Buffer{}
+	writer, err := table_export.NewWriter(format, &out, "expense")
+	assert.Nil(t, err)
+
+	err = writer.WriteHeader("type", "amount", "at", "note")
+	assert.Nil(t, err)
+	err = writer.WriteRow("kitchen", -15_000.5, time.Date(2025, 1, 2, 12, 0, 0, 0, time.UTC), `beli "gas" & <air>`)
+	assert.Nil(t, err)
+	err = writer.Close()
+	assert.Nil(t, err)
+
+	return out.Bytes()
+}
+
+func TestWriter(t *testing.T) {
+	t.Run("test csv", func(t *testing.T) {
+		content := writeTable(t, table_export.FormatCSV)
+		assert.Equal(t, "type,amount,at,note\nkitchen,-15000.5,2025-01-02 12:00:00,\"beli \"\"gas\"\" & <air>\"\n", string(content))
+	})
+
+	t.Run("test xlsx", func(t *testing.T) {
+		content := writeTable(t, table_export.FormatXLSX)
+
+		archive, err := zip.NewReader(bytes.NewReader(content), int64(len(content)))
+		assert.Nil(t, err)
+
+		var sheet []byte
+		for _, file := range archive.File {
+			if file.Name != "xl/worksheets/sheet1.xml" {
+				continue
+			}
+			reader, err := file.Open()
+			assert.Nil(t, err)
+			sheet, err = io.ReadAll(reader)
+			assert.Nil(t, err)
+		}
+
+		data := struct {
+			Rows []struct {
+				Cells []struct {
+					Ref    string `xml:"r,attr"`
+					Type   string `xml:"t,attr"`
+					Value  string `xml:"v"`
+					Inline string `xml:"is>t"`
+				} `xml:"c"`
+			} `xml:"sheetData>row"`
+		}{}
+		err = xml.Unmarshal(sheet, &data)
+		assert.Nil(t, err)
+		assert.Len(t, data.Rows, 2)
+
+		cells := data.Rows[1].Cells
+		assert.Equal(t, "kitchen", cells[0].Inline)
+		assert.Equal(t, "B2", cells[1].Ref)
+		assert.Equal(t, "-15000.5", cells[1].Value)
+		assert.Equal(t, "45659.5", cells[2].Value)
+		assert.Equal(t, `beli "gas" & <air>`, cells[3].Inline)
+	})
+
+	t.Run("test format not supported", func(t *testing.T) {
+		_, err := table_export.NewWriter("pdf", &bytes.Buffer{}, "")
+		assert.ErrorIs(t, err, table_export.ErrFormatNotSupported)
+	})
+}
@@ -0,0 +1,175 @@
+package table_export
+
+import (
+	"archive/zip"
+	"bufio"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// minimal workbook with one sheet, string cell is inline so no shared string table is kept in memory.
+const xlsxContentTypes = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+	`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+	`<Default Extension="xml" ContentType="application/xml"/>` +
+	`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>` +
+	`<Override PartName="/xl/styles.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.styles+xml"/>` +
+	`<Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>` +
+	`</Types>`
+
+const xlsxRootRels = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+	`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>` +
+	`</Relationships>`
+
+const xlsxWorkbookRels = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+	`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/>` +
+	`<Relationship Id="rId2" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/styles" Target="styles.xml"/>` +
+	`</Relationships>`
+
+// style 1 is date time cell
+const xlsxStyles = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<styleSheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">` +
+	`<numFmts count="1"><numFmt numFmtId="164" formatCode="yyyy-mm-dd hh:mm:ss"/></numFmts>` +
+	`<fonts count="1"><font><sz val="11"/><name val="Calibri"/></font></fonts>` +
+	`<fills count="2"><fill><patternFill patternType="none"/></fill><fill><patternFill patternType="gray125"/></fill></fills>` +
+	`<borders count="1"><border><left/><right/><top/><bottom/><diagonal/></border></borders>` +
+	`<cellStyleXfs count="1"><xf numFmtId="0" fontId="0" fillId="0" borderId="0"/></cellStyleXfs>` +
+	`<cellXfs count="2"><xf numFmtId="0" fontId="0" fillId="0" borderId="0" xfId="0"/>` +
+	`<xf numFmtId="164" fontId="0" fillId="0" borderId="0" xfId="0" applyNumberFormat="1"/></cellXfs>` +
+	`</styleSheet>`
+
+const xlsxSheetStart = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`
+
+const xlsxSheetEnd = `</sheetData></worksheet>`
+
+// excel serial date start, 1899-12-30 because of excel 1900 leap year bug
+var xlsxEpoch = time.Date(1899, 12, 30, 0, 0, 0, 0, time.UTC)
+
+func NewXLSXWriter(out io.Writer, sheetName string) (Writer, error) {
+	if sheetName == "" {
+		sheetName = "Sheet1"
+	}
+
+	archive := zip.NewWriter(out)
+	workbook := `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">` +
+		`<sheets><sheet name="` + xmlEscape(sheetName) + `" sheetId="1" r:id="rId1"/></sheets></workbook>`
+
+	for _, part := range [][2]string{
+		{"[Content_Types].xml", xlsxContentTypes},
+		{"_rels/.rels", xlsxRootRels},
+		{"xl/workbook.xml", workbook},
+		{"xl/_rels/workbook.xml.rels", xlsxWorkbookRels},
+		{"xl/styles.xml", xlsxStyles},
+	} {
+		file, err := archive.Create(part[0])
+		if err != nil {
+			return nil, err
+		}
+		_, err = io.WriteString(file, part[1])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// sheet is last part so its row can be streamed
+	sheet, err := archive.Create("xl/worksheets/sheet1.xml")
+	if err != nil {
+		return nil, err
+	}
+	buf := bufio.NewWriter(sheet)
+	_, err = buf.WriteString(xlsxSheetStart)
+	if err != nil {
+		return nil, err
+	}
+
+	return &xlsxWriter{
+		archive: archive,
+		sheet:   buf,
+	}, nil
+}
+
+type xlsxWriter struct {
+	archive *zip.Writer
+	sheet   *bufio.Writer
+	row     int
+}
+
+func xmlEscape(value string) string {
+	builder := strings.Builder{}
+	_ = xml.EscapeText(&builder, []byte(value))
+	return builder.String()
+}
+
+func xlsxColumn(index int) string {
+	name := ""
+	for index >= 0 {
+		name = string(rune('A'+index%26)) + name
+		index = index/26 - 1
+	}
+	return name
+}
+
+func (x *xlsxWriter) WriteHeader(header ...string) error {
+	cells := make([]any, len(header))
+	for i, name := range header {
+		cells[i] = name
+	}
+	return x.WriteRow(cells...)
+}
+
+func (x *xlsxWriter) WriteRow(cells ...any) error {
+	x.row++
+	builder := strings.Builder{}
+	fmt.Fprintf(&builder, `<row r="%d">`, x.row)
+
+	for i, cell := range cells {
+		ref := fmt.Sprintf("%s%d", xlsxColumn(i), x.row)
+		switch value := cell.(type) {
+		case nil:
+		case float64:
+			fmt.Fprintf(&builder, `<c r="%s"><v>%s</v></c>`, ref, formatFloat(value))
+		case int, int64, uint, uint64, uint32, int32:
+			fmt.Fprintf(&builder, `<c r="%s"><v>%d</v></c>`, ref, value)
+		case bool:
+			boolValue := 0
+			if value {
+				boolValue = 1
+			}
+			fmt.Fprintf(&builder, `<c r="%s" t="b"><v>%d</v></c>`, ref, boolValue)
+		case time.Time:
+			if value.IsZero() {
+				continue
+			}
+			// serial date is wall clock time, not utc
+			wall := time.Date(value.Year(), value.Month(), value.Day(), value.Hour(), value.Minute(), value.Second(), value.Nanosecond(), time.UTC)
+			serial := wall.Sub(xlsxEpoch).Hours() / 24
+			fmt.Fprintf(&builder, `<c r="%s" s="1"><v>%s</v></c>`, ref, formatFloat(serial))
+		default:
+			fmt.Fprintf(&builder, `<c r="%s" t="inlineStr"><is><t xml:space="preserve">%s</t></is></c>`, ref, xmlEscape(cellString(value)))
+		}
+	}
+	builder.WriteString(`</row>`)
+
+	_, err := x.sheet.WriteString(builder.String())
+	return err
+}
+
+func (x *xlsxWriter) Close() error {
+	_, err := x.sheet.WriteString(xlsxSheetEnd)
+	if err != nil {
+		return err
+	}
+	err = x.sheet.Flush()
+	if err != nil {
+		return err
+	}
+
+	return x.archive.Close()
+}
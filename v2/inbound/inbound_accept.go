@@ -2,15 +2,297 @@ package inbound
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
 
 	"connectrpc.com/connect"
+	"github.com/pdcgo/schema/services/access_iface/v1"
 	"github.com/pdcgo/schema/services/warehouse_iface/v1"
+	"github.com/pdcgo/shared/db_models"
+	"github.com/pdcgo/shared/interfaces/authorization_iface"
+	"github.com/pdcgo/warehouse_service/warehouse_models"
+	"github.com/pdcgo/warehouse_service/warehouse_mutations"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
+func protoToProblemType(problemType warehouse_iface.ProblemType) (string, error) {
+	switch problemType {
+	case warehouse_iface.ProblemType_PROBLEM_TYPE_BROKEN_S:
+		return "broken_s", nil
+	case warehouse_iface.ProblemType_PROBLEM_TYPE_LOST_S:
+		return "lost_s", nil
+	case warehouse_iface.ProblemType_PROBLEM_TYPE_BROKEN_W:
+		return "broken_w", nil
+	case warehouse_iface.ProblemType_PROBLEM_TYPE_LOST_W:
+		return "lost_w", nil
+	case warehouse_iface.ProblemType_PROBLEM_TYPE_DISASTER:
+		return "disaster", nil
+	case warehouse_iface.ProblemType_PROBLEM_TYPE_SAMPLE:
+		return "sample", nil
+	default:
+		return "", errors.New("problem type not supported")
+	}
+}
+
 // InboundAccept implements warehouse_ifaceconnect.InboundServiceHandler.
 func (i *inboundServiceImpl) InboundAccept(
 	ctx context.Context,
 	req *connect.Request[warehouse_iface.InboundAcceptRequest],
 ) (*connect.Response[warehouse_iface.InboundAcceptResponse], error) {
-	panic("unimplemented")
+	var err error
+
+	source, agent, err := i.checkAccess(ctx, req.Header(), authorization_iface.Update)
+	if err != nil {
+		return nil, err
+	}
+
+	// accept creating stock, so seller cannot accept own inbound
+	switch source.RequestFrom {
+	case access_iface.RequestFrom_REQUEST_FROM_WAREHOUSE,
+		access_iface.RequestFrom_REQUEST_FROM_ADMIN:
+	default:
+		return nil, errors.New("inbound accept only from warehouse")
+	}
+
+	pay := req.Msg
+	result := warehouse_iface.InboundAcceptResponse{}
+
+	var invTx *db_models.InvTransaction
+	db := i.db.WithContext(ctx)
+	err = db.Transaction(func(tx *gorm.DB) error {
+		var err error
+
+		invTx, err = getInbound(tx, source, pay.InvTransactionId)
+		if err != nil {
+			return err
+		}
+
+		if pay.WarehouseId != 0 && uint64(invTx.WarehouseID) != pay.WarehouseId {
+			return errors.New("inbound not in warehouse")
+		}
+		if pay.TxType != "" && db_models.InvTxType(pay.TxType) != invTx.Type {
+			return fmt.Errorf("inbound type %s not match", pay.TxType)
+		}
+
+		switch invTx.Status {
+		case db_models.InvWaiting, db_models.InvTxOngoing:
+		default:
+			return fmt.Errorf("inbound with status %s cannot accepted", invTx.Status)
+		}
+
+		restockCost := db_models.RestockCost{}
+		err = tx.
+			Model(&db_models.RestockCost{}).
+			Where("inv_transaction_id = ?", invTx.ID).
+			Find(&restockCost).
+			Error
+		if err != nil {
+			return err
+		}
+
+		// placement must cover all item count of sku
+		itemMap := map[db_models.SkuID]*db_models.InvTxItem{}
+		countMap := map[db_models.SkuID]int64{}
+		for _, item := range invTx.Items {
+			if itemMap[item.SkuID] == nil {
+				itemMap[item.SkuID] = item
+			}
+			countMap[item.SkuID] += int64(item.Count)
+		}
+
+		rackIDs := []uint64{}
+		for skuID, placement := range pay.Placement {
+			if itemMap[db_models.SkuID(skuID)] == nil {
+				return fmt.Errorf("sku %s not in inbound", skuID)
+			}
+
+			var placed int64
+			for _, place := range placement.List {
+				placed += place.Count
+				if place.ProblemType == warehouse_iface.ProblemType_PROBLEM_TYPE_UNSPECIFIED {
+					rackIDs = append(rackIDs, place.RackId)
+				}
+			}
+			countMap[db_models.SkuID(skuID)] -= placed
+		}
+
+		for skuID, count := range countMap {
+			if count != 0 {
+				return fmt.Errorf("placement count of sku %s not match", skuID)
+			}
+		}
+
+		var rackCount int64
+		err = tx.
+			Model(&db_models.Rack{}).
+			Where("id IN ?", rackIDs).
+			Where("warehouse_id = ?", invTx.WarehouseID).
+			Where("deleted != ?", true).
+			Count(&rackCount).
+			Error
+		if err != nil {
+			return err
+		}
+
+		uniqueRack := map[uint64]bool{}
+		for _, rackID := range rackIDs {
+			uniqueRack[rackID] = true
+		}
+		if int(rackCount) != len(uniqueRack) {
+			return errors.New("rack not found in warehouse")
+		}
+
+		now := time.Now()
+		userID := agent.GetUserID()
+
+		for skuID, placement := range pay.Placement {
+			item := itemMap[db_models.SkuID(skuID)]
+
+			var readyCount int64
+			for _, place := range placement.List {
+				if place.Count <= 0 {
+					return errors.New("placement count must greater than zero")
+				}
+
+				if place.ProblemType != warehouse_iface.ProblemType_PROBLEM_TYPE_UNSPECIFIED {
+					problemType, err := protoToProblemType(place.ProblemType)
+					if err != nil {
+						return err
+					}
+
+					err = tx.
+						Create(&warehouse_models.InvItemProblem{
+							SkuID:       item.SkuID,
+							TxID:        invTx.ID,
+							TxItemID:    item.ID,
+							ProblemType: problemType,
+							ProblemNote: place.Note,
+							Count:       int(place.Count),
+							Created:     now,
+						}).
+						Error
+					if err != nil {
+						return err
+					}
+
+					continue
+				}
+
+				readyCount += place.Count
+
+				// item in rack is negative count with empty tx_id, same as push handler and reconciliation reading it
+				err = tx.
+					Create(&db_models.InvertoryHistory{
+						RackID:      uint(place.RackId),
+						InTxID:      &invTx.ID,
+						SkuID:       item.SkuID,
+						WarehouseID: invTx.WarehouseID,
+						TeamID:      invTx.TeamID,
+						UserID:      userID,
+						Count:       -int(place.Count),
+						Price:       item.Price,
+						ExtPrice:    restockCost.PerPieceFee,
+						Created:     now,
+					}).
+					Error
+				if err != nil {
+					return err
+				}
+
+				err = tx.
+					Clauses(clause.OnConflict{
+						Columns: []clause.Column{{Name: "rack_id"}, {Name: "sku_id"}},
+						DoUpdates: clause.Assignments(map[string]interface{}{
+							"count": gorm.Expr("placements.count + excluded.count"),
+						}),
+					}).
+					Create(&db_models.Placement{
+						RackID: uint(place.RackId),
+						SkuID:  item.SkuID,
+						Count:  int(place.Count),
+					}).
+					Error
+				if err != nil {
+					return err
+				}
+			}
+
+			var pendingCount int64
+			for _, txItem := range invTx.Items {
+				if txItem.SkuID == item.SkuID {
+					pendingCount += int64(txItem.Count)
+				}
+			}
+
+			err = tx.
+				Model(&db_models.Sku{}).
+				Where("id = ?", item.SkuID).
+				Updates(map[string]interface{}{
+					"stock_pending": gorm.Expr("stock_pending - ?", pendingCount),
+					"stock_ready":   gorm.Expr("stock_ready + ?", readyCount),
+					"stock_total":   gorm.Expr("stock_total + ?", readyCount),
+					"last_inbound":  now,
+				}).
+				Error
+			if err != nil {
+				return err
+			}
+		}
+
+		err = tx.
+			Model(&db_models.InvTransaction{}).
+			Where("id = ?", invTx.ID).
+			Updates(map[string]interface{}{
+				"status":       db_models.InvTxCompleted,
+				"arrived":      now,
+				"verify_by_id": userID,
+			}).
+			Error
+		if err != nil {
+			return err
+		}
+
+		return warehouse_mutations.
+			NewTransactionLogNewEntry(tx, agent).
+			SetTxID(invTx.ID).
+			SetActionType(db_models.ActionChangeStatus).
+			SetStatus(db_models.InvTxCompleted).
+			Do()
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result.TxId = uint64(invTx.ID)
+
+	var event *warehouse_iface.StockEvent
+	switch invTx.Type {
+	case db_models.InvTxReturn:
+		event = &warehouse_iface.StockEvent{
+			Data: &warehouse_iface.StockEvent_ReturnAccepted{
+				ReturnAccepted: &warehouse_iface.ReturnAccepted{
+					TransactionId: uint64(invTx.ID),
+				},
+			},
+		}
+	default:
+		event = &warehouse_iface.StockEvent{
+			Data: &warehouse_iface.StockEvent_RestockAccepted{
+				RestockAccepted: &warehouse_iface.RestockAccepted{
+					TransactionId: uint64(invTx.ID),
+				},
+			},
+		}
+	}
+
+	// inbound already committed, failing send only logged so client not retrying accept
+	_, err = i.eventSender(ctx, event)
+	if err != nil {
+		slog.Error("sending inbound accepted event failed", "tx_id", invTx.ID, "err", err)
+	}
+
+	return connect.NewResponse(&result), nil
 }
@@ -0,0 +1,52 @@
+package inbound
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+	"github.com/pdcgo/schema/services/warehouse_iface/v1"
+	"github.com/pdcgo/shared/db_models"
+	"github.com/pdcgo/shared/interfaces/authorization_iface"
+	"gorm.io/gorm"
+)
+
+// InboundCancel implements warehouse_ifaceconnect.InboundServiceHandler.
+//
+// Cancel is done by team who created the inbound, only allowed before warehouse process it.
+func (i *inboundServiceImpl) InboundCancel(
+	ctx context.Context,
+	req *connect.Request[warehouse_iface.InboundCancelRequest],
+) (*connect.Response[warehouse_iface.InboundCancelResponse], error) {
+	var err error
+
+	source, agent, err := i.checkAccess(ctx, req.Header(), authorization_iface.Update)
+	if err != nil {
+		return nil, err
+	}
+
+	pay := req.Msg
+
+	db := i.db.WithContext(ctx)
+	err = db.Transaction(func(tx *gorm.DB) error {
+		invTx, err := getInbound(tx, source, pay.InvTransactionId)
+		if err != nil {
+			return err
+		}
+
+		if pay.WarehouseId != 0 && uint64(invTx.WarehouseID) != pay.WarehouseId {
+			return errors.New("inbound not in warehouse")
+		}
+
+		if invTx.Status != db_models.InvWaiting {
+			return errors.New("inbound already processed by warehouse")
+		}
+
+		return cancelInbound(tx, agent, invTx, db_models.NoteCancel, pay.Reason)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&warehouse_iface.InboundCancelResponse{}), nil
+}
@@ -2,14 +2,194 @@ package inbound
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"connectrpc.com/connect"
+	"github.com/pdcgo/schema/services/access_iface/v1"
 	"github.com/pdcgo/schema/services/warehouse_iface/v1"
+	"github.com/pdcgo/shared/db_models"
+	"github.com/pdcgo/shared/interfaces/authorization_iface"
+	"github.com/pdcgo/warehouse_service/warehouse_mutations"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
+func protoToPaymentType(paymentType warehouse_iface.PaymentType) db_models.RestockPaymentType {
+	switch paymentType {
+	case warehouse_iface.PaymentType_PAYMENT_TYPE_SHOPEEPAY:
+		return db_models.RestockPaymentShopeePay
+	case warehouse_iface.PaymentType_PAYMENT_TYPE_TRANSFER:
+		return db_models.RestockPaymentBankAccount
+	default:
+		return db_models.RestockPaymentNoPayment
+	}
+}
+
 // InboundCreate implements [warehouse_ifaceconnect.InboundServiceHandler].
 func (i *inboundServiceImpl) InboundCreate(
 	ctx context.Context,
 	req *connect.Request[warehouse_iface.InboundCreateRequest]) (*connect.Response[warehouse_iface.InboundCreateResponse], error) {
-	panic("unimplemented")
+	var err error
+
+	source, agent, err := i.checkAccess(ctx, req.Header(), authorization_iface.Create)
+	if err != nil {
+		return nil, err
+	}
+
+	pay := req.Msg
+	result := warehouse_iface.InboundCreateResponse{}
+
+	if pay.WarehouseId == 0 {
+		return nil, errors.New("warehouse_id empty")
+	}
+	if len(pay.Items) == 0 {
+		return nil, errors.New("inbound items empty")
+	}
+
+	// selling team only create inbound of its own team, warehouse create inbound of owner team
+	// in payload but only into its own warehouse
+	teamID := source.TeamId
+	switch source.RequestFrom {
+	case access_iface.RequestFrom_REQUEST_FROM_ADMIN:
+		teamID = pay.TeamId
+	case access_iface.RequestFrom_REQUEST_FROM_WAREHOUSE:
+		if pay.WarehouseId != source.TeamId {
+			return nil, errors.New("warehouse access error")
+		}
+		teamID = pay.TeamId
+	}
+	if teamID == 0 {
+		return nil, errors.New("team_id empty")
+	}
+
+	invTx := db_models.InvTransaction{
+		TeamID:      uint(teamID),
+		WarehouseID: uint(pay.WarehouseId),
+		CreateByID:  agent.GetUserID(),
+		Status:      db_models.InvWaiting,
+		Created:     time.Now(),
+	}
+
+	if pay.ShippingId != 0 {
+		shippingID := uint(pay.ShippingId)
+		invTx.ShippingID = &shippingID
+	}
+
+	var restockCost *db_models.RestockCost
+	switch info := pay.InboundInfo.(type) {
+	case *warehouse_iface.InboundCreateRequest_Restock:
+		invTx.Type = db_models.InvTxRestock
+		invTx.ExternOrdID = info.Restock.ExternOrdId
+		invTx.Receipt = info.Restock.Receipt
+		restockCost = &db_models.RestockCost{
+			PaymentType: protoToPaymentType(info.Restock.PaymentType),
+			ShippingFee: info.Restock.ShippingCost,
+		}
+
+	case *warehouse_iface.InboundCreateRequest_Return:
+		invTx.Type = db_models.InvTxReturn
+		invTx.ExternOrdID = info.Return.ExternOrdId
+		invTx.Receipt = info.Return.Receipt
+
+	default:
+		return nil, errors.New("inbound info not supported")
+	}
+
+	db := i.db.WithContext(ctx)
+	err = db.Transaction(func(tx *gorm.DB) error {
+		var err error
+
+		items := db_models.InvItemList{}
+		for _, item := range pay.Items {
+			if item.Count <= 0 {
+				return errors.New("item count must greater than zero")
+			}
+
+			sku := db_models.Sku{
+				WarehouseID: uint(pay.WarehouseId),
+				TeamID:      uint(teamID),
+				ProductID:   uint(item.ProductId),
+				VariantID:   uint(item.VariantId),
+			}
+			_, err = sku.CalculateID()
+			if err != nil {
+				return err
+			}
+
+			// sku created on first inbound to warehouse
+			err = tx.
+				Clauses(clause.OnConflict{DoNothing: true}).
+				Create(&sku).
+				Error
+			if err != nil {
+				return err
+			}
+
+			err = tx.
+				Model(&db_models.Sku{}).
+				Where("id = ?", sku.ID).
+				Update("stock_pending", gorm.Expr("stock_pending + ?", item.Count)).
+				Error
+			if err != nil {
+				return err
+			}
+
+			items = append(items, &db_models.InvTxItem{
+				SkuID: sku.ID,
+				Count: int(item.Count),
+				Price: item.Price,
+				Total: item.Price * float64(item.Count),
+			})
+		}
+
+		invTx.Total = items.Total()
+		err = tx.Create(&invTx).Error
+		if err != nil {
+			return err
+		}
+
+		for _, item := range items {
+			item.InvTransactionID = invTx.ID
+		}
+		err = tx.Create(&items).Error
+		if err != nil {
+			return err
+		}
+
+		if restockCost != nil {
+			restockCost.InvTransactionID = invTx.ID
+			restockCost.CalculatePerPiece(items.TotalCount())
+			err = tx.Create(restockCost).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		if pay.Note != "" {
+			err = tx.
+				Create(&db_models.InvNote{
+					InvTransactionID: invTx.ID,
+					NoteType:         db_models.NoteCommon,
+					NoteText:         pay.Note,
+				}).
+				Error
+			if err != nil {
+				return err
+			}
+		}
+
+		return warehouse_mutations.
+			NewTransactionLogNewEntry(tx, agent).
+			SetTxID(invTx.ID).
+			SetActionType(db_models.ActionChangeStatus).
+			SetStatus(db_models.InvWaiting).
+			Do()
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result.Id = uint64(invTx.ID)
+	return connect.NewResponse(&result), nil
 }
@@ -0,0 +1,89 @@
+package inbound
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/pdcgo/schema/services/access_iface/v1"
+	"github.com/pdcgo/schema/services/warehouse_iface/v1"
+	"github.com/pdcgo/shared/db_models"
+	"github.com/pdcgo/shared/interfaces/authorization_iface"
+)
+
+// InboundDetailSearch implements warehouse_ifaceconnect.InboundServiceHandler.
+func (i *inboundServiceImpl) InboundDetailSearch(
+	ctx context.Context,
+	req *connect.Request[warehouse_iface.InboundDetailSearchRequest],
+) (*connect.Response[warehouse_iface.InboundDetailSearchResponse], error) {
+	var err error
+
+	source, _, err := i.checkAccess(ctx, req.Header(), authorization_iface.Read)
+	if err != nil {
+		return nil, err
+	}
+
+	pay := req.Msg
+	db := i.db.WithContext(ctx)
+
+	query := db.
+		Model(&db_models.InvTransaction{}).
+		Where("type IN ?", inboundTypes).
+		Where("deleted != ?", true)
+
+	switch source.RequestFrom {
+	case access_iface.RequestFrom_REQUEST_FROM_ADMIN:
+	case access_iface.RequestFrom_REQUEST_FROM_WAREHOUSE:
+		query = query.Where("warehouse_id = ?", source.TeamId)
+	default:
+		query = query.Where("team_id = ?", source.TeamId)
+	}
+
+	q := strings.TrimSpace(pay.Q)
+	switch pay.SearchBy {
+	case warehouse_iface.SearchBy_SEARCH_BY_ID,
+		warehouse_iface.SearchBy_SEARCH_BY_UNSPECIFIED:
+		if pay.InvTransactionId == 0 {
+			return nil, errors.New("inv_transaction_id empty")
+		}
+		query = query.Where("id = ?", pay.InvTransactionId)
+
+	case warehouse_iface.SearchBy_SEARCH_BY_RECEIPT:
+		if q == "" {
+			return nil, errors.New("receipt empty")
+		}
+		query = query.Where("lower(receipt) = ?", strings.ToLower(q))
+
+	case warehouse_iface.SearchBy_SEARCH_BY_ORDERID:
+		if q == "" {
+			return nil, errors.New("order id empty")
+		}
+		query = query.Where("extern_ord_id = ?", q)
+
+	default:
+		return nil, errors.New("search by not supported")
+	}
+
+	invTx := db_models.InvTransaction{}
+	err = query.
+		Order("id desc").
+		First(&invTx).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	result := warehouse_iface.InboundDetailSearchResponse{
+		Inbound: &warehouse_iface.InboundDetail{
+			Id:          uint64(invTx.ID),
+			TeamId:      uint64(invTx.TeamID),
+			WarehouseId: uint64(invTx.WarehouseID),
+			Receipt:     invTx.Receipt,
+			Type:        string(invTx.Type),
+			Status:      string(invTx.Status),
+		},
+	}
+
+	return connect.NewResponse(&result), nil
+}
@@ -0,0 +1,29 @@
+package inbound
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+	"github.com/pdcgo/schema/services/warehouse_iface/v1"
+	"github.com/pdcgo/shared/interfaces/authorization_iface"
+)
+
+// InboundList implements [warehouse_ifaceconnect.InboundServiceHandler].
+//
+// InboundListRequest and InboundListResponse in schema still have no field (filter and
+// list item only described as comment), so after access checked unimplemented is returned
+// until schema carry them. Detail of single inbound available from InboundDetailSearch.
+func (i *inboundServiceImpl) InboundList(
+	ctx context.Context,
+	req *connect.Request[warehouse_iface.InboundListRequest],
+) (*connect.Response[warehouse_iface.InboundListResponse], error) {
+	var err error
+
+	_, _, err = i.checkAccess(ctx, req.Header(), authorization_iface.Read)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("inbound list request has no field in schema"))
+}
@@ -0,0 +1,59 @@
+package inbound
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+	"github.com/pdcgo/schema/services/access_iface/v1"
+	"github.com/pdcgo/schema/services/warehouse_iface/v1"
+	"github.com/pdcgo/shared/db_models"
+	"github.com/pdcgo/shared/interfaces/authorization_iface"
+	"gorm.io/gorm"
+)
+
+// InboundReject implements warehouse_ifaceconnect.InboundServiceHandler.
+//
+// Reject is done by warehouse when the inbound package refused on arrival.
+func (i *inboundServiceImpl) InboundReject(
+	ctx context.Context,
+	req *connect.Request[warehouse_iface.InboundRejectRequest],
+) (*connect.Response[warehouse_iface.InboundRejectResponse], error) {
+	var err error
+
+	source, agent, err := i.checkAccess(ctx, req.Header(), authorization_iface.Update)
+	if err != nil {
+		return nil, err
+	}
+
+	switch source.RequestFrom {
+	case access_iface.RequestFrom_REQUEST_FROM_WAREHOUSE,
+		access_iface.RequestFrom_REQUEST_FROM_ADMIN:
+	default:
+		return nil, errors.New("inbound reject only from warehouse")
+	}
+
+	pay := req.Msg
+	if pay.Reason == "" {
+		return nil, errors.New("reject reason empty")
+	}
+
+	db := i.db.WithContext(ctx)
+	err = db.Transaction(func(tx *gorm.DB) error {
+		invTx, err := getInbound(tx, source, pay.InvTransactionId)
+		if err != nil {
+			return err
+		}
+
+		if pay.WarehouseId != 0 && uint64(invTx.WarehouseID) != pay.WarehouseId {
+			return errors.New("inbound not in warehouse")
+		}
+
+		return cancelInbound(tx, agent, invTx, db_models.NoteReturn, pay.Reason)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&warehouse_iface.InboundRejectResponse{}), nil
+}
@@ -2,38 +2,187 @@ package inbound
 
 import (
 	"context"
+	"errors"
+	"net/http"
 
-	"connectrpc.com/connect"
-	"github.com/pdcgo/schema/services/warehouse_iface/v1"
+	"github.com/pdcgo/event_source"
+	"github.com/pdcgo/schema/services/access_iface/v1"
+	"github.com/pdcgo/shared/authorization"
+	"github.com/pdcgo/shared/custom_connect"
+	"github.com/pdcgo/shared/db_models"
 	"github.com/pdcgo/shared/interfaces/authorization_iface"
+	"github.com/pdcgo/warehouse_service/warehouse_mutations"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
+type TeamInvTransaction struct{}
+
+// GetEntityID implements authorization.Entity.
+func (t *TeamInvTransaction) GetEntityID() string {
+	return "team_inv_transaction"
+}
+
 type inboundServiceImpl struct {
-	db   *gorm.DB
-	auth authorization_iface.Authorization
+	db          *gorm.DB
+	auth        authorization_iface.Authorization
+	eventSender event_source.EventSender
 }
 
-// InboundList implements [warehouse_ifaceconnect.InboundServiceHandler].
-func (i *inboundServiceImpl) InboundList(context.Context, *connect.Request[warehouse_iface.InboundListRequest]) (*connect.Response[warehouse_iface.InboundListResponse], error) {
-	panic("unimplemented")
+func NewInboundService(
+	db *gorm.DB,
+	auth authorization_iface.Authorization,
+	eventSender event_source.EventSender,
+) *inboundServiceImpl {
+	return &inboundServiceImpl{db, auth, eventSender}
 }
 
-// InboundCancel implements warehouse_ifaceconnect.InboundServiceHandler.
-func (i *inboundServiceImpl) InboundCancel(context.Context, *connect.Request[warehouse_iface.InboundCancelRequest]) (*connect.Response[warehouse_iface.InboundCancelResponse], error) {
-	panic("unimplemented")
+var inboundTypes = []db_models.InvTxType{
+	db_models.InvTxRestock,
+	db_models.InvTxAdjRestock,
+	db_models.InvTxReturn,
+	db_models.InvTxTransferIn,
 }
 
-// InboundDetailSearch implements warehouse_ifaceconnect.InboundServiceHandler.
-func (i *inboundServiceImpl) InboundDetailSearch(context.Context, *connect.Request[warehouse_iface.InboundDetailSearchRequest]) (*connect.Response[warehouse_iface.InboundDetailSearchResponse], error) {
-	panic("unimplemented")
+// checkAccess resolving request source and checking team_inv_transaction permission on that source domain.
+func (i *inboundServiceImpl) checkAccess(
+	ctx context.Context,
+	header http.Header,
+	action authorization_iface.Action,
+) (*access_iface.RequestSource, authorization_iface.Identity, error) {
+	source, err := custom_connect.GetRequestSource(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	identity := i.
+		auth.
+		AuthIdentityFromHeader(header)
+
+	err = identity.Err()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var domainID uint
+	switch source.RequestFrom {
+	case access_iface.RequestFrom_REQUEST_FROM_ADMIN:
+		domainID = uint(authorization.RootDomain)
+	case access_iface.RequestFrom_REQUEST_FROM_WAREHOUSE:
+		domainID = uint(source.TeamId)
+	default:
+		domainID = uint(source.TeamId)
+	}
+
+	err = identity.
+		HasPermission(authorization_iface.CheckPermissionGroup{
+			&TeamInvTransaction{}: &authorization_iface.CheckPermission{
+				DomainID: domainID,
+				Actions:  []authorization_iface.Action{action},
+			},
+		}).
+		Err()
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return source, identity.Identity(), nil
 }
 
-// InboundReject implements warehouse_ifaceconnect.InboundServiceHandler.
-func (i *inboundServiceImpl) InboundReject(context.Context, *connect.Request[warehouse_iface.InboundRejectRequest]) (*connect.Response[warehouse_iface.InboundRejectResponse], error) {
-	panic("unimplemented")
+// getInbound locking inbound transaction and checking that transaction is visible from request source.
+func getInbound(tx *gorm.DB, source *access_iface.RequestSource, txID uint64) (*db_models.InvTransaction, error) {
+	invTx := db_models.InvTransaction{}
+
+	err := tx.
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Model(&db_models.InvTransaction{}).
+		Where("type IN ?", inboundTypes).
+		Where("deleted != ?", true).
+		First(&invTx, txID).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	switch source.RequestFrom {
+	case access_iface.RequestFrom_REQUEST_FROM_ADMIN:
+	case access_iface.RequestFrom_REQUEST_FROM_WAREHOUSE:
+		if uint64(invTx.WarehouseID) != source.TeamId {
+			return nil, errors.New("warehouse access error")
+		}
+	default:
+		if uint64(invTx.TeamID) != source.TeamId {
+			return nil, errors.New("team access error")
+		}
+	}
+
+	err = tx.
+		Model(&db_models.InvTxItem{}).
+		Where("inv_transaction_id = ?", invTx.ID).
+		Find(&invTx.Items).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &invTx, nil
 }
 
-func NewInboundService(db *gorm.DB, auth authorization_iface.Authorization) *inboundServiceImpl {
-	return &inboundServiceImpl{db, auth}
+// cancelInbound canceling inbound and releasing stock pending that reserved on create.
+func cancelInbound(
+	tx *gorm.DB,
+	agent authorization_iface.Identity,
+	invTx *db_models.InvTransaction,
+	noteType db_models.NoteType,
+	reason string,
+) error {
+	var err error
+
+	switch invTx.Status {
+	case db_models.InvWaiting, db_models.InvTxOngoing:
+	default:
+		return errors.New("inbound status cannot canceled")
+	}
+
+	for _, item := range invTx.Items {
+		err = tx.
+			Model(&db_models.Sku{}).
+			Where("id = ?", item.SkuID).
+			Update("stock_pending", gorm.Expr("stock_pending - ?", item.Count)).
+			Error
+		if err != nil {
+			return err
+		}
+	}
+
+	err = tx.
+		Model(&db_models.InvTransaction{}).
+		Where("id = ?", invTx.ID).
+		Update("status", db_models.InvTxCancel).
+		Error
+	if err != nil {
+		return err
+	}
+	invTx.Status = db_models.InvTxCancel
+
+	if reason != "" {
+		err = tx.
+			Create(&db_models.InvNote{
+				InvTransactionID: invTx.ID,
+				NoteType:         noteType,
+				NoteText:         reason,
+			}).
+			Error
+		if err != nil {
+			return err
+		}
+	}
+
+	return warehouse_mutations.
+		NewTransactionLogNewEntry(tx, agent).
+		SetTxID(invTx.ID).
+		SetActionType(db_models.ActionChangeStatus).
+		SetStatus(db_models.InvTxCancel).
+		Do()
 }
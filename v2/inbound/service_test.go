@@ -0,0 +1,191 @@
+package inbound_test
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/pdcgo/event_source"
+	"github.com/pdcgo/schema/services/access_iface/v1"
+	"github.com/pdcgo/schema/services/warehouse_iface/v1"
+	"github.com/pdcgo/shared/authorization/authorization_mock"
+	"github.com/pdcgo/shared/custom_connect"
+	"github.com/pdcgo/shared/db_models"
+	"github.com/pdcgo/shared/pkg/moretest"
+	"github.com/pdcgo/shared/pkg/moretest/moretest_mock"
+	"github.com/pdcgo/warehouse_service/v2/inbound"
+	"github.com/pdcgo/warehouse_service/warehouse_models"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestInboundService(t *testing.T) {
+	var db gorm.DB
+
+	var migrate moretest.SetupFunc = func(t *testing.T) func() error {
+		err := db.AutoMigrate(
+			&db_models.Sku{},
+			&db_models.InvTransaction{},
+			&db_models.InvTxItem{},
+			&db_models.RestockCost{},
+			&db_models.InvNote{},
+			&db_models.InvTimestamp{},
+			&db_models.Rack{},
+			&db_models.Placement{},
+			&db_models.InvertoryHistory{},
+			&warehouse_models.InvItemProblem{},
+		)
+		assert.Nil(t, err)
+
+		err = db.Create(&db_models.Rack{ID: 1, WarehouseID: 1, Name: "A1"}).Error
+		assert.Nil(t, err)
+
+		return nil
+	}
+
+	moretest.Suite(t, "testing inbound service",
+		moretest.SetupListFunc{
+			moretest_mock.MockSqliteDatabase(&db),
+			migrate,
+		},
+		func(t *testing.T) {
+			service := inbound.NewInboundService(&db, &authorization_mock.EmptyAuthorizationMock{
+				AuthIdentityMock: &authorization_mock.AuthIdentityMock{
+					IdentityMock: &authorization_mock.IdentityMock{ID: 1},
+				},
+			}, event_source.EmptySender)
+
+			sellingCtx := context.WithValue(context.TODO(), custom_connect.SourceKey, &access_iface.RequestSource{
+				TeamId:      2,
+				RequestFrom: access_iface.RequestFrom_REQUEST_FROM_SELLING,
+			})
+			warehouseCtx := context.WithValue(context.TODO(), custom_connect.SourceKey, &access_iface.RequestSource{
+				TeamId:      1,
+				RequestFrom: access_iface.RequestFrom_REQUEST_FROM_WAREHOUSE,
+			})
+
+			create := func(t *testing.T) uint64 {
+				res, err := service.InboundCreate(sellingCtx, connect.NewRequest(&warehouse_iface.InboundCreateRequest{
+					WarehouseId: 1,
+					InboundInfo: &warehouse_iface.InboundCreateRequest_Restock{
+						Restock: &warehouse_iface.RestockInfo{
+							ExternOrdId: "ord-1",
+						},
+					},
+					Items: []*warehouse_iface.InboundItem{
+						{ProductId: 1, VariantId: 1, Count: 5, Price: 1000},
+					},
+				}))
+				assert.Nil(t, err)
+				return res.Msg.Id
+			}
+
+			accept := func(ctx context.Context, txID uint64) error {
+				_, err := service.InboundAccept(ctx, connect.NewRequest(&warehouse_iface.InboundAcceptRequest{
+					InvTransactionId: txID,
+					WarehouseId:      1,
+					Placement: map[string]*warehouse_iface.PlacementList{
+						skuID(t): {
+							List: []*warehouse_iface.PlacementItem{
+								{RackId: 1, Count: 5},
+							},
+						},
+					},
+				}))
+				return err
+			}
+
+			t.Run("test seller cannot accept own inbound", func(t *testing.T) {
+				txID := create(t)
+
+				err := accept(sellingCtx, txID)
+				assert.NotNil(t, err)
+
+				invTx := db_models.InvTransaction{}
+				err = db.First(&invTx, txID).Error
+				assert.Nil(t, err)
+				assert.Equal(t, db_models.InvWaiting, invTx.Status)
+
+				t.Run("test warehouse accept", func(t *testing.T) {
+					err := accept(warehouseCtx, txID)
+					assert.Nil(t, err)
+
+					sku := db_models.Sku{}
+					err = db.First(&sku, "id = ?", skuID(t)).Error
+					assert.Nil(t, err)
+					assert.Equal(t, 5, sku.StockReady)
+					assert.Equal(t, 0, sku.StockPending)
+
+					// in stock history is negative count, so reconciliation sum match stock total
+					var historyCount int
+					err = db.
+						Model(&db_models.InvertoryHistory{}).
+						Select("coalesce(sum(count * -1), 0)").
+						Where("tx_id is null and sku_id = ?", skuID(t)).
+						Scan(&historyCount).
+						Error
+					assert.Nil(t, err)
+					assert.Equal(t, sku.StockTotal, historyCount)
+				})
+			})
+
+			t.Run("test warehouse create for owner team", func(t *testing.T) {
+				payload := &warehouse_iface.InboundCreateRequest{
+					TeamId:      2,
+					WarehouseId: 1,
+					InboundInfo: &warehouse_iface.InboundCreateRequest_Return{
+						Return: &warehouse_iface.ReturnInfo{
+							ExternOrdId: "ord-2",
+						},
+					},
+					Items: []*warehouse_iface.InboundItem{
+						{ProductId: 1, VariantId: 1, Count: 1, Price: 1000},
+					},
+				}
+
+				res, err := service.InboundCreate(warehouseCtx, connect.NewRequest(payload))
+				assert.Nil(t, err)
+
+				invTx := db_models.InvTransaction{}
+				err = db.First(&invTx, res.Msg.Id).Error
+				assert.Nil(t, err)
+				assert.Equal(t, uint(2), invTx.TeamID)
+				assert.Equal(t, uint(1), invTx.WarehouseID)
+
+				t.Run("test other warehouse rejected", func(t *testing.T) {
+					payload.WarehouseId = 3
+					_, err := service.InboundCreate(warehouseCtx, connect.NewRequest(payload))
+					assert.NotNil(t, err)
+				})
+			})
+
+			t.Run("test list unimplemented", func(t *testing.T) {
+				_, err := service.InboundList(sellingCtx, connect.NewRequest(&warehouse_iface.InboundListRequest{}))
+				assert.Equal(t, connect.CodeUnimplemented, connect.CodeOf(err))
+			})
+
+			t.Run("test warehouse reject", func(t *testing.T) {
+				txID := create(t)
+
+				_, err := service.InboundReject(warehouseCtx, connect.NewRequest(&warehouse_iface.InboundRejectRequest{
+					InvTransactionId: txID,
+					WarehouseId:      1,
+					Reason:           "package broken",
+				}))
+				assert.Nil(t, err)
+
+				invTx := db_models.InvTransaction{}
+				err = db.First(&invTx, txID).Error
+				assert.Nil(t, err)
+				assert.Equal(t, db_models.InvTxCancel, invTx.Status)
+			})
+		},
+	)
+}
+
+func skuID(t *testing.T) string {
+	sku := db_models.Sku{WarehouseID: 1, TeamID: 2, ProductID: 1, VariantID: 1}
+	id, err := sku.CalculateID()
+	assert.Nil(t, err)
+	return string(id)
+}
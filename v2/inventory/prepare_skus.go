@@ -7,6 +7,7 @@ import (
 	"connectrpc.com/connect"
 	"github.com/pdcgo/schema/services/warehouse_iface/v1"
 	"github.com/pdcgo/shared/db_models"
+	"github.com/pdcgo/warehouse_service/warehouse_query"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 )
@@ -49,7 +50,12 @@ func (i *inventoryServiceImpl) PrepareSkus(
 		}
 
 		// preparing daily stock history if not exists
+		locs := warehouse_query.NewWarehouseLocationCache(tx)
 		for _, sku := range skuLocks {
+			loc, err := locs.Get(uint(sku.WarehouseID))
+			if err != nil {
+				return err
+			}
 
 			initCount := gorm.Expr(
 				`
@@ -89,6 +95,7 @@ func (i *inventoryServiceImpl) PrepareSkus(
 
 			params := map[string]interface{}{
 				"t":            time.Now(),
+				"tz":           loc.String(),
 				"sku_id":       sku.ID,
 				"warehouse_id": sku.WarehouseID,
 				"init_count":   initCount,
@@ -106,7 +113,7 @@ func (i *inventoryServiceImpl) PrepareSkus(
 						end_stock_amount
 					)
 					values (
-						date_trunc('day', @t ::timestamptz AT TIME ZONE 'Asia/Jakarta'), 
+						date_trunc('day', @t ::timestamptz AT TIME ZONE @tz), 
 						@sku_id, 
 						@warehouse_id, 
 						(@init_count), 
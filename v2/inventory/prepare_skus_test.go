@@ -30,6 +30,7 @@ func TestPrepareSkus(t *testing.T) {
 				err = tx.AutoMigrate(
 					&db_models.Sku{},
 					&warehouse_models.DailySkuHistory{},
+					&warehouse_models.WarehouseTimezone{},
 					&db_models.InvertoryHistory{},
 				)
 				assert.NoError(t, err)
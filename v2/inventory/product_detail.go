@@ -0,0 +1,307 @@
+package inventory
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/pdcgo/schema/services/access_iface/v1"
+	"github.com/pdcgo/schema/services/warehouse_iface/v1"
+	"github.com/pdcgo/shared/authorization"
+	"github.com/pdcgo/shared/custom_connect"
+	"github.com/pdcgo/shared/db_models"
+	"github.com/pdcgo/shared/interfaces/authorization_iface"
+	warehouse_service_iface "github.com/pdcgo/warehouse_service/services/warehouse_service/v1"
+	"github.com/pdcgo/warehouse_service/warehouse_models"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+const ProductOutboundDefaultDays = 30
+
+// GetProductDetail getting product with all variant sku in warehouse. outbound is counted from
+// outboundSince.
+func GetProductDetail(db *gorm.DB, warehouseID, productID uint64, outboundSince time.Time) (*warehouse_service_iface.ProductStockDetailResponse, error) {
+	var err error
+
+	product := db_models.Product{}
+	err = db.
+		Model(&db_models.Product{}).
+		Where("id = ?", productID).
+		First(&product).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	result := warehouse_service_iface.ProductStockDetailResponse{
+		ProductId:   productID,
+		WarehouseId: warehouseID,
+		TeamId:      uint64(product.TeamID),
+		Name:        product.Name,
+		RefId:       string(product.RefID),
+		Variants:    []*warehouse_service_iface.ProductStockVariant{},
+	}
+	if len(product.Image) > 0 {
+		result.Image = product.Image[0]
+	}
+
+	skus := []*db_models.Sku{}
+	err = db.
+		Model(&db_models.Sku{}).
+		Where("warehouse_id = ?", warehouseID).
+		Where("product_id = ?", productID).
+		Order("variant_id asc").
+		Find(&skus).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	if len(skus) == 0 {
+		return &result, nil
+	}
+
+	skuMap := map[db_models.SkuID]*warehouse_service_iface.ProductStockVariant{}
+	variantMap := map[uint64]*warehouse_service_iface.ProductStockVariant{}
+	skuIDs := []db_models.SkuID{}
+	varIDs := []uint64{}
+	for _, sku := range skus {
+		variant := &warehouse_service_iface.ProductStockVariant{
+			SkuId:         string(sku.ID),
+			VariantId:     uint64(sku.VariantID),
+			StockReady:    int64(sku.StockReady),
+			StockPending:  int64(sku.StockPending),
+			IsBlacklisted: sku.IsBlacklisted,
+			Racks:         []*warehouse_service_iface.ProductStockRack{},
+		}
+
+		result.Variants = append(result.Variants, variant)
+		skuMap[sku.ID] = variant
+		variantMap[uint64(sku.VariantID)] = variant
+		skuIDs = append(skuIDs, sku.ID)
+		varIDs = append(varIDs, uint64(sku.VariantID))
+	}
+
+	// preloading variant
+	variants := []*db_models.VariationValue{}
+	err = db.
+		Model(&db_models.VariationValue{}).
+		Where("id in ?", varIDs).
+		Find(&variants).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, vv := range variants {
+		if variant := variantMap[uint64(vv.ID)]; variant != nil {
+			variant.VariantRefId = string(vv.RefID)
+		}
+	}
+
+	// preloading placement per rack
+	placements := []*struct {
+		SkuID    db_models.SkuID
+		RackID   uint64
+		RackName string
+		Count    int64
+	}{}
+	err = db.
+		Table("placements p").
+		Joins("join racks r on r.id = p.rack_id").
+		Where("p.sku_id in ?", skuIDs).
+		Where("p.count > 0").
+		Where("r.deleted != ?", true).
+		Select([]string{
+			"p.sku_id",
+			"p.rack_id",
+			"r.name as rack_name",
+			"p.count",
+		}).
+		Order("r.name asc").
+		Find(&placements).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, placement := range placements {
+		variant := skuMap[placement.SkuID]
+		variant.Racks = append(variant.Racks, &warehouse_service_iface.ProductStockRack{
+			RackId:    placement.RackID,
+			RackName:  placement.RackName,
+			ItemCount: placement.Count,
+		})
+	}
+
+	// stock value from latest daily history of each sku
+	latest := db.
+		Model(&warehouse_models.DailySkuHistory{}).
+		Select([]string{"sku_id", "MAX(t) as t"}).
+		Where("warehouse_id = ?", warehouseID).
+		Where("sku_id in ?", skuIDs).
+		Group("sku_id")
+
+	histories := []*warehouse_models.DailySkuHistory{}
+	err = db.
+		Model(&warehouse_models.DailySkuHistory{}).
+		Joins("JOIN (?) latest ON latest.sku_id = daily_sku_histories.sku_id AND latest.t = daily_sku_histories.t", latest).
+		Where("daily_sku_histories.warehouse_id = ?", warehouseID).
+		Find(&histories).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, hist := range histories {
+		variant := skuMap[hist.SkuID]
+		if variant == nil {
+			continue
+		}
+
+		variant.StockValue = hist.EndStockAmount
+		variant.StockValueAt = timestamppb.New(hist.T)
+		result.StockValue += hist.EndStockAmount
+	}
+
+	// outbound volume, same aggregation as OutboundByProduct
+	outbounds := []*struct {
+		VariationID uint64
+		TxCount     int64
+		ItemCount   int64
+	}{}
+	err = db.
+		Table("orders o").
+		Joins("JOIN order_items oi ON oi.order_id = o.id").
+		Where("o.invertory_tx_id is not null").
+		Where("oi.product_id = ?", productID).
+		Where("o.created_at >= ?", outboundSince).
+		Where("EXISTS (?)",
+			db.
+				Table("inv_transactions it").
+				Where("it.id = o.invertory_tx_id").
+				Where("it.warehouse_id = ?", warehouseID).
+				Select("1"),
+		).
+		Select([]string{
+			"oi.variation_id as variation_id",
+			"count(oi.order_id) as tx_count",
+			"sum(oi.count) as item_count",
+		}).
+		Group("oi.variation_id").
+		Find(&outbounds).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, outbound := range outbounds {
+		if variant := variantMap[outbound.VariationID]; variant != nil {
+			variant.OutboundTxCount = outbound.TxCount
+			variant.OutboundItemCount = outbound.ItemCount
+		}
+	}
+
+	return &result, nil
+}
+
+// checkProductRead checking read permission of product on request source domain.
+func (i *inventoryServiceImpl) checkProductRead(ctx context.Context, req connect.AnyRequest) (*access_iface.RequestSource, error) {
+	source, err := custom_connect.GetRequestSource(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	identity := i.
+		auth.
+		AuthIdentityFromHeader(req.Header())
+
+	err = identity.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	var domainID uint
+	switch source.RequestFrom {
+	case access_iface.RequestFrom_REQUEST_FROM_ADMIN:
+		domainID = authorization.RootDomain
+	default:
+		domainID = uint(source.TeamId)
+	}
+
+	err = identity.
+		HasPermission(authorization_iface.CheckPermissionGroup{
+			&db_models.Product{}: &authorization_iface.CheckPermission{
+				DomainID: domainID,
+				Actions:  []authorization_iface.Action{authorization_iface.Read},
+			},
+		}).
+		Err()
+	if err != nil {
+		return nil, err
+	}
+
+	return source, nil
+}
+
+// ProductDetail implements warehouse_ifaceconnect.InventoryServiceHandler.
+//
+// ProductDetailRequest and ProductDetailResponse in schema still have no field, so after access
+// checked unimplemented is returned. detail is served by InventoryDetailService ProductStockDetail.
+func (i *inventoryServiceImpl) ProductDetail(
+	ctx context.Context,
+	req *connect.Request[warehouse_iface.ProductDetailRequest],
+) (*connect.Response[warehouse_iface.ProductDetailResponse], error) {
+	_, err := i.checkProductRead(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("product detail request has no field in schema, use ProductStockDetail"))
+}
+
+// ProductStockDetail implements warehouse_service_ifaceconnect.InventoryDetailServiceHandler.
+func (i *inventoryServiceImpl) ProductStockDetail(
+	ctx context.Context,
+	req *connect.Request[warehouse_service_iface.ProductStockDetailRequest],
+) (*connect.Response[warehouse_service_iface.ProductStockDetailResponse], error) {
+	source, err := i.checkProductRead(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	pay := req.Msg
+	if source.RequestFrom == access_iface.RequestFrom_REQUEST_FROM_WAREHOUSE && pay.WarehouseId != source.TeamId {
+		return nil, connect.NewError(connect.CodePermissionDenied, errors.New("warehouse access error"))
+	}
+
+	days := pay.OutboundDays
+	if days == 0 {
+		days = ProductOutboundDefaultDays
+	}
+
+	result, err := GetProductDetail(
+		i.db.WithContext(ctx),
+		pay.WarehouseId,
+		pay.ProductId,
+		time.Now().AddDate(0, 0, -int(days)),
+	)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		return nil, err
+	}
+
+	switch source.RequestFrom {
+	case access_iface.RequestFrom_REQUEST_FROM_ADMIN, access_iface.RequestFrom_REQUEST_FROM_WAREHOUSE:
+	default:
+		if result.TeamId != source.TeamId {
+			return nil, connect.NewError(connect.CodePermissionDenied, errors.New("team access error"))
+		}
+	}
+
+	return connect.NewResponse(result), nil
+}
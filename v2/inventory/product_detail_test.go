@@ -0,0 +1,117 @@
+package inventory_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pdcgo/shared/db_models"
+	"github.com/pdcgo/shared/pkg/moretest"
+	"github.com/pdcgo/shared/pkg/moretest/moretest_mock"
+	"github.com/pdcgo/warehouse_service/v2/inventory"
+	"github.com/pdcgo/warehouse_service/warehouse_models"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestGetProductDetail(t *testing.T) {
+	var db gorm.DB
+
+	var migrate moretest.SetupFunc = func(t *testing.T) func() error {
+		err := db.AutoMigrate(
+			&db_models.Product{},
+			&db_models.VariationValue{},
+			&db_models.Sku{},
+			&db_models.Rack{},
+			&db_models.Placement{},
+			&db_models.InvTransaction{},
+			&db_models.Order{},
+			&db_models.OrderItem{},
+			&warehouse_models.DailySkuHistory{},
+		)
+		assert.Nil(t, err)
+
+		return nil
+	}
+
+	now := time.Now()
+	var seed moretest.SetupFunc = func(t *testing.T) func() error {
+		err := db.Create(&db_models.Product{
+			ID:     1,
+			TeamID: 1,
+			Name:   "kaos",
+			Image:  []string{"kaos.png"},
+		}).Error
+		assert.Nil(t, err)
+
+		err = db.Create(&[]db_models.VariationValue{
+			{ID: 1, ProductID: 1, RefID: "ref-1"},
+			{ID: 2, ProductID: 1, RefID: "ref-2"},
+		}).Error
+		assert.Nil(t, err)
+
+		err = db.Create(&[]db_models.Sku{
+			{ID: "sku-1", ProductID: 1, VariantID: 1, TeamID: 1, WarehouseID: 1, StockReady: 8},
+			{ID: "sku-2", ProductID: 1, VariantID: 2, TeamID: 1, WarehouseID: 1, IsBlacklisted: true},
+		}).Error
+		assert.Nil(t, err)
+
+		err = db.Create(&db_models.Rack{ID: 1, WarehouseID: 1, Name: "A1"}).Error
+		assert.Nil(t, err)
+
+		err = db.Create(&db_models.Placement{RackID: 1, SkuID: "sku-1", Count: 8}).Error
+		assert.Nil(t, err)
+
+		err = db.Create(&[]warehouse_models.DailySkuHistory{
+			{T: now.AddDate(0, 0, -1), SkuID: "sku-1", WarehouseID: 1, EndStockCount: 10, EndStockAmount: 10000},
+			{T: now, SkuID: "sku-1", WarehouseID: 1, EndStockCount: 8, EndStockAmount: 8000},
+		}).Error
+		assert.Nil(t, err)
+
+		err = db.Create(&db_models.InvTransaction{ID: 1, TeamID: 1, WarehouseID: 1}).Error
+		assert.Nil(t, err)
+
+		txID := uint(1)
+		err = db.Create(&db_models.Order{
+			ID:            1,
+			TeamID:        1,
+			InvertoryTxID: &txID,
+			CreatedAt:     now,
+		}).Error
+		assert.Nil(t, err)
+
+		err = db.Create(&db_models.OrderItem{OrderID: 1, ProductID: 1, VariationID: 1, Count: 2}).Error
+		assert.Nil(t, err)
+
+		return nil
+	}
+
+	moretest.Suite(t, "TestGetProductDetail",
+		moretest.SetupListFunc{
+			moretest_mock.MockSqliteDatabase(&db),
+			migrate,
+			seed,
+		},
+		func(t *testing.T) {
+			detail, err := inventory.GetProductDetail(&db, 1, 1, now.AddDate(0, 0, -7))
+			assert.NoError(t, err)
+
+			assert.Equal(t, "kaos", detail.Name)
+			assert.Equal(t, "kaos.png", detail.Image)
+			assert.Equal(t, float64(8000), detail.StockValue)
+			assert.Len(t, detail.Variants, 2)
+
+			sku1 := detail.Variants[0]
+			assert.Equal(t, "ref-1", sku1.VariantRefId)
+			assert.Len(t, sku1.Racks, 1)
+			assert.Equal(t, "A1", sku1.Racks[0].RackName)
+			assert.Equal(t, int64(2), sku1.OutboundItemCount)
+			assert.Equal(t, int64(1), sku1.OutboundTxCount)
+			assert.Equal(t, float64(8000), sku1.StockValue)
+			assert.Equal(t, now.Unix(), sku1.StockValueAt.AsTime().Unix())
+
+			sku2 := detail.Variants[1]
+			assert.True(t, sku2.IsBlacklisted)
+			assert.Len(t, sku2.Racks, 0)
+		},
+	)
+}
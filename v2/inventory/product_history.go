@@ -2,18 +2,228 @@ package inventory
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"connectrpc.com/connect"
+	"github.com/pdcgo/schema/services/access_iface/v1"
 	"github.com/pdcgo/schema/services/warehouse_iface/v1"
+	"github.com/pdcgo/shared/authorization"
+	"github.com/pdcgo/shared/custom_connect"
+	"github.com/pdcgo/shared/db_connect"
+	"github.com/pdcgo/shared/db_models"
+	"github.com/pdcgo/shared/interfaces/authorization_iface"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
 )
 
+type productHistoryItem struct {
+	ID            int64
+	SkuID         string
+	WarehouseID   int64
+	TeamID        int64
+	ActorID       int64
+	TransactionID int64
+	ChangeCount   int64
+	Type          warehouse_iface.StockChangeType
+	TransactionAt time.Time
+	Balance       int64
+	Receipt       string
+	TxStatus      string
+	TxCreated     *time.Time
+	TxArrived     *time.Time
+}
+
 // ProductHistory implements warehouse_ifaceconnect.InventoryServiceHandler.
-func (i *inventoryServiceImpl) ProductHistory(ctx context.Context, req *connect.Request[warehouse_iface.ProductHistoryRequest]) (*connect.Response[warehouse_iface.ProductHistoryResponse], error) {
+//
+// ProductHistory is ledger of stock_change_logs of sku, newest first. StockTotal is running
+// balance of sku in its warehouse after the change.
+func (i *inventoryServiceImpl) ProductHistory(
+	ctx context.Context,
+	req *connect.Request[warehouse_iface.ProductHistoryRequest],
+) (*connect.Response[warehouse_iface.ProductHistoryResponse], error) {
 	var err error
-	// pay := req.Msg
-	result := warehouse_iface.ProductHistoryResponse{}
+	pay := req.Msg
+	source, err := custom_connect.GetRequestSource(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	identity := i.
+		auth.
+		AuthIdentityFromHeader(req.Header())
+
+	err = identity.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	var domainID uint
+	switch source.RequestFrom {
+	case access_iface.RequestFrom_REQUEST_FROM_ADMIN:
+		domainID = authorization.RootDomain
+	default:
+		domainID = uint(source.TeamId)
+	}
+
+	err = identity.
+		HasPermission(authorization_iface.CheckPermissionGroup{
+			&db_models.Product{}: &authorization_iface.CheckPermission{
+				DomainID: domainID,
+				Actions:  []authorization_iface.Action{authorization_iface.Read},
+			},
+		}).
+		Err()
+	if err != nil {
+		return nil, err
+	}
+
+	filterPay := pay.Filter
+	if filterPay == nil || filterPay.SkuId == "" {
+		return nil, errors.New("sku_id empty")
+	}
+
+	var changeType warehouse_iface.StockChangeType
+	if filterPay.Type != "" {
+		value, ok := warehouse_iface.StockChangeType_value[filterPay.Type]
+		if !ok {
+			return nil, errors.New("stock change type not supported")
+		}
+		changeType = warehouse_iface.StockChangeType(value)
+	}
+
+	db := i.db.WithContext(ctx)
+	result := warehouse_iface.ProductHistoryResponse{
+		Data: []*warehouse_iface.HistoryItem{},
+	}
+
+	_, err = db_connect.NewQueryChain(db,
+		func(db *gorm.DB, next db_connect.NextFunc) db_connect.NextFunc { // base query with running balance
+			return func(query *gorm.DB) (*gorm.DB, error) {
+				ledger := db.
+					Table("stock_change_logs scl").
+					Where("scl.sku_id = ?", filterPay.SkuId).
+					Select([]string{
+						"scl.id",
+						"scl.sku_id",
+						"scl.warehouse_id",
+						"scl.actor_id",
+						"scl.transaction_id",
+						"scl.change_count",
+						"scl.type",
+						"scl.transaction_at",
+						"sum(scl.change_count) over (partition by scl.sku_id, scl.warehouse_id order by scl.transaction_at, scl.id) as balance",
+					})
+
+				return next(
+					query.
+						Table("(?) as l", ledger).
+						Joins("join skus s on s.id = l.sku_id"),
+				)
+			}
+		},
+		func(db *gorm.DB, next db_connect.NextFunc) db_connect.NextFunc { // filtering scope
+			return func(query *gorm.DB) (*gorm.DB, error) {
+				switch source.RequestFrom {
+				case access_iface.RequestFrom_REQUEST_FROM_ADMIN:
+				case access_iface.RequestFrom_REQUEST_FROM_WAREHOUSE:
+					query = query.Where("s.warehouse_id = ?", source.TeamId)
+				default:
+					query = query.Where("s.team_id = ?", source.TeamId)
+				}
+
+				return next(query)
+			}
+		},
+		func(db *gorm.DB, next db_connect.NextFunc) db_connect.NextFunc { // filter type
+			return func(query *gorm.DB) (*gorm.DB, error) {
+				if filterPay.Type == "" {
+					return next(query)
+				}
+
+				return next(
+					query.
+						Where("l.type = ?", changeType),
+				)
+			}
+		},
+		func(db *gorm.DB, next db_connect.NextFunc) db_connect.NextFunc { // paginated
+			return func(query *gorm.DB) (*gorm.DB, error) {
+				var queryPaginated *gorm.DB
+				queryPaginated, result.PageInfo, err = db_connect.SetPaginationQuery(db, func() (*gorm.DB, error) {
+					return query.Session(&gorm.Session{}), nil
+
+				}, pay.Page)
+
+				if err != nil {
+					return query, err
+				}
+
+				return next(
+					queryPaginated,
+				)
+			}
+		},
+		func(db *gorm.DB, next db_connect.NextFunc) db_connect.NextFunc { // sorting data
+			return func(query *gorm.DB) (*gorm.DB, error) {
+				return next(
+					query.Order("l.transaction_at desc").Order("l.id desc"),
+				)
+			}
+		},
+		func(db *gorm.DB, next db_connect.NextFunc) db_connect.NextFunc { // getting data
+			return func(query *gorm.DB) (*gorm.DB, error) {
+				items := []*productHistoryItem{}
+				err = query.
+					Joins("left join inv_transactions it on it.id = l.transaction_id").
+					Select([]string{
+						"l.*",
+						"s.team_id",
+						"it.receipt",
+						"it.status as tx_status",
+						"it.created as tx_created",
+						"it.arrived as tx_arrived",
+					}).
+					Find(&items).
+					Error
+
+				if err != nil {
+					return nil, err
+				}
+
+				for _, item := range items {
+					data := warehouse_iface.HistoryItem{
+						Id:            uint64(item.TransactionID),
+						UserId:        uint64(item.ActorID),
+						TeamId:        uint64(item.TeamID),
+						Status:        item.TxStatus,
+						Type:          item.Type.String(),
+						Receipt:       item.Receipt,
+						Quantity:      item.ChangeCount,
+						StockTotal:    item.Balance,
+						TxCreatedTime: timestamppb.New(item.TransactionAt),
+					}
+
+					if item.TxCreated != nil {
+						data.OrderCreatedTime = timestamppb.New(*item.TxCreated)
+					}
+					if item.TxArrived != nil {
+						data.ArrivedTime = timestamppb.New(*item.TxArrived)
+					}
+
+					result.Data = append(result.Data, &data)
+				}
+
+				return next(
+					query,
+				)
+			}
+		},
+	)
 
-	// db := i.db.WithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
 
-	return connect.NewResponse(&result), err
+	return connect.NewResponse(&result), nil
 }
@@ -0,0 +1,174 @@
+package inventory_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/pdcgo/schema/services/access_iface/v1"
+	"github.com/pdcgo/schema/services/common/v1"
+	"github.com/pdcgo/schema/services/warehouse_iface/v1"
+	"github.com/pdcgo/shared/authorization/authorization_mock"
+	"github.com/pdcgo/shared/custom_connect"
+	"github.com/pdcgo/shared/db_models"
+	"github.com/pdcgo/shared/pkg/moretest"
+	"github.com/pdcgo/shared/pkg/moretest/moretest_mock"
+	"github.com/pdcgo/warehouse_service/v2/inventory"
+	"github.com/pdcgo/warehouse_service/warehouse_models"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestProductHistory(t *testing.T) {
+	var db gorm.DB
+
+	var migrate moretest.SetupFunc = func(t *testing.T) func() error {
+		err := db.AutoMigrate(
+			&db_models.Sku{},
+			&db_models.InvTransaction{},
+			&warehouse_models.StockChangeLog{},
+		)
+		assert.Nil(t, err)
+
+		return nil
+	}
+
+	now := time.Now()
+	var seed moretest.SetupFunc = func(t *testing.T) func() error {
+		err := db.Create(&db_models.Sku{
+			ID:          "test-sku-1",
+			TeamID:      1,
+			ProductID:   1,
+			WarehouseID: 1,
+		}).Error
+		assert.Nil(t, err)
+
+		err = db.Create(&db_models.InvTransaction{
+			ID:          1,
+			TeamID:      1,
+			WarehouseID: 1,
+			Receipt:     "RESI-1",
+			Created:     now,
+		}).Error
+		assert.Nil(t, err)
+
+		logs := []*warehouse_models.StockChangeLog{
+			{
+				SkuID:         "test-sku-1",
+				ExternalMsgId: "msg-1",
+				WarehouseID:   1,
+				ActorID:       2,
+				TransactionID: 1,
+				ChangeCount:   10,
+				TransactionAt: now.Add(-time.Hour * 2),
+				Type:          warehouse_iface.StockChangeType_STOCK_CHANGE_TYPE_RESTOCK_ACCEPTED,
+			},
+			{
+				SkuID:         "test-sku-1",
+				ExternalMsgId: "msg-2",
+				WarehouseID:   1,
+				ActorID:       3,
+				TransactionID: 2,
+				ChangeCount:   -3,
+				TransactionAt: now.Add(-time.Hour),
+				Type:          warehouse_iface.StockChangeType_STOCK_CHANGE_TYPE_ORDER_ACCEPTED,
+			},
+			{
+				SkuID:         "test-sku-1",
+				ExternalMsgId: "msg-3",
+				WarehouseID:   1,
+				ActorID:       3,
+				TransactionID: 3,
+				ChangeCount:   -2,
+				TransactionAt: now,
+				Type:          warehouse_iface.StockChangeType_STOCK_CHANGE_TYPE_ORDER_ACCEPTED,
+			},
+		}
+		err = db.Create(&logs).Error
+		assert.Nil(t, err)
+
+		return nil
+	}
+
+	moretest.Suite(t, "TestProductHistory",
+		moretest.SetupListFunc{
+			moretest_mock.MockSqliteDatabase(&db),
+			migrate,
+			seed,
+		},
+		func(t *testing.T) {
+			authMock := authorization_mock.EmptyAuthorizationMock{}
+			service := inventory.NewInventoryService(&db, &authMock)
+
+			ctx := context.WithValue(context.TODO(), custom_connect.SourceKey, &access_iface.RequestSource{
+				TeamId:      1,
+				RequestFrom: access_iface.RequestFrom_REQUEST_FROM_SELLING,
+			})
+
+			t.Run("ledger with running balance", func(t *testing.T) {
+				res, err := service.ProductHistory(ctx, connect.NewRequest(&warehouse_iface.ProductHistoryRequest{
+					Filter: &warehouse_iface.ProductHistoryRequestFilter{
+						SkuId: "test-sku-1",
+					},
+					Page: &common.PageFilter{
+						Page:  1,
+						Limit: 10,
+					},
+				}))
+				assert.NoError(t, err)
+
+				data := res.Msg.Data
+				assert.Len(t, data, 3)
+				assert.Equal(t, int64(3), res.Msg.PageInfo.TotalItems)
+
+				assert.Equal(t, uint64(3), data[0].Id)
+				assert.Equal(t, int64(5), data[0].StockTotal)
+				assert.Equal(t, int64(7), data[1].StockTotal)
+				assert.Equal(t, int64(10), data[2].StockTotal)
+
+				assert.Equal(t, uint64(2), data[2].UserId)
+				assert.Equal(t, "RESI-1", data[2].Receipt)
+				assert.Equal(t, "STOCK_CHANGE_TYPE_RESTOCK_ACCEPTED", data[2].Type)
+			})
+
+			t.Run("filter type keep running balance", func(t *testing.T) {
+				res, err := service.ProductHistory(ctx, connect.NewRequest(&warehouse_iface.ProductHistoryRequest{
+					Filter: &warehouse_iface.ProductHistoryRequestFilter{
+						SkuId: "test-sku-1",
+						Type:  "STOCK_CHANGE_TYPE_ORDER_ACCEPTED",
+					},
+					Page: &common.PageFilter{
+						Page:  1,
+						Limit: 1,
+					},
+				}))
+				assert.NoError(t, err)
+
+				data := res.Msg.Data
+				assert.Len(t, data, 1)
+				assert.Equal(t, int64(2), res.Msg.PageInfo.TotalItems)
+				assert.Equal(t, int64(5), data[0].StockTotal)
+			})
+
+			t.Run("other team not see ledger", func(t *testing.T) {
+				otherCtx := context.WithValue(context.TODO(), custom_connect.SourceKey, &access_iface.RequestSource{
+					TeamId:      2,
+					RequestFrom: access_iface.RequestFrom_REQUEST_FROM_SELLING,
+				})
+
+				res, err := service.ProductHistory(otherCtx, connect.NewRequest(&warehouse_iface.ProductHistoryRequest{
+					Filter: &warehouse_iface.ProductHistoryRequestFilter{
+						SkuId: "test-sku-1",
+					},
+					Page: &common.PageFilter{
+						Page:  1,
+						Limit: 10,
+					},
+				}))
+				assert.NoError(t, err)
+				assert.Len(t, res.Msg.Data, 0)
+			})
+		},
+	)
+}
@@ -0,0 +1,385 @@
+package inventory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/pdcgo/shared/db_models"
+	warehouse_service_iface "github.com/pdcgo/warehouse_service/services/warehouse_service/v1"
+	"github.com/pdcgo/warehouse_service/warehouse_models"
+	"github.com/pdcgo/warehouse_service/warehouse_query"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var ErrDailyHistoryParams = errors.New("invalid rebuild daily history params")
+
+type RebuildDailyHistoryParams struct {
+	WarehouseID uint64   `json:"warehouse_id"`
+	SkuIDs      []string `json:"sku_ids"`
+	// Start and End is day in warehouse time, formatted as 2006-01-02
+	Start string `json:"start"`
+	End   string `json:"end"`
+	Fix   bool   `json:"fix"`
+}
+
+type DailyHistoryDiff struct {
+	SkuID    string                            `json:"sku_id"`
+	T        time.Time                         `json:"t"`
+	Missing  bool                              `json:"missing"`
+	Current  *warehouse_models.DailySkuHistory `json:"current"`
+	Expected *warehouse_models.DailySkuHistory `json:"expected"`
+}
+
+type RebuildDailyHistoryReport struct {
+	SkuCount int                 `json:"sku_count"`
+	RowCount int                 `json:"row_count"`
+	Diffs    []*DailyHistoryDiff `json:"diffs"`
+	Fixed    int                 `json:"fixed"`
+}
+
+type skuStockSum struct {
+	SkuID  string
+	Count  int64
+	Amount float64
+}
+
+// RebuildDailyHistory recomputing daily_sku_histories from stock_change_logs. stock at end of
+// range is current stock from invertory_histories minus change after the range, then walked
+// backward day by day, so result is same whatever order the event processed.
+//
+// t is calendar day in warehouse timezone stored at midnight UTC, same as produced by push handler.
+func RebuildDailyHistory(db *gorm.DB, params *RebuildDailyHistoryParams) (*RebuildDailyHistoryReport, error) {
+	var err error
+
+	if params.WarehouseID == 0 {
+		return nil, fmt.Errorf("%w: warehouse_id empty", ErrDailyHistoryParams)
+	}
+
+	loc, err := warehouse_query.GetWarehouseLocation(db, uint(params.WarehouseID))
+	if err != nil {
+		return nil, err
+	}
+
+	startDay, err := time.ParseInLocation(time.DateOnly, params.Start, loc)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrDailyHistoryParams, err)
+	}
+	endDay, err := time.ParseInLocation(time.DateOnly, params.End, loc)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrDailyHistoryParams, err)
+	}
+	if endDay.Before(startDay) {
+		return nil, fmt.Errorf("%w: end before start", ErrDailyHistoryParams)
+	}
+
+	rangeStart := startDay.UTC()
+	rangeEnd := endDay.AddDate(0, 0, 1).UTC()
+
+	dayKey := func(t time.Time) time.Time {
+		t = t.In(loc)
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	}
+
+	result := RebuildDailyHistoryReport{
+		Diffs: []*DailyHistoryDiff{},
+	}
+
+	skuIDs := params.SkuIDs
+	if len(skuIDs) == 0 {
+		err = db.
+			Raw(`
+				select sku_id from stock_change_logs
+				where warehouse_id = ? and transaction_at >= ?
+				union
+				select sku_id from daily_sku_histories
+				where warehouse_id = ? and t >= ?
+			`,
+				params.WarehouseID, rangeStart,
+				params.WarehouseID, dayKey(rangeStart),
+			).
+			Scan(&skuIDs).
+			Error
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	result.SkuCount = len(skuIDs)
+	if len(skuIDs) == 0 {
+		return &result, nil
+	}
+
+	// current stock
+	currents := []*skuStockSum{}
+	err = db.
+		Table("invertory_histories ih").
+		Where("ih.tx_id is null").
+		Where("ih.sku_id in ?", skuIDs).
+		Group("ih.sku_id").
+		Select([]string{
+			"ih.sku_id",
+			"coalesce(sum(ih.count * -1), 0) as count",
+			"coalesce(sum(-1 * ih.count * (ih.price + coalesce(ih.ext_price, 0))), 0) as amount",
+		}).
+		Find(&currents).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	// change after range
+	tails := []*skuStockSum{}
+	err = db.
+		Table("stock_change_logs scl").
+		Where("scl.warehouse_id = ?", params.WarehouseID).
+		Where("scl.sku_id in ?", skuIDs).
+		Where("scl.transaction_at >= ?", rangeEnd).
+		Group("scl.sku_id").
+		Select([]string{
+			"scl.sku_id",
+			"coalesce(sum(scl.change_count), 0) as count",
+			"coalesce(sum(scl.change_amount), 0) as amount",
+		}).
+		Find(&tails).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	balances := map[string]*skuStockSum{}
+	for _, skuID := range skuIDs {
+		balances[skuID] = &skuStockSum{SkuID: skuID}
+	}
+	for _, current := range currents {
+		balances[current.SkuID].Count += current.Count
+		balances[current.SkuID].Amount += current.Amount
+	}
+	for _, tail := range tails {
+		balances[tail.SkuID].Count -= tail.Count
+		balances[tail.SkuID].Amount -= tail.Amount
+	}
+
+	// change in range
+	logs := []*warehouse_models.StockChangeLog{}
+	err = db.
+		Model(&warehouse_models.StockChangeLog{}).
+		Where("warehouse_id = ?", params.WarehouseID).
+		Where("sku_id in ?", skuIDs).
+		Where("transaction_at >= ?", rangeStart).
+		Where("transaction_at < ?", rangeEnd).
+		Order("transaction_at asc").
+		Order("id asc").
+		Find(&logs).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	expecteds := map[string]map[time.Time]*warehouse_models.DailySkuHistory{}
+	for _, skuID := range skuIDs {
+		expecteds[skuID] = map[time.Time]*warehouse_models.DailySkuHistory{}
+	}
+
+	getExpected := func(skuID string, t time.Time) *warehouse_models.DailySkuHistory {
+		hist := expecteds[skuID][t]
+		if hist == nil {
+			hist = &warehouse_models.DailySkuHistory{
+				T:           t,
+				SkuID:       db_models.SkuID(skuID),
+				WarehouseID: params.WarehouseID,
+			}
+			expecteds[skuID][t] = hist
+		}
+
+		return hist
+	}
+
+	for _, log := range logs {
+		hist := getExpected(log.SkuID, dayKey(log.TransactionAt))
+		hist.DiffStockCount += int64(log.ChangeCount)
+		hist.DiffStockAmount += log.ChangeAmount
+	}
+
+	// existing row
+	currentRows := []*warehouse_models.DailySkuHistory{}
+	err = db.
+		Model(&warehouse_models.DailySkuHistory{}).
+		Where("warehouse_id = ?", params.WarehouseID).
+		Where("sku_id in ?", skuIDs).
+		Where("t >= ?", dayKey(rangeStart)).
+		Where("t < ?", dayKey(rangeEnd)).
+		Find(&currentRows).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	currentMap := map[string]map[time.Time]*warehouse_models.DailySkuHistory{}
+	for _, row := range currentRows {
+		skuID := string(row.SkuID)
+		t := row.T.UTC()
+		if currentMap[skuID] == nil {
+			currentMap[skuID] = map[time.Time]*warehouse_models.DailySkuHistory{}
+		}
+		currentMap[skuID][t] = row
+
+		getExpected(skuID, t)
+	}
+
+	fixes := []*warehouse_models.DailySkuHistory{}
+	for _, skuID := range skuIDs {
+		days := []time.Time{}
+		for t := range expecteds[skuID] {
+			days = append(days, t)
+		}
+		slices.SortFunc(days, func(a, b time.Time) int {
+			return b.Compare(a)
+		})
+
+		balance := balances[skuID]
+		endCount := balance.Count
+		endAmount := balance.Amount
+
+		for _, t := range days {
+			hist := expecteds[skuID][t]
+			hist.EndStockCount = endCount
+			hist.EndStockAmount = endAmount
+			hist.StartStockCount = endCount - hist.DiffStockCount
+			hist.StartStockAmount = endAmount - hist.DiffStockAmount
+
+			endCount = hist.StartStockCount
+			endAmount = hist.StartStockAmount
+
+			result.RowCount++
+
+			current := currentMap[skuID][t]
+			if current != nil && dailyHistoryEqual(current, hist) {
+				continue
+			}
+
+			result.Diffs = append(result.Diffs, &DailyHistoryDiff{
+				SkuID:    skuID,
+				T:        t,
+				Missing:  current == nil,
+				Current:  current,
+				Expected: hist,
+			})
+			fixes = append(fixes, hist)
+		}
+	}
+
+	if !params.Fix || len(fixes) == 0 {
+		return &result, nil
+	}
+
+	err = db.Transaction(func(tx *gorm.DB) error {
+		return tx.
+			Clauses(clause.OnConflict{
+				Columns: []clause.Column{{Name: "t"}, {Name: "sku_id"}, {Name: "warehouse_id"}},
+				DoUpdates: clause.AssignmentColumns([]string{
+					"start_stock_count",
+					"end_stock_count",
+					"start_stock_amount",
+					"end_stock_amount",
+					"diff_stock_count",
+					"diff_stock_amount",
+				}),
+			}).
+			CreateInBatches(fixes, 100).
+			Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result.Fixed = len(fixes)
+	return &result, nil
+}
+
+func dailyHistoryEqual(a, b *warehouse_models.DailySkuHistory) bool {
+	amountEqual := func(x, y float64) bool {
+		return math.Abs(x-y) < 0.0001
+	}
+
+	return a.StartStockCount == b.StartStockCount &&
+		a.EndStockCount == b.EndStockCount &&
+		a.DiffStockCount == b.DiffStockCount &&
+		amountEqual(a.StartStockAmount, b.StartStockAmount) &&
+		amountEqual(a.EndStockAmount, b.EndStockAmount) &&
+		amountEqual(a.DiffStockAmount, b.DiffStockAmount)
+}
+
+func dailyHistoryProto(hist *warehouse_models.DailySkuHistory) *warehouse_service_iface.DailySkuHistory {
+	if hist == nil {
+		return nil
+	}
+
+	return &warehouse_service_iface.DailySkuHistory{
+		T:                timestamppb.New(hist.T),
+		SkuId:            string(hist.SkuID),
+		WarehouseId:      hist.WarehouseID,
+		StartStockCount:  hist.StartStockCount,
+		EndStockCount:    hist.EndStockCount,
+		StartStockAmount: hist.StartStockAmount,
+		EndStockAmount:   hist.EndStockAmount,
+		DiffStockCount:   hist.DiffStockCount,
+		DiffStockAmount:  hist.DiffStockAmount,
+	}
+}
+
+type dailyHistoryServiceImpl struct {
+	db *gorm.DB
+}
+
+// NewDailyHistoryService serving RebuildDailyHistory on connect. request has root and admin
+// request_policy, so handler need access interceptor.
+func NewDailyHistoryService(db *gorm.DB) *dailyHistoryServiceImpl {
+	return &dailyHistoryServiceImpl{db}
+}
+
+// DailyHistoryRebuild implements warehouse_service_ifaceconnect.DailyHistoryServiceHandler.
+func (d *dailyHistoryServiceImpl) DailyHistoryRebuild(
+	ctx context.Context,
+	req *connect.Request[warehouse_service_iface.DailyHistoryRebuildRequest],
+) (*connect.Response[warehouse_service_iface.DailyHistoryRebuildResponse], error) {
+	pay := req.Msg
+
+	report, err := RebuildDailyHistory(d.db.WithContext(ctx), &RebuildDailyHistoryParams{
+		WarehouseID: pay.WarehouseId,
+		SkuIDs:      pay.SkuIds,
+		Start:       pay.Start,
+		End:         pay.End,
+		Fix:         pay.Fix,
+	})
+	if err != nil {
+		if errors.Is(err, ErrDailyHistoryParams) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		return nil, err
+	}
+
+	result := warehouse_service_iface.DailyHistoryRebuildResponse{
+		SkuCount: int64(report.SkuCount),
+		RowCount: int64(report.RowCount),
+		Diffs:    make([]*warehouse_service_iface.DailyHistoryDiff, len(report.Diffs)),
+		Fixed:    int64(report.Fixed),
+	}
+	for i, diff := range report.Diffs {
+		result.Diffs[i] = &warehouse_service_iface.DailyHistoryDiff{
+			SkuId:    diff.SkuID,
+			T:        timestamppb.New(diff.T),
+			Missing:  diff.Missing,
+			Current:  dailyHistoryProto(diff.Current),
+			Expected: dailyHistoryProto(diff.Expected),
+		}
+	}
+
+	return connect.NewResponse(&result), nil
+}
@@ -0,0 +1,225 @@
+package inventory_test
+
+import (
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/pdcgo/schema/services/warehouse_iface/v1"
+	"github.com/pdcgo/shared/db_models"
+	"github.com/pdcgo/shared/pkg/moretest"
+	"github.com/pdcgo/shared/pkg/moretest/moretest_mock"
+	warehouse_service_iface "github.com/pdcgo/warehouse_service/services/warehouse_service/v1"
+	"github.com/pdcgo/warehouse_service/v2/inventory"
+	"github.com/pdcgo/warehouse_service/warehouse_models"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestRebuildDailyHistory(t *testing.T) {
+	var db gorm.DB
+
+	var migrate moretest.SetupFunc = func(t *testing.T) func() error {
+		err := db.AutoMigrate(
+			&db_models.InvertoryHistory{},
+			&warehouse_models.StockChangeLog{},
+			&warehouse_models.DailySkuHistory{},
+			&warehouse_models.WarehouseTimezone{},
+		)
+		assert.Nil(t, err)
+
+		return nil
+	}
+
+	day1 := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	day2 := time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)
+
+	var seed moretest.SetupFunc = func(t *testing.T) func() error {
+		// current stock 8
+		err := db.Create(&db_models.InvertoryHistory{
+			SkuID:       "sku-1",
+			WarehouseID: 1,
+			Count:       -8,
+			Price:       1000,
+		}).Error
+		assert.Nil(t, err)
+
+		err = db.Create(&[]*warehouse_models.StockChangeLog{
+			{
+				SkuID:         "sku-1",
+				ExternalMsgId: "msg-1",
+				WarehouseID:   1,
+				ChangeCount:   10,
+				ChangeAmount:  10000,
+				TransactionAt: day1.Add(time.Hour * 3),
+				Type:          warehouse_iface.StockChangeType_STOCK_CHANGE_TYPE_RESTOCK_ACCEPTED,
+			},
+			{
+				SkuID:         "sku-1",
+				ExternalMsgId: "msg-2",
+				WarehouseID:   1,
+				ChangeCount:   -2,
+				ChangeAmount:  -2000,
+				TransactionAt: day2.Add(time.Hour * 3),
+				Type:          warehouse_iface.StockChangeType_STOCK_CHANGE_TYPE_ORDER_ACCEPTED,
+			},
+		}).Error
+		assert.Nil(t, err)
+
+		// drifted row
+		err = db.Create(&warehouse_models.DailySkuHistory{
+			T:                day2,
+			SkuID:            "sku-1",
+			WarehouseID:      1,
+			StartStockCount:  11,
+			EndStockCount:    9,
+			StartStockAmount: 11000,
+			EndStockAmount:   9000,
+			DiffStockCount:   -2,
+			DiffStockAmount:  -2000,
+		}).Error
+		assert.Nil(t, err)
+
+		return nil
+	}
+
+	moretest.Suite(t, "TestRebuildDailyHistory",
+		moretest.SetupListFunc{
+			moretest_mock.MockSqliteDatabase(&db),
+			migrate,
+			seed,
+		},
+		func(t *testing.T) {
+			params := inventory.RebuildDailyHistoryParams{
+				WarehouseID: 1,
+				SkuIDs:      []string{"sku-1"},
+				Start:       "2025-01-01",
+				End:         "2025-01-02",
+			}
+
+			t.Run("report different row", func(t *testing.T) {
+				report, err := inventory.RebuildDailyHistory(&db, &params)
+				assert.NoError(t, err)
+
+				assert.Equal(t, 2, report.RowCount)
+				assert.Len(t, report.Diffs, 2)
+				assert.Equal(t, 0, report.Fixed)
+
+				assert.False(t, report.Diffs[0].Missing)
+				assert.Equal(t, int64(10), report.Diffs[0].Expected.StartStockCount)
+				assert.Equal(t, int64(8), report.Diffs[0].Expected.EndStockCount)
+
+				assert.True(t, report.Diffs[1].Missing)
+				assert.Equal(t, int64(0), report.Diffs[1].Expected.StartStockCount)
+				assert.Equal(t, int64(10), report.Diffs[1].Expected.EndStockCount)
+				assert.Equal(t, float64(10000), report.Diffs[1].Expected.EndStockAmount)
+			})
+
+			t.Run("report on connect", func(t *testing.T) {
+				service := inventory.NewDailyHistoryService(&db)
+
+				res, err := service.DailyHistoryRebuild(t.Context(), connect.NewRequest(&warehouse_service_iface.DailyHistoryRebuildRequest{
+					WarehouseId: 1,
+					SkuIds:      []string{"sku-1"},
+					Start:       "2025-01-01",
+					End:         "2025-01-02",
+				}))
+				assert.NoError(t, err)
+				assert.Equal(t, int64(2), res.Msg.RowCount)
+				assert.Len(t, res.Msg.Diffs, 2)
+				assert.Nil(t, res.Msg.Diffs[1].Current)
+				assert.Equal(t, int64(10), res.Msg.Diffs[1].Expected.EndStockCount)
+				assert.Equal(t, day1, res.Msg.Diffs[1].T.AsTime())
+
+				_, err = service.DailyHistoryRebuild(t.Context(), connect.NewRequest(&warehouse_service_iface.DailyHistoryRebuildRequest{
+					WarehouseId: 1,
+					Start:       "2025-01-02",
+					End:         "2025-01-01",
+				}))
+				assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+			})
+
+			t.Run("fixing row", func(t *testing.T) {
+				fixParams := params
+				fixParams.Fix = true
+
+				report, err := inventory.RebuildDailyHistory(&db, &fixParams)
+				assert.NoError(t, err)
+				assert.Equal(t, 2, report.Fixed)
+
+				report, err = inventory.RebuildDailyHistory(&db, &params)
+				assert.NoError(t, err)
+				assert.Len(t, report.Diffs, 0)
+
+				var count int64
+				err = db.Model(&warehouse_models.DailySkuHistory{}).Count(&count).Error
+				assert.NoError(t, err)
+				assert.Equal(t, int64(2), count)
+			})
+		},
+	)
+}
+
+func TestRebuildDailyHistoryWarehouseTimezone(t *testing.T) {
+	var db gorm.DB
+
+	var migrate moretest.SetupFunc = func(t *testing.T) func() error {
+		err := db.AutoMigrate(
+			&db_models.InvertoryHistory{},
+			&warehouse_models.StockChangeLog{},
+			&warehouse_models.DailySkuHistory{},
+			&warehouse_models.WarehouseTimezone{},
+		)
+		assert.Nil(t, err)
+
+		return nil
+	}
+
+	var seed moretest.SetupFunc = func(t *testing.T) func() error {
+		err := db.Create(&warehouse_models.WarehouseTimezone{
+			ID:       2,
+			Timezone: "Asia/Makassar",
+		}).Error
+		assert.Nil(t, err)
+
+		err = db.Create(&db_models.InvertoryHistory{
+			SkuID:       "sku-1",
+			WarehouseID: 2,
+			Count:       -1,
+			Price:       1000,
+		}).Error
+		assert.Nil(t, err)
+
+		// 23:30 in WIB but already next day in WITA
+		err = db.Create(&warehouse_models.StockChangeLog{
+			SkuID:         "sku-1",
+			ExternalMsgId: "msg-1",
+			WarehouseID:   2,
+			ChangeCount:   1,
+			ChangeAmount:  1000,
+			TransactionAt: time.Date(2025, 1, 1, 16, 30, 0, 0, time.UTC),
+			Type:          warehouse_iface.StockChangeType_STOCK_CHANGE_TYPE_RESTOCK_ACCEPTED,
+		}).Error
+		assert.Nil(t, err)
+
+		return nil
+	}
+
+	moretest.Suite(t, "TestRebuildDailyHistoryWarehouseTimezone",
+		moretest.SetupListFunc{
+			moretest_mock.MockSqliteDatabase(&db),
+			migrate,
+			seed,
+		},
+		func(t *testing.T) {
+			report, err := inventory.RebuildDailyHistory(&db, &inventory.RebuildDailyHistoryParams{
+				WarehouseID: 2,
+				Start:       "2025-01-01",
+				End:         "2025-01-02",
+			})
+			assert.NoError(t, err)
+			assert.Len(t, report.Diffs, 1)
+			assert.Equal(t, time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC), report.Diffs[0].T)
+		},
+	)
+}
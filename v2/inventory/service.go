@@ -1,10 +1,6 @@
 package inventory
 
 import (
-	"context"
-
-	"connectrpc.com/connect"
-	"github.com/pdcgo/schema/services/warehouse_iface/v1"
 	"github.com/pdcgo/shared/interfaces/authorization_iface"
 	"gorm.io/gorm"
 )
@@ -21,11 +17,6 @@ type inventoryServiceImpl struct {
 	auth authorization_iface.Authorization
 }
 
-// ProductDetail implements warehouse_ifaceconnect.InventoryServiceHandler.
-func (i *inventoryServiceImpl) ProductDetail(context.Context, *connect.Request[warehouse_iface.ProductDetailRequest]) (*connect.Response[warehouse_iface.ProductDetailResponse], error) {
-	panic("unimplemented")
-}
-
 func NewInventoryService(
 	db *gorm.DB,
 	auth authorization_iface.Authorization,
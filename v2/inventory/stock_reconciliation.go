@@ -0,0 +1,324 @@
+package inventory
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/pdcgo/shared/db_models"
+	warehouse_service_iface "github.com/pdcgo/warehouse_service/services/warehouse_service/v1"
+	"github.com/pdcgo/warehouse_service/warehouse_models"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+type StockReconciliationParams struct {
+	// WarehouseID is optional, 0 for all warehouse
+	WarehouseID uint64   `json:"warehouse_id"`
+	SkuIDs      []string `json:"sku_ids"`
+}
+
+type StockReconciliationReport struct {
+	Run   *warehouse_models.StockReconciliationRun    `json:"run"`
+	Items []*warehouse_models.StockReconciliationItem `json:"items"`
+}
+
+type skuStockSource struct {
+	SkuID         db_models.SkuID
+	WarehouseID   uint64
+	StockTotal    int64
+	StockReady    int64
+	HistoryCount  int64
+	DailyEndCount *int64
+	DailyT        *time.Time
+}
+
+// stockSeverity comparing sku stock sources. skus and invertory_histories is source of truth for
+// stock, so disagreement between them is critical. daily snapshot only used for stat and can be
+// repaired with RebuildDailyHistory, so its drift is warning.
+func stockSeverity(source *skuStockSource) (warehouse_models.ReconciliationSeverity, string) {
+	severity := warehouse_models.ReconciliationOk
+	notes := []string{}
+
+	if source.StockTotal != source.HistoryCount {
+		severity = warehouse_models.ReconciliationCritical
+		notes = append(notes, fmt.Sprintf("stock_total %d != invertory_histories %d", source.StockTotal, source.HistoryCount))
+	}
+
+	if source.StockReady > source.StockTotal {
+		severity = warehouse_models.ReconciliationCritical
+		notes = append(notes, fmt.Sprintf("stock_ready %d > stock_total %d", source.StockReady, source.StockTotal))
+	}
+
+	if source.StockReady < 0 || source.StockTotal < 0 || source.HistoryCount < 0 {
+		severity = warehouse_models.ReconciliationCritical
+		notes = append(notes, "negative stock")
+	}
+
+	switch {
+	case source.DailyEndCount == nil:
+		if source.HistoryCount != 0 {
+			if severity == warehouse_models.ReconciliationOk {
+				severity = warehouse_models.ReconciliationWarning
+			}
+			notes = append(notes, "daily_sku_histories empty")
+		}
+	case *source.DailyEndCount != source.HistoryCount:
+		if severity == warehouse_models.ReconciliationOk {
+			severity = warehouse_models.ReconciliationWarning
+		}
+		notes = append(notes, fmt.Sprintf("daily end_stock_count %d != invertory_histories %d", *source.DailyEndCount, source.HistoryCount))
+	}
+
+	return severity, strings.Join(notes, "; ")
+}
+
+// ReconcileStock comparing skus.stock_total and stock_ready, sum of invertory_histories and latest
+// daily_sku_histories.end_stock_count per sku. result saved as run, with only disagreeing sku as
+// item.
+func ReconcileStock(db *gorm.DB, params *StockReconciliationParams) (*StockReconciliationReport, error) {
+	var err error
+
+	query := db.
+		Table("skus s").
+		Select([]string{
+			"s.id as sku_id",
+			"s.warehouse_id",
+			"s.stock_total",
+			"s.stock_ready",
+			`coalesce((
+				select sum(ih.count * -1)
+				from invertory_histories ih
+				where ih.tx_id is null and ih.sku_id = s.id
+			), 0) as history_count`,
+			`(
+				select dsh.end_stock_count
+				from daily_sku_histories dsh
+				where dsh.sku_id = s.id and dsh.warehouse_id = s.warehouse_id
+				order by dsh.t desc
+				limit 1
+			) as daily_end_count`,
+			`(
+				select dsh.t
+				from daily_sku_histories dsh
+				where dsh.sku_id = s.id and dsh.warehouse_id = s.warehouse_id
+				order by dsh.t desc
+				limit 1
+			) as daily_t`,
+		}).
+		Order("s.id asc")
+
+	if params.WarehouseID != 0 {
+		query = query.Where("s.warehouse_id = ?", params.WarehouseID)
+	}
+
+	if len(params.SkuIDs) != 0 {
+		query = query.Where("s.id in ?", params.SkuIDs)
+	}
+
+	sources := []*skuStockSource{}
+	err = query.
+		Find(&sources).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	result := StockReconciliationReport{
+		Run: &warehouse_models.StockReconciliationRun{
+			WarehouseID: params.WarehouseID,
+			SkuCount:    int64(len(sources)),
+		},
+		Items: []*warehouse_models.StockReconciliationItem{},
+	}
+
+	for _, source := range sources {
+		severity, note := stockSeverity(source)
+
+		switch severity {
+		case warehouse_models.ReconciliationOk:
+			continue
+		case warehouse_models.ReconciliationWarning:
+			result.Run.WarningCount++
+		case warehouse_models.ReconciliationCritical:
+			result.Run.CriticalCount++
+		}
+
+		result.Items = append(result.Items, &warehouse_models.StockReconciliationItem{
+			SkuID:         source.SkuID,
+			WarehouseID:   source.WarehouseID,
+			StockTotal:    source.StockTotal,
+			StockReady:    source.StockReady,
+			HistoryCount:  source.HistoryCount,
+			DailyEndCount: source.DailyEndCount,
+			DailyT:        source.DailyT,
+			Severity:      severity,
+			Note:          note,
+		})
+	}
+
+	err = db.Transaction(func(tx *gorm.DB) error {
+		err := tx.
+			Create(result.Run).
+			Error
+		if err != nil {
+			return err
+		}
+
+		if len(result.Items) == 0 {
+			return nil
+		}
+
+		for _, item := range result.Items {
+			item.RunID = result.Run.ID
+		}
+
+		return tx.
+			CreateInBatches(result.Items, 100).
+			Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// ListStockReconciliationRun getting past run, newest first, for seeing trend.
+func ListStockReconciliationRun(db *gorm.DB, warehouseID uint64, limit int) ([]*warehouse_models.StockReconciliationRun, error) {
+	if limit <= 0 {
+		limit = 30
+	}
+
+	runs := []*warehouse_models.StockReconciliationRun{}
+	err := db.
+		Model(&warehouse_models.StockReconciliationRun{}).
+		Where("warehouse_id = ?", warehouseID).
+		Order("id desc").
+		Limit(limit).
+		Find(&runs).
+		Error
+
+	return runs, err
+}
+
+func GetStockReconciliationItems(db *gorm.DB, runID uint64) ([]*warehouse_models.StockReconciliationItem, error) {
+	items := []*warehouse_models.StockReconciliationItem{}
+	err := db.
+		Model(&warehouse_models.StockReconciliationItem{}).
+		Where("run_id = ?", runID).
+		Order("severity asc").
+		Order("sku_id asc").
+		Find(&items).
+		Error
+
+	return items, err
+}
+
+var reconciliationSeverityMap = map[warehouse_models.ReconciliationSeverity]warehouse_service_iface.ReconciliationSeverity{
+	warehouse_models.ReconciliationOk:       warehouse_service_iface.ReconciliationSeverity_RECONCILIATION_SEVERITY_OK,
+	warehouse_models.ReconciliationWarning:  warehouse_service_iface.ReconciliationSeverity_RECONCILIATION_SEVERITY_WARNING,
+	warehouse_models.ReconciliationCritical: warehouse_service_iface.ReconciliationSeverity_RECONCILIATION_SEVERITY_CRITICAL,
+}
+
+func reconciliationRunProto(run *warehouse_models.StockReconciliationRun) *warehouse_service_iface.StockReconciliationRun {
+	return &warehouse_service_iface.StockReconciliationRun{
+		Id:            run.ID,
+		WarehouseId:   run.WarehouseID,
+		SkuCount:      run.SkuCount,
+		WarningCount:  run.WarningCount,
+		CriticalCount: run.CriticalCount,
+		CreatedAt:     timestamppb.New(run.CreatedAt),
+	}
+}
+
+func reconciliationItemsProto(items []*warehouse_models.StockReconciliationItem) []*warehouse_service_iface.StockReconciliationItem {
+	result := make([]*warehouse_service_iface.StockReconciliationItem, len(items))
+	for i, item := range items {
+		result[i] = &warehouse_service_iface.StockReconciliationItem{
+			Id:            item.ID,
+			RunId:         item.RunID,
+			SkuId:         string(item.SkuID),
+			WarehouseId:   item.WarehouseID,
+			StockTotal:    item.StockTotal,
+			StockReady:    item.StockReady,
+			HistoryCount:  item.HistoryCount,
+			DailyEndCount: item.DailyEndCount,
+			Severity:      reconciliationSeverityMap[item.Severity],
+			Note:          item.Note,
+			CreatedAt:     timestamppb.New(item.CreatedAt),
+		}
+
+		if item.DailyT != nil {
+			result[i].DailyT = timestamppb.New(*item.DailyT)
+		}
+	}
+
+	return result
+}
+
+type stockReconciliationServiceImpl struct {
+	db *gorm.DB
+}
+
+// NewStockReconciliationService serving reconciliation on connect. request has root and admin
+// request_policy, so handler need access interceptor.
+func NewStockReconciliationService(db *gorm.DB) *stockReconciliationServiceImpl {
+	return &stockReconciliationServiceImpl{db}
+}
+
+// StockReconciliationCreate implements warehouse_service_ifaceconnect.StockReconciliationServiceHandler.
+func (s *stockReconciliationServiceImpl) StockReconciliationCreate(
+	ctx context.Context,
+	req *connect.Request[warehouse_service_iface.StockReconciliationCreateRequest],
+) (*connect.Response[warehouse_service_iface.StockReconciliationCreateResponse], error) {
+	report, err := ReconcileStock(s.db.WithContext(ctx), &StockReconciliationParams{
+		WarehouseID: req.Msg.WarehouseId,
+		SkuIDs:      req.Msg.SkuIds,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&warehouse_service_iface.StockReconciliationCreateResponse{
+		Run:   reconciliationRunProto(report.Run),
+		Items: reconciliationItemsProto(report.Items),
+	}), nil
+}
+
+// StockReconciliationList implements warehouse_service_ifaceconnect.StockReconciliationServiceHandler.
+func (s *stockReconciliationServiceImpl) StockReconciliationList(
+	ctx context.Context,
+	req *connect.Request[warehouse_service_iface.StockReconciliationListRequest],
+) (*connect.Response[warehouse_service_iface.StockReconciliationListResponse], error) {
+	runs, err := ListStockReconciliationRun(s.db.WithContext(ctx), req.Msg.WarehouseId, int(req.Msg.Limit))
+	if err != nil {
+		return nil, err
+	}
+
+	result := warehouse_service_iface.StockReconciliationListResponse{
+		Data: make([]*warehouse_service_iface.StockReconciliationRun, len(runs)),
+	}
+	for i, run := range runs {
+		result.Data[i] = reconciliationRunProto(run)
+	}
+
+	return connect.NewResponse(&result), nil
+}
+
+// StockReconciliationItemList implements warehouse_service_ifaceconnect.StockReconciliationServiceHandler.
+func (s *stockReconciliationServiceImpl) StockReconciliationItemList(
+	ctx context.Context,
+	req *connect.Request[warehouse_service_iface.StockReconciliationItemListRequest],
+) (*connect.Response[warehouse_service_iface.StockReconciliationItemListResponse], error) {
+	items, err := GetStockReconciliationItems(s.db.WithContext(ctx), req.Msg.RunId)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&warehouse_service_iface.StockReconciliationItemListResponse{
+		Data: reconciliationItemsProto(items),
+	}), nil
+}
@@ -0,0 +1,128 @@
+package inventory_test
+
+import (
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/pdcgo/shared/db_models"
+	"github.com/pdcgo/shared/pkg/moretest"
+	"github.com/pdcgo/shared/pkg/moretest/moretest_mock"
+	warehouse_service_iface "github.com/pdcgo/warehouse_service/services/warehouse_service/v1"
+	"github.com/pdcgo/warehouse_service/v2/inventory"
+	"github.com/pdcgo/warehouse_service/warehouse_models"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestReconcileStock(t *testing.T) {
+	var db gorm.DB
+
+	var migrate moretest.SetupFunc = func(t *testing.T) func() error {
+		err := db.AutoMigrate(
+			&db_models.Sku{},
+			&db_models.InvertoryHistory{},
+			&warehouse_models.DailySkuHistory{},
+			&warehouse_models.StockReconciliationRun{},
+			&warehouse_models.StockReconciliationItem{},
+		)
+		assert.Nil(t, err)
+
+		return nil
+	}
+
+	var seed moretest.SetupFunc = func(t *testing.T) func() error {
+		err := db.Create(&[]db_models.Sku{
+			{ID: "sku-ok", WarehouseID: 1, StockTotal: 5, StockReady: 5},
+			{ID: "sku-daily", WarehouseID: 1, StockTotal: 3, StockReady: 3},
+			{ID: "sku-total", WarehouseID: 1, StockTotal: 7, StockReady: 7},
+			{ID: "sku-other", WarehouseID: 2, StockTotal: 1, StockReady: 1},
+		}).Error
+		assert.Nil(t, err)
+
+		err = db.Create(&[]db_models.InvertoryHistory{
+			{SkuID: "sku-ok", WarehouseID: 1, Count: -5},
+			{SkuID: "sku-daily", WarehouseID: 1, Count: -3},
+			{SkuID: "sku-total", WarehouseID: 1, Count: -6},
+		}).Error
+		assert.Nil(t, err)
+
+		now := time.Now()
+		err = db.Create(&[]warehouse_models.DailySkuHistory{
+			{T: now.AddDate(0, 0, -1), SkuID: "sku-ok", WarehouseID: 1, EndStockCount: 4},
+			{T: now, SkuID: "sku-ok", WarehouseID: 1, EndStockCount: 5},
+			{T: now, SkuID: "sku-daily", WarehouseID: 1, EndStockCount: 2},
+			{T: now, SkuID: "sku-total", WarehouseID: 1, EndStockCount: 6},
+		}).Error
+		assert.Nil(t, err)
+
+		return nil
+	}
+
+	moretest.Suite(t, "TestReconcileStock",
+		moretest.SetupListFunc{
+			moretest_mock.MockSqliteDatabase(&db),
+			migrate,
+			seed,
+		},
+		func(t *testing.T) {
+			report, err := inventory.ReconcileStock(&db, &inventory.StockReconciliationParams{
+				WarehouseID: 1,
+			})
+			assert.NoError(t, err)
+
+			assert.Equal(t, int64(3), report.Run.SkuCount)
+			assert.Equal(t, int64(1), report.Run.WarningCount)
+			assert.Equal(t, int64(1), report.Run.CriticalCount)
+			assert.Len(t, report.Items, 2)
+
+			severities := map[db_models.SkuID]warehouse_models.ReconciliationSeverity{}
+			for _, item := range report.Items {
+				severities[item.SkuID] = item.Severity
+			}
+			assert.Equal(t, warehouse_models.ReconciliationWarning, severities["sku-daily"])
+			assert.Equal(t, warehouse_models.ReconciliationCritical, severities["sku-total"])
+
+			t.Run("run saved for trend", func(t *testing.T) {
+				runs, err := inventory.ListStockReconciliationRun(&db, 1, 10)
+				assert.NoError(t, err)
+				assert.Len(t, runs, 1)
+
+				items, err := inventory.GetStockReconciliationItems(&db, runs[0].ID)
+				assert.NoError(t, err)
+				assert.Len(t, items, 2)
+			})
+
+			t.Run("run on connect", func(t *testing.T) {
+				service := inventory.NewStockReconciliationService(&db)
+
+				created, err := service.StockReconciliationCreate(t.Context(), connect.NewRequest(&warehouse_service_iface.StockReconciliationCreateRequest{
+					WarehouseId: 1,
+				}))
+				assert.NoError(t, err)
+				assert.Equal(t, int64(1), created.Msg.Run.CriticalCount)
+				assert.Len(t, created.Msg.Items, 2)
+
+				runs, err := service.StockReconciliationList(t.Context(), connect.NewRequest(&warehouse_service_iface.StockReconciliationListRequest{
+					WarehouseId: 1,
+				}))
+				assert.NoError(t, err)
+				assert.Len(t, runs.Msg.Data, 2)
+				assert.Equal(t, created.Msg.Run.Id, runs.Msg.Data[0].Id)
+
+				items, err := service.StockReconciliationItemList(t.Context(), connect.NewRequest(&warehouse_service_iface.StockReconciliationItemListRequest{
+					RunId: created.Msg.Run.Id,
+				}))
+				assert.NoError(t, err)
+				assert.Len(t, items.Msg.Data, 2)
+
+				severities := map[string]warehouse_service_iface.ReconciliationSeverity{}
+				for _, item := range items.Msg.Data {
+					severities[item.SkuId] = item.Severity
+				}
+				assert.Equal(t, warehouse_service_iface.ReconciliationSeverity_RECONCILIATION_SEVERITY_WARNING, severities["sku-daily"])
+				assert.Equal(t, warehouse_service_iface.ReconciliationSeverity_RECONCILIATION_SEVERITY_CRITICAL, severities["sku-total"])
+			})
+		},
+	)
+}
@@ -0,0 +1,192 @@
+package warehouse_service_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pdcgo/event_source"
+	"github.com/pdcgo/event_source/event_source_mock"
+	"github.com/pdcgo/schema/services/warehouse_iface/v1"
+	"github.com/pdcgo/shared/db_models"
+	"github.com/pdcgo/shared/pkg/moretest"
+	"github.com/pdcgo/shared/pkg/moretest/moretest_mock"
+	"github.com/pdcgo/warehouse_service/v2"
+	"github.com/pdcgo/warehouse_service/v2/inventory"
+	"github.com/pdcgo/warehouse_service/warehouse_models"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+func TestProductStockDetail(t *testing.T) {
+	var dbScenario moretest_mock.DbScenario
+
+	moretest.Suite(t, "testing product stock detail",
+		moretest.SetupListFunc{
+			moretest_mock.MockPostgresDatabase(&dbScenario),
+		},
+		func(t *testing.T) {
+			dbScenario(t, func(db *gorm.DB) {
+				productStockDetailScenario(t, db)
+			})
+		},
+	)
+}
+
+func productStockDetailScenario(t *testing.T, db *gorm.DB) {
+	var migrate moretest.SetupFunc = func(t *testing.T) func() error {
+		err := db.AutoMigrate(
+			&db_models.Product{},
+			&db_models.VariationValue{},
+			&db_models.Sku{},
+			&db_models.Rack{},
+			&db_models.Placement{},
+			&db_models.InvTransaction{},
+			&db_models.Order{},
+			&db_models.OrderItem{},
+			&db_models.InvertoryHistory{},
+			&warehouse_models.DailySkuHistory{},
+			&warehouse_models.WarehouseTimezone{},
+			&warehouse_models.StockEventLog{},
+			&warehouse_models.StockChangeLog{},
+		)
+		assert.NoError(t, err)
+
+		return nil
+	}
+
+	now := time.Now()
+	var seed moretest.SetupFunc = func(t *testing.T) func() error {
+		err := db.Create(&db_models.Product{
+			ID:     1,
+			TeamID: 1,
+			Name:   "kaos",
+			Image:  []string{"kaos.png"},
+		}).Error
+		assert.NoError(t, err)
+
+		err = db.Create(&[]db_models.VariationValue{
+			{ID: 1, ProductID: 1, RefID: "ref-1"},
+			{ID: 2, ProductID: 1, RefID: "ref-2"},
+		}).Error
+		assert.NoError(t, err)
+
+		err = db.Create(&[]db_models.Sku{
+			{ID: "sku-1", ProductID: 1, VariantID: 1, TeamID: 1, WarehouseID: 1},
+			{ID: "sku-2", ProductID: 1, VariantID: 2, TeamID: 1, WarehouseID: 1, IsBlacklisted: true},
+		}).Error
+		assert.NoError(t, err)
+
+		err = db.Create(&[]db_models.Rack{
+			{ID: 1, WarehouseID: 1, Name: "B1"},
+			{ID: 2, WarehouseID: 1, Name: "A1"},
+		}).Error
+		assert.NoError(t, err)
+
+		err = db.Create(&[]db_models.Placement{
+			{RackID: 1, SkuID: "sku-1", Count: 5},
+			{RackID: 2, SkuID: "sku-1", Count: 3},
+		}).Error
+		assert.NoError(t, err)
+
+		err = db.Create(&db_models.InvTransaction{ID: 1, TeamID: 1, WarehouseID: 1}).Error
+		assert.NoError(t, err)
+
+		txID := uint(1)
+		err = db.Create(&db_models.Order{
+			ID:            1,
+			TeamID:        1,
+			InvertoryTxID: &txID,
+			CreatedAt:     now,
+		}).Error
+		assert.NoError(t, err)
+
+		err = db.Create(&db_models.OrderItem{OrderID: 1, ProductID: 1, VariationID: 1, Count: 2}).Error
+		assert.NoError(t, err)
+
+		return nil
+	}
+
+	moretest.Suite(t, "push then read detail",
+		moretest.SetupListFunc{
+			migrate,
+			seed,
+		},
+		func(t *testing.T) {
+			handler := warehouse_service.NewWarehousePushHandler(db, event_source.EmptySender, nil)
+
+			push := func(msgID string, count int32, amount float64) {
+				event := event_source_mock.NewMockEvent(t, &warehouse_iface.StockEvent{
+					Data: &warehouse_iface.StockEvent_StockChange{
+						StockChange: &warehouse_iface.StockChange{
+							CreatedTime: timestamppb.Now(),
+							Changes: []*warehouse_iface.StockChangeLog{
+								{
+									SkuId:         "sku-1",
+									ExternalMsgId: msgID,
+									WarehouseId:   1,
+									ChangeCount:   count,
+									ChangeAmount:  amount,
+									ActorId:       1,
+									TransactionId: 1,
+									TransactionAt: timestamppb.Now(),
+								},
+							},
+						},
+					},
+				})
+				event.Message.MessageID = msgID
+
+				err := handler(t.Context(), event)
+				assert.NoError(t, err)
+			}
+
+			push("inbound-1", 10, 10000)
+			push("outbound-1", -2, -2000)
+
+			detail, err := inventory.GetProductDetail(db, 1, 1, now.AddDate(0, 0, -7))
+			assert.NoError(t, err)
+
+			assert.Equal(t, "kaos", detail.Name)
+			assert.Equal(t, "kaos.png", detail.Image)
+			assert.Equal(t, uint64(1), detail.TeamId)
+			assert.Equal(t, float64(8000), detail.StockValue)
+			assert.Len(t, detail.Variants, 2)
+
+			t.Run("stock value from latest daily history", func(t *testing.T) {
+				sku1 := detail.Variants[0]
+				assert.Equal(t, "sku-1", sku1.SkuId)
+				assert.Equal(t, "ref-1", sku1.VariantRefId)
+				assert.Equal(t, float64(8000), sku1.StockValue)
+				assert.NotNil(t, sku1.StockValueAt)
+			})
+
+			t.Run("placement per rack", func(t *testing.T) {
+				racks := detail.Variants[0].Racks
+				assert.Len(t, racks, 2)
+				assert.Equal(t, "A1", racks[0].RackName)
+				assert.Equal(t, int64(3), racks[0].ItemCount)
+				assert.Equal(t, "B1", racks[1].RackName)
+				assert.Equal(t, int64(5), racks[1].ItemCount)
+			})
+
+			t.Run("outbound volume", func(t *testing.T) {
+				sku1 := detail.Variants[0]
+				assert.Equal(t, int64(1), sku1.OutboundTxCount)
+				assert.Equal(t, int64(2), sku1.OutboundItemCount)
+
+				old, err := inventory.GetProductDetail(db, 1, 1, now.Add(time.Hour))
+				assert.NoError(t, err)
+				assert.Equal(t, int64(0), old.Variants[0].OutboundItemCount)
+			})
+
+			t.Run("blacklisted without history", func(t *testing.T) {
+				sku2 := detail.Variants[1]
+				assert.True(t, sku2.IsBlacklisted)
+				assert.Equal(t, float64(0), sku2.StockValue)
+				assert.Nil(t, sku2.StockValueAt)
+				assert.Len(t, sku2.Racks, 0)
+			})
+		},
+	)
+}
@@ -0,0 +1,399 @@
+package warehouse_service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"os"
+	"strconv"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/pdcgo/event_source"
+	"github.com/pdcgo/schema/services/common/v1"
+	"github.com/pdcgo/shared/db_connect"
+	warehouse_service_iface "github.com/pdcgo/warehouse_service/services/warehouse_service/v1"
+	"github.com/pdcgo/warehouse_service/services/warehouse_service/v1/warehouse_service_ifaceconnect"
+	"github.com/pdcgo/warehouse_service/warehouse_models"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const DefaultDeadLetterMaxAttempt = 5
+
+var ErrDeadLetterNotDead = errors.New("only dead letter can be replayed")
+
+type DeadLetterPolicy struct {
+	// MaxAttempt is how many failed delivery before event marked dead and acknowledged.
+	MaxAttempt int
+}
+
+func NewDeadLetterPolicy() *DeadLetterPolicy {
+	policy := DeadLetterPolicy{
+		MaxAttempt: DefaultDeadLetterMaxAttempt,
+	}
+
+	maxAttempt, err := strconv.Atoi(os.Getenv("PUSH_MAX_ATTEMPT"))
+	if err == nil && maxAttempt > 0 {
+		policy.MaxAttempt = maxAttempt
+	}
+
+	return &policy
+}
+
+func (p *DeadLetterPolicy) maxAttempt() int {
+	if p == nil || p.MaxAttempt <= 0 {
+		return DefaultDeadLetterMaxAttempt
+	}
+
+	return p.MaxAttempt
+}
+
+// isPermanentErr is error that will not change on redelivery.
+func isPermanentErr(err error) bool {
+	var invalidErr *EventInvalidErr
+	var unsupportedErr *EventUnuportedErr
+
+	return errors.As(err, &invalidErr) || errors.As(err, &unsupportedErr)
+}
+
+// storeDeadLetter recording failed event. returning nil when event is dead so pubsub stop
+// redelivering it, otherwise returning processing error.
+func storeDeadLetter(db *gorm.DB, policy *DeadLetterPolicy, messageID string, data []byte, procErr error) error {
+	var err error
+
+	now := time.Now()
+	deadLetter := warehouse_models.StockEventDeadLetter{
+		MessageID:     messageID,
+		Raw:           data,
+		Error:         procErr.Error(),
+		AttemptCount:  1,
+		MaxAttempt:    policy.maxAttempt(),
+		Status:        warehouse_models.DeadLetterRetrying,
+		LastAttemptAt: now,
+	}
+
+	err = db.Transaction(func(tx *gorm.DB) error {
+		err := tx.
+			Clauses(clause.OnConflict{
+				Columns: []clause.Column{{Name: "message_id"}},
+				DoUpdates: clause.Assignments(map[string]interface{}{
+					"attempt_count":   gorm.Expr("stock_event_dead_letters.attempt_count + 1"),
+					"error":           deadLetter.Error,
+					"last_attempt_at": now,
+					"updated_at":      now,
+				}),
+			}).
+			Create(&deadLetter).
+			Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.
+			Model(&warehouse_models.StockEventDeadLetter{}).
+			Where("message_id = ?", messageID).
+			First(&deadLetter).
+			Error
+		if err != nil {
+			return err
+		}
+
+		if deadLetter.AttemptCount < deadLetter.MaxAttempt && !isPermanentErr(procErr) {
+			return nil
+		}
+
+		deadLetter.Status = warehouse_models.DeadLetterDead
+
+		return tx.
+			Model(&warehouse_models.StockEventDeadLetter{}).
+			Where("id = ?", deadLetter.ID).
+			Update("status", deadLetter.Status).
+			Error
+	})
+
+	if err != nil {
+		slog.Error("storing dead letter failed", "message_id", messageID, "err", err.Error())
+		return procErr
+	}
+
+	if deadLetter.Status == warehouse_models.DeadLetterDead {
+		slog.Error("stock event dead",
+			"message_id", messageID,
+			"attempt", deadLetter.AttemptCount,
+			"err", procErr.Error(),
+		)
+		return nil
+	}
+
+	return procErr
+}
+
+// resolveDeadLetter mark retrying dead letter of message resolved, after redelivery processed successfully.
+func resolveDeadLetter(db *gorm.DB, messageID string) {
+	err := db.
+		Model(&warehouse_models.StockEventDeadLetter{}).
+		Where("message_id = ? AND status = ?", messageID, warehouse_models.DeadLetterRetrying).
+		Updates(map[string]interface{}{
+			"status":     warehouse_models.DeadLetterResolved,
+			"updated_at": time.Now(),
+		}).
+		Error
+	if err != nil {
+		slog.Error("resolving dead letter failed", "message_id", messageID, "err", err.Error())
+	}
+}
+
+type DeadLetterService struct {
+	db      *gorm.DB
+	process StockEventProcessor
+}
+
+func NewDeadLetterService(db *gorm.DB, eventSender event_source.EventSender) *DeadLetterService {
+	return &DeadLetterService{
+		db:      db,
+		process: NewStockEventProcessor(db, eventSender),
+	}
+}
+
+func (d *DeadLetterService) List(
+	ctx context.Context,
+	status warehouse_models.DeadLetterStatus,
+	page *common.PageFilter,
+) ([]*warehouse_models.StockEventDeadLetter, *common.PageInfo, error) {
+	var err error
+
+	db := d.db.WithContext(ctx)
+	query := db.
+		Model(&warehouse_models.StockEventDeadLetter{})
+
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+
+	query, pageInfo, err := db_connect.SetPaginationQuery(db, func() (*gorm.DB, error) {
+		return query.Session(&gorm.Session{}), nil
+	}, page)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := []*warehouse_models.StockEventDeadLetter{}
+	err = query.
+		Order("id desc").
+		Find(&result).
+		Error
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return result, pageInfo, nil
+}
+
+func (d *DeadLetterService) Get(ctx context.Context, id uint64) (*warehouse_models.StockEventDeadLetter, error) {
+	deadLetter := warehouse_models.StockEventDeadLetter{}
+	err := d.db.
+		WithContext(ctx).
+		Model(&warehouse_models.StockEventDeadLetter{}).
+		Where("id = ?", id).
+		First(&deadLetter).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &deadLetter, nil
+}
+
+// claimReplay claiming dead letter for replay with conditional update, so same dead letter is not
+// processed twice by concurrent replay. only dead status can be claimed.
+func (d *DeadLetterService) claimReplay(ctx context.Context, id uint64) (*warehouse_models.StockEventDeadLetter, error) {
+	res := d.db.
+		WithContext(ctx).
+		Model(&warehouse_models.StockEventDeadLetter{}).
+		Where("id = ? AND status = ?", id, warehouse_models.DeadLetterDead).
+		Updates(map[string]interface{}{
+			"status":     warehouse_models.DeadLetterReplaying,
+			"updated_at": time.Now(),
+		})
+	if res.Error != nil {
+		return nil, res.Error
+	}
+
+	if res.RowsAffected == 0 {
+		_, err := d.Get(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, ErrDeadLetterNotDead
+	}
+
+	return d.Get(ctx, id)
+}
+
+// Replay processing dead letter again through stock event handler chain. dead letter is claimed
+// before processing, failed replay return it to dead.
+func (d *DeadLetterService) Replay(ctx context.Context, id uint64) (*warehouse_models.StockEventDeadLetter, error) {
+	deadLetter, err := d.claimReplay(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	db := d.db.WithContext(ctx)
+	now := time.Now()
+
+	procErr := d.process(ctx, deadLetter.MessageID, deadLetter.Raw)
+	if procErr != nil {
+		err = db.
+			Model(&warehouse_models.StockEventDeadLetter{}).
+			Where("id = ? AND status = ?", deadLetter.ID, warehouse_models.DeadLetterReplaying).
+			Updates(map[string]interface{}{
+				"status":          warehouse_models.DeadLetterDead,
+				"attempt_count":   gorm.Expr("attempt_count + 1"),
+				"error":           procErr.Error(),
+				"last_attempt_at": now,
+				"updated_at":      now,
+			}).
+			Error
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, procErr
+	}
+
+	err = db.
+		Model(&warehouse_models.StockEventDeadLetter{}).
+		Where("id = ? AND status = ?", deadLetter.ID, warehouse_models.DeadLetterReplaying).
+		Updates(map[string]interface{}{
+			"status":          warehouse_models.DeadLetterReplayed,
+			"attempt_count":   gorm.Expr("attempt_count + 1"),
+			"last_attempt_at": now,
+			"replayed_at":     now,
+			"updated_at":      now,
+		}).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	return d.Get(ctx, id)
+}
+
+var deadLetterStatusMap = map[warehouse_models.DeadLetterStatus]warehouse_service_iface.DeadLetterStatus{
+	warehouse_models.DeadLetterRetrying:  warehouse_service_iface.DeadLetterStatus_DEAD_LETTER_STATUS_RETRYING,
+	warehouse_models.DeadLetterDead:      warehouse_service_iface.DeadLetterStatus_DEAD_LETTER_STATUS_DEAD,
+	warehouse_models.DeadLetterReplaying: warehouse_service_iface.DeadLetterStatus_DEAD_LETTER_STATUS_REPLAYING,
+	warehouse_models.DeadLetterReplayed:  warehouse_service_iface.DeadLetterStatus_DEAD_LETTER_STATUS_REPLAYED,
+	warehouse_models.DeadLetterResolved:  warehouse_service_iface.DeadLetterStatus_DEAD_LETTER_STATUS_RESOLVED,
+}
+
+func deadLetterProto(data *warehouse_models.StockEventDeadLetter) *warehouse_service_iface.DeadLetter {
+	result := &warehouse_service_iface.DeadLetter{
+		Id:            data.ID,
+		MessageId:     data.MessageID,
+		Raw:           data.Raw,
+		Error:         data.Error,
+		AttemptCount:  int64(data.AttemptCount),
+		MaxAttempt:    int64(data.MaxAttempt),
+		Status:        deadLetterStatusMap[data.Status],
+		LastAttemptAt: timestamppb.New(data.LastAttemptAt),
+		CreatedAt:     timestamppb.New(data.CreatedAt),
+		UpdatedAt:     timestamppb.New(data.UpdatedAt),
+	}
+
+	if data.ReplayedAt != nil {
+		result.ReplayedAt = timestamppb.New(*data.ReplayedAt)
+	}
+
+	return result
+}
+
+func deadLetterConnectErr(err error) error {
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, ErrDeadLetterNotDead):
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+
+	return err
+}
+
+type deadLetterServiceImpl struct {
+	service *DeadLetterService
+}
+
+// DeadLetterList implements warehouse_service_ifaceconnect.DeadLetterServiceHandler.
+func (d *deadLetterServiceImpl) DeadLetterList(
+	ctx context.Context,
+	req *connect.Request[warehouse_service_iface.DeadLetterListRequest],
+) (*connect.Response[warehouse_service_iface.DeadLetterListResponse], error) {
+	pay := req.Msg
+
+	var status warehouse_models.DeadLetterStatus
+	for key, value := range deadLetterStatusMap {
+		if value == pay.Status {
+			status = key
+		}
+	}
+
+	page := pay.Page
+	if page == nil {
+		page = &common.PageFilter{Page: 1, Limit: 20}
+	}
+
+	data, pageInfo, err := d.service.List(ctx, status, page)
+	if err != nil {
+		return nil, err
+	}
+
+	result := warehouse_service_iface.DeadLetterListResponse{
+		Data:     make([]*warehouse_service_iface.DeadLetter, len(data)),
+		PageInfo: pageInfo,
+	}
+	for i, item := range data {
+		result.Data[i] = deadLetterProto(item)
+	}
+
+	return connect.NewResponse(&result), nil
+}
+
+// DeadLetterGet implements warehouse_service_ifaceconnect.DeadLetterServiceHandler.
+func (d *deadLetterServiceImpl) DeadLetterGet(
+	ctx context.Context,
+	req *connect.Request[warehouse_service_iface.DeadLetterGetRequest],
+) (*connect.Response[warehouse_service_iface.DeadLetterGetResponse], error) {
+	data, err := d.service.Get(ctx, req.Msg.Id)
+	if err != nil {
+		return nil, deadLetterConnectErr(err)
+	}
+
+	return connect.NewResponse(&warehouse_service_iface.DeadLetterGetResponse{
+		Data: deadLetterProto(data),
+	}), nil
+}
+
+// DeadLetterReplay implements warehouse_service_ifaceconnect.DeadLetterServiceHandler.
+func (d *deadLetterServiceImpl) DeadLetterReplay(
+	ctx context.Context,
+	req *connect.Request[warehouse_service_iface.DeadLetterReplayRequest],
+) (*connect.Response[warehouse_service_iface.DeadLetterReplayResponse], error) {
+	data, err := d.service.Replay(ctx, req.Msg.Id)
+	if err != nil {
+		return nil, deadLetterConnectErr(err)
+	}
+
+	return connect.NewResponse(&warehouse_service_iface.DeadLetterReplayResponse{
+		Data: deadLetterProto(data),
+	}), nil
+}
+
+// NewDeadLetterServiceHandler is connect handler of dead letter list, inspect and replay. request has
+// root and admin request_policy, so it need access interceptor in opts.
+func NewDeadLetterServiceHandler(service *DeadLetterService, opts ...connect.HandlerOption) (string, http.Handler) {
+	return warehouse_service_ifaceconnect.NewDeadLetterServiceHandler(&deadLetterServiceImpl{service}, opts...)
+}
@@ -0,0 +1,262 @@
+package warehouse_service_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/pdcgo/event_source"
+	"github.com/pdcgo/event_source/event_source_mock"
+	"github.com/pdcgo/san_collection/san_caches"
+	"github.com/pdcgo/schema/services/common/v1"
+	role_base "github.com/pdcgo/schema/services/role_base/v1"
+	"github.com/pdcgo/schema/services/warehouse_iface/v1"
+	"github.com/pdcgo/shared/db_models"
+	"github.com/pdcgo/shared/pkg/moretest"
+	"github.com/pdcgo/shared/pkg/moretest/moretest_mock"
+	"github.com/pdcgo/user_service/access_interceptors"
+	"github.com/pdcgo/user_service/identity"
+	"github.com/pdcgo/user_service/user_models"
+	"github.com/pdcgo/warehouse_service/services/warehouse_service/v1/warehouse_service_ifaceconnect"
+	"github.com/pdcgo/warehouse_service/v2"
+	"github.com/pdcgo/warehouse_service/warehouse_models"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+func TestPushDeadLetter(t *testing.T) {
+	var db gorm.DB
+
+	var migrate moretest.SetupFunc = func(t *testing.T) func() error {
+		err := db.AutoMigrate(
+			&db_models.Sku{},
+			&warehouse_models.StockEventLog{},
+			&warehouse_models.StockChangeLog{},
+			&warehouse_models.WarehouseTimezone{},
+			&warehouse_models.StockEventDeadLetter{},
+			&user_models.UserTeamRole{},
+		)
+		assert.NoError(t, err)
+
+		return nil
+	}
+
+	moretest.Suite(t, "testing push dead letter",
+		moretest.SetupListFunc{
+			moretest_mock.MockSqliteDatabase(&db),
+			migrate,
+		},
+		func(t *testing.T) {
+			handler := warehouse_service.NewWarehousePushHandler(&db, event_source.EmptySender, &warehouse_service.DeadLetterPolicy{
+				MaxAttempt: 2,
+			})
+			service := warehouse_service.NewDeadLetterService(&db, event_source.EmptySender)
+
+			t.Run("invalid payload dead on first attempt", func(t *testing.T) {
+				err := handler(t.Context(), &event_source.PushRequest{
+					Message: event_source.PushMessage{
+						MessageID: "invalid-1",
+						Data:      []byte("not json"),
+					},
+				})
+				assert.NoError(t, err)
+
+				deadLetter := warehouse_models.StockEventDeadLetter{}
+				err = db.Where("message_id = ?", "invalid-1").First(&deadLetter).Error
+				assert.NoError(t, err)
+				assert.Equal(t, warehouse_models.DeadLetterDead, deadLetter.Status)
+				assert.Equal(t, 1, deadLetter.AttemptCount)
+				assert.Equal(t, []byte("not json"), deadLetter.Raw)
+			})
+
+			t.Run("failed event retried until max attempt", func(t *testing.T) {
+				event := event_source_mock.NewMockEvent(t, &warehouse_iface.StockEvent{
+					Data: &warehouse_iface.StockEvent_StockChange{
+						StockChange: &warehouse_iface.StockChange{
+							CreatedTime: timestamppb.Now(),
+							Changes: []*warehouse_iface.StockChangeLog{
+								{
+									SkuId:         "sku-not-found",
+									ExternalMsgId: "retry-1",
+									WarehouseId:   1,
+									ChangeCount:   1,
+									ChangeAmount:  100,
+									ActorId:       1,
+									TransactionId: 1,
+									TransactionAt: timestamppb.Now(),
+								},
+							},
+						},
+					},
+				})
+				event.Message.MessageID = "retry-1"
+
+				err := handler(t.Context(), event)
+				assert.Error(t, err)
+
+				deadLetter := warehouse_models.StockEventDeadLetter{}
+				err = db.Where("message_id = ?", "retry-1").First(&deadLetter).Error
+				assert.NoError(t, err)
+				assert.Equal(t, warehouse_models.DeadLetterRetrying, deadLetter.Status)
+				assert.Equal(t, 1, deadLetter.AttemptCount)
+
+				err = handler(t.Context(), event)
+				assert.NoError(t, err)
+
+				err = db.Where("message_id = ?", "retry-1").First(&deadLetter).Error
+				assert.NoError(t, err)
+				assert.Equal(t, warehouse_models.DeadLetterDead, deadLetter.Status)
+				assert.Equal(t, 2, deadLetter.AttemptCount)
+
+				t.Run("list dead letter", func(t *testing.T) {
+					data, pageInfo, err := service.List(t.Context(), warehouse_models.DeadLetterDead, &common.PageFilter{
+						Page:  1,
+						Limit: 10,
+					})
+					assert.NoError(t, err)
+					assert.Len(t, data, 2)
+					assert.Equal(t, int64(2), pageInfo.TotalItems)
+				})
+
+				t.Run("dead letter service on connect only for root", func(t *testing.T) {
+					err := db.Create(&user_models.UserTeamRole{TeamID: 1, UserID: 1, Role: role_base.Role_ROLE_ROOT}).Error
+					assert.NoError(t, err)
+					err = db.Create(&user_models.UserTeamRole{TeamID: 2, UserID: 2, Role: role_base.Role_ROLE_WAREHOUSE_OWNER}).Error
+					assert.NoError(t, err)
+
+					mux := http.NewServeMux()
+					mux.Handle(warehouse_service.NewDeadLetterServiceHandler(
+						service,
+						connect.WithInterceptors(access_interceptors.NewAccessInterceptor(&db, "secret", san_caches.NewSkipCacheManager())),
+					))
+					server := httptest.NewServer(mux)
+					defer server.Close()
+
+					call := func(userID uint32, method string, body string) (int, map[string]any) {
+						req, err := http.NewRequest(http.MethodPost, server.URL+"/"+warehouse_service_ifaceconnect.DeadLetterServiceName+"/"+method, strings.NewReader(body))
+						assert.NoError(t, err)
+						req.Header.Set("Content-Type", "application/json")
+						if userID != 0 {
+							tok := &identity.TokenIdentity{Identity: &role_base.Identity{
+								IdentityId: userID,
+								ExpiredAt:  timestamppb.New(time.Now().Add(time.Hour)),
+							}}
+							token, err := tok.Serialize("secret")
+							assert.NoError(t, err)
+							req.Header.Set("Authorization", "Bearer "+token)
+						}
+
+						res, err := http.DefaultClient.Do(req)
+						assert.NoError(t, err)
+						defer res.Body.Close()
+
+						data := map[string]any{}
+						err = json.NewDecoder(res.Body).Decode(&data)
+						assert.NoError(t, err)
+						return res.StatusCode, data
+					}
+
+					status, _ := call(0, "DeadLetterList", `{"status": "DEAD_LETTER_STATUS_DEAD"}`)
+					assert.Equal(t, http.StatusUnauthorized, status)
+
+					status, _ = call(2, "DeadLetterList", `{"status": "DEAD_LETTER_STATUS_DEAD"}`)
+					assert.Equal(t, http.StatusForbidden, status)
+
+					status, data := call(1, "DeadLetterList", `{"status": "DEAD_LETTER_STATUS_DEAD", "page": {"page": 1, "limit": 10}}`)
+					assert.Equal(t, http.StatusOK, status)
+					assert.Len(t, data["data"], 2)
+					assert.Equal(t, map[string]any{"currentPage": "1", "totalPage": "1", "totalItems": "2"}, data["pageInfo"])
+
+					status, data = call(1, "DeadLetterGet", fmt.Sprintf(`{"id": %d}`, deadLetter.ID))
+					assert.Equal(t, http.StatusOK, status)
+					assert.Equal(t, "retry-1", data["data"].(map[string]any)["messageId"])
+					assert.Equal(t, "DEAD_LETTER_STATUS_DEAD", data["data"].(map[string]any)["status"])
+
+					status, _ = call(1, "DeadLetterGet", `{"id": 999}`)
+					assert.Equal(t, http.StatusNotFound, status)
+				})
+
+				t.Run("replay still failing increase attempt", func(t *testing.T) {
+					_, err := service.Replay(t.Context(), deadLetter.ID)
+					assert.Error(t, err)
+
+					data, err := service.Get(t.Context(), deadLetter.ID)
+					assert.NoError(t, err)
+					assert.Equal(t, 3, data.AttemptCount)
+					assert.Equal(t, warehouse_models.DeadLetterDead, data.Status)
+				})
+
+				t.Run("replay only claim dead", func(t *testing.T) {
+					// other replay already claimed it
+					err := db.
+						Model(&warehouse_models.StockEventDeadLetter{}).
+						Where("id = ?", deadLetter.ID).
+						Update("status", warehouse_models.DeadLetterReplaying).
+						Error
+					assert.NoError(t, err)
+
+					_, err = service.Replay(t.Context(), deadLetter.ID)
+					assert.ErrorIs(t, err, warehouse_service.ErrDeadLetterNotDead)
+
+					data, err := service.Get(t.Context(), deadLetter.ID)
+					assert.NoError(t, err)
+					assert.Equal(t, 3, data.AttemptCount)
+					assert.Equal(t, warehouse_models.DeadLetterReplaying, data.Status)
+
+					_, err = service.Replay(t.Context(), 999)
+					assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+				})
+			})
+
+			t.Run("retrying resolved when redelivery succeed", func(t *testing.T) {
+				event := event_source_mock.NewMockEvent(t, &warehouse_iface.StockEvent{
+					Data: &warehouse_iface.StockEvent_StockChange{
+						StockChange: &warehouse_iface.StockChange{
+							CreatedTime: timestamppb.Now(),
+							Changes: []*warehouse_iface.StockChangeLog{
+								{
+									SkuId:         "sku-resolve",
+									ExternalMsgId: "resolve-1",
+									WarehouseId:   1,
+									ChangeCount:   1,
+									ChangeAmount:  100,
+									ActorId:       1,
+									TransactionId: 1,
+									TransactionAt: timestamppb.Now(),
+								},
+							},
+						},
+					},
+				})
+				event.Message.MessageID = "resolve-1"
+
+				err := handler(t.Context(), event)
+				assert.Error(t, err)
+
+				// event processed by other delivery, so redelivery is deduplicated
+				err = db.Create(&warehouse_models.StockEventLog{
+					ID: "resolve-1",
+				}).Error
+				assert.NoError(t, err)
+
+				err = handler(t.Context(), event)
+				assert.NoError(t, err)
+
+				deadLetter := warehouse_models.StockEventDeadLetter{}
+				err = db.Where("message_id = ?", "resolve-1").First(&deadLetter).Error
+				assert.NoError(t, err)
+				assert.Equal(t, warehouse_models.DeadLetterResolved, deadLetter.Status)
+				assert.Equal(t, 1, deadLetter.AttemptCount)
+
+				_, err = service.Replay(t.Context(), deadLetter.ID)
+				assert.ErrorIs(t, err, warehouse_service.ErrDeadLetterNotDead)
+			})
+		},
+	)
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/pdcgo/shared/db_models"
 	"github.com/pdcgo/shared/pkg/common_helper"
 	"github.com/pdcgo/warehouse_service/warehouse_models"
+	"github.com/pdcgo/warehouse_service/warehouse_query"
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"gorm.io/gorm"
@@ -28,35 +29,69 @@ func (e *EventUnuportedErr) Error() string {
 	return fmt.Sprintf("unsupported event: %T", e.StockEvent)
 }
 
+type EventInvalidErr struct {
+	Err error
+}
+
+// Error implements [error].
+func (e *EventInvalidErr) Error() string {
+	return fmt.Sprintf("invalid event: %s", e.Err.Error())
+}
+
+// Unwrap implements [errors.Unwrap].
+func (e *EventInvalidErr) Unwrap() error {
+	return e.Err
+}
+
 type WarehousePushHandler event_source.PushHandler
 
-func NewWarehousePushHandler(db *gorm.DB, eventSender event_source.EventSender) WarehousePushHandler {
+// NewWarehousePushHandler processing pushed stock event. failed event stored to dead letter,
+// and acknowledged when policy say it will not succeed on redelivery.
+func NewWarehousePushHandler(db *gorm.DB, eventSender event_source.EventSender, policy *DeadLetterPolicy) WarehousePushHandler {
+	process := NewStockEventProcessor(db, eventSender)
 
 	return func(ctx context.Context, msg *event_source.PushRequest) error {
+		err := process(ctx, msg.Message.MessageID, msg.Message.Data)
+		if err == nil {
+			resolveDeadLetter(db.WithContext(ctx), msg.Message.MessageID)
+			return nil
+		}
+
+		return storeDeadLetter(db.WithContext(ctx), policy, msg.Message.MessageID, msg.Message.Data, err)
+	}
+}
+
+type StockEventProcessor func(ctx context.Context, messageID string, data []byte) error
+
+// NewStockEventProcessor is handler chain of stock event, used by push handler and dead letter replay.
+func NewStockEventProcessor(db *gorm.DB, eventSender event_source.EventSender) StockEventProcessor {
+
+	return func(ctx context.Context, messageID string, data []byte) error {
 		var err error
 
 		var event warehouse_iface.StockEvent
-		err = protojson.Unmarshal(msg.Message.Data, &event)
+		err = protojson.Unmarshal(data, &event)
 		if err != nil {
-			return err
+			return &EventInvalidErr{Err: err}
 		}
 
 		// validating message
 		err = protovalidate.GlobalValidator.Validate(&event)
 		if err != nil {
-			return err
+			return &EventInvalidErr{Err: err}
 		}
 
-		messageID := msg.Message.MessageID
-
 		return db.Transaction(func(tx *gorm.DB) error {
+			// change computed here is published through outbox, pushed stock change is already on topic
+			var computed bool
+
 			handler := common_helper.NewChainParam(
 				func(next common_helper.NextFuncParam[*warehouse_iface.StockEvent]) common_helper.NextFuncParam[*warehouse_iface.StockEvent] {
 					return func(event *warehouse_iface.StockEvent) (*warehouse_iface.StockEvent, error) { // deduplicate event
 
 						dedup := warehouse_models.StockEventLog{
 							ID:  messageID,
-							Raw: msg.Message.Data,
+							Raw: data,
 						}
 
 						res := tx.
@@ -79,14 +114,14 @@ func NewWarehousePushHandler(db *gorm.DB, eventSender event_source.EventSender)
 						switch eventData := event.Data.(type) {
 						case *warehouse_iface.StockEvent_RestockAccepted:
 							tx = tx.Debug()
-							changeEvent, err = CreateStockChangeLog(tx, msg.Message.MessageID, eventData.RestockAccepted.TransactionId, warehouse_iface.StockChangeType_STOCK_CHANGE_TYPE_RESTOCK_ACCEPTED)
+							changeEvent, err = CreateStockChangeLog(tx, messageID, eventData.RestockAccepted.TransactionId, warehouse_iface.StockChangeType_STOCK_CHANGE_TYPE_RESTOCK_ACCEPTED)
 
 						case *warehouse_iface.StockEvent_ReturnAccepted:
-							changeEvent, err = CreateStockChangeLog(tx, msg.Message.MessageID, eventData.ReturnAccepted.TransactionId, warehouse_iface.StockChangeType_STOCK_CHANGE_TYPE_RETURN_ACCEPTED)
+							changeEvent, err = CreateStockChangeLog(tx, messageID, eventData.ReturnAccepted.TransactionId, warehouse_iface.StockChangeType_STOCK_CHANGE_TYPE_RETURN_ACCEPTED)
 						case *warehouse_iface.StockEvent_OrderAccepted:
-							changeEvent, err = CreateStockChangeLog(tx, msg.Message.MessageID, eventData.OrderAccepted.TransactionId, warehouse_iface.StockChangeType_STOCK_CHANGE_TYPE_ORDER_ACCEPTED)
+							changeEvent, err = CreateStockChangeLog(tx, messageID, eventData.OrderAccepted.TransactionId, warehouse_iface.StockChangeType_STOCK_CHANGE_TYPE_ORDER_ACCEPTED)
 						case *warehouse_iface.StockEvent_OrderCanceled:
-							changeEvent, err = CreateStockChangeLog(tx, msg.Message.MessageID, eventData.OrderCanceled.TransactionId, warehouse_iface.StockChangeType_STOCK_CHANGE_TYPE_ORDER_CANCELED)
+							changeEvent, err = CreateStockChangeLog(tx, messageID, eventData.OrderCanceled.TransactionId, warehouse_iface.StockChangeType_STOCK_CHANGE_TYPE_ORDER_CANCELED)
 
 						case *warehouse_iface.StockEvent_TransferWarehouseCreated:
 
@@ -95,7 +130,7 @@ func NewWarehousePushHandler(db *gorm.DB, eventSender event_source.EventSender)
 								return changeEvent, err
 							}
 
-							changeEvent, err = CreateStockChangeLog(tx, msg.Message.MessageID, uint64(transfer.OutboundTxID), warehouse_iface.StockChangeType_STOCK_CHANGE_TYPE_TRANSFER_WAREHOUSE_OUT)
+							changeEvent, err = CreateStockChangeLog(tx, messageID, uint64(transfer.OutboundTxID), warehouse_iface.StockChangeType_STOCK_CHANGE_TYPE_TRANSFER_WAREHOUSE_OUT)
 
 						case *warehouse_iface.StockEvent_TransferWarehouseAccepted:
 							transfer, err := getTransfer(tx, eventData.TransferWarehouseAccepted.TransferId)
@@ -103,7 +138,7 @@ func NewWarehousePushHandler(db *gorm.DB, eventSender event_source.EventSender)
 								return changeEvent, err
 							}
 
-							changeEvent, err = CreateStockChangeLog(tx, msg.Message.MessageID, uint64(transfer.InboundTxID), warehouse_iface.StockChangeType_STOCK_CHANGE_TYPE_TRANSFER_WAREHOUSE_IN)
+							changeEvent, err = CreateStockChangeLog(tx, messageID, uint64(transfer.InboundTxID), warehouse_iface.StockChangeType_STOCK_CHANGE_TYPE_TRANSFER_WAREHOUSE_IN)
 
 						case *warehouse_iface.StockEvent_TransferWarehouseCanceled:
 							transfer, err := getTransfer(tx, eventData.TransferWarehouseCanceled.TransferId)
@@ -111,11 +146,11 @@ func NewWarehousePushHandler(db *gorm.DB, eventSender event_source.EventSender)
 								return changeEvent, err
 							}
 
-							changeEvent, err = CreateStockChangeLog(tx, msg.Message.MessageID, uint64(transfer.OutboundTxID), warehouse_iface.StockChangeType_STOCK_CHANGE_TYPE_TRANSFER_WAREHOUSE_OUT_CANCELED)
+							changeEvent, err = CreateStockChangeLog(tx, messageID, uint64(transfer.OutboundTxID), warehouse_iface.StockChangeType_STOCK_CHANGE_TYPE_TRANSFER_WAREHOUSE_OUT_CANCELED)
 						case *warehouse_iface.StockEvent_StockFoundBack:
-							changeEvent, err = CreateStockChangeLog(tx, msg.Message.MessageID, eventData.StockFoundBack.TransactionId, warehouse_iface.StockChangeType_STOCK_CHANGE_TYPE_STOCK_FOUND_BACK)
+							changeEvent, err = CreateStockChangeLog(tx, messageID, eventData.StockFoundBack.TransactionId, warehouse_iface.StockChangeType_STOCK_CHANGE_TYPE_STOCK_FOUND_BACK)
 						case *warehouse_iface.StockEvent_StockProblem:
-							changeEvent, err = CreateStockChangeLog(tx, msg.Message.MessageID, eventData.StockProblem.TransactionId, warehouse_iface.StockChangeType_STOCK_CHANGE_TYPE_STOCK_PROBLEM)
+							changeEvent, err = CreateStockChangeLog(tx, messageID, eventData.StockProblem.TransactionId, warehouse_iface.StockChangeType_STOCK_CHANGE_TYPE_STOCK_PROBLEM)
 
 						case *warehouse_iface.StockEvent_StockChange:
 							return next(event)
@@ -129,6 +164,7 @@ func NewWarehousePushHandler(db *gorm.DB, eventSender event_source.EventSender)
 							return changeEvent, err
 						}
 
+						computed = true
 						return next(changeEvent)
 					}
 
@@ -150,13 +186,27 @@ func NewWarehousePushHandler(db *gorm.DB, eventSender event_source.EventSender)
 
 							stockChange := eventData.StockChange
 
+							// redelivered event (pubsub retry, dead letter replay) has its log already recorded.
+							// only newly inserted log is written to outbox and passed to daily history, so
+							// redelivery is neither published nor counted twice.
+							inserted := []*warehouse_iface.StockChangeLog{}
 							for _, log := range stockChange.Changes {
 
-								err := tx.
+								res := tx.
 									Clauses(clause.OnConflict{DoNothing: true}).
-									Create(log).
-									Error
+									Create(log)
+
+								if res.Error != nil {
+									return event, res.Error
+								}
+								if res.RowsAffected != 0 {
+									inserted = append(inserted, log)
+								}
+							}
+							stockChange.Changes = inserted
 
+							if computed && len(inserted) != 0 {
+								err := CreateStockChangeOutbox(tx, messageID, event)
 								if err != nil {
 									return event, err
 								}
@@ -175,8 +225,14 @@ func NewWarehousePushHandler(db *gorm.DB, eventSender event_source.EventSender)
 						switch eventData := event.Data.(type) {
 						case *warehouse_iface.StockEvent_StockChange:
 							stockChange := eventData.StockChange
+							locs := warehouse_query.NewWarehouseLocationCache(tx)
 
 							for _, log := range stockChange.Changes {
+								loc, err := locs.Get(uint(log.WarehouseId))
+								if err != nil {
+									return event, err
+								}
+
 								var sku db_models.Sku
 								err = tx.
 									Clauses(clause.Locking{Strength: "UPDATE"}).
@@ -226,6 +282,7 @@ func NewWarehousePushHandler(db *gorm.DB, eventSender event_source.EventSender)
 
 								params := map[string]interface{}{
 									"t":             stockChange.CreatedTime.AsTime(),
+									"tz":            loc.String(),
 									"sku_id":        log.SkuId,
 									"warehouse_id":  log.WarehouseId,
 									"init_count":    initCount,
@@ -248,7 +305,7 @@ func NewWarehousePushHandler(db *gorm.DB, eventSender event_source.EventSender)
 													diff_stock_amount
 												)
 												values (
-													date_trunc('day', @t ::timestamptz AT TIME ZONE 'Asia/Jakarta'), 
+													date_trunc('day', @t ::timestamptz AT TIME ZONE @tz), 
 													@sku_id, 
 													@warehouse_id, 
 													(@init_count) - @change_count, 
@@ -467,8 +524,6 @@ func CreateStockChangeLog(
 
 	}
 
-	// sendedLog := []*warehouse_iface.StockChangeLog{}
-
 	for _, log := range logs {
 		log.TransactionAt = timestamppb.New(timetx)
 		log.Type = changeType
@@ -476,22 +531,7 @@ func CreateStockChangeLog(
 
 	}
 
-	// if len(sendedLog) == 0 {
-	// 	return nil
-	// }
-
-	// _, err = eventSender(ctx, &warehouse_iface.StockEvent{
-	// 	Data: &warehouse_iface.StockEvent_StockChange{
-	// 		StockChange: &warehouse_iface.StockChange{
-	// 			Changes:     sendedLog,
-	// 			CreatedTime: timestamppb.New(timetx),
-	// 		},
-	// 	},
-	// })
-	// if err != nil {
-	// 	return err
-	// }
-
+	// change is published by outbox publisher after commit, see CreateStockChangeOutbox
 	return &warehouse_iface.StockEvent{
 		Data: &warehouse_iface.StockEvent_StockChange{
 			StockChange: &warehouse_iface.StockChange{
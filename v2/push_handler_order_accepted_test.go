@@ -36,6 +36,7 @@ func TestOrderAccepted(t *testing.T) {
 					&warehouse_models.InvItemProblem{},
 					&db_models.RestockCost{},
 					&warehouse_models.StockChangeLog{},
+					&warehouse_models.StockChangeOutbox{},
 				)
 				assert.NoError(t, err)
 
@@ -58,7 +59,9 @@ func TestOrderAccepted(t *testing.T) {
 				assert.NoError(t, err)
 
 				var handler warehouse_service.WarehousePushHandler
+				var sendCount int
 				var eventSender event_source.EventSender = func(ctx context.Context, event proto.Message) (string, error) {
+					sendCount += 1
 					assert.IsType(t, &warehouse_iface.StockEvent{}, event)
 
 					_, err := event_source.EmptySender(ctx, event)
@@ -79,7 +82,7 @@ func TestOrderAccepted(t *testing.T) {
 
 					return "", nil
 				}
-				handler = warehouse_service.NewWarehousePushHandler(tx, eventSender)
+				handler = warehouse_service.NewWarehousePushHandler(tx, eventSender, nil)
 
 				event := event_source_mock.NewMockEvent(t, &warehouse_iface.StockEvent{
 					Data: &warehouse_iface.StockEvent_OrderAccepted{
@@ -91,6 +94,13 @@ func TestOrderAccepted(t *testing.T) {
 
 				err = handler(t.Context(), event)
 				assert.NoError(t, err)
+				assert.Equal(t, 0, sendCount)
+
+				// change published after commit through outbox
+				count, err := warehouse_service.NewStockOutboxPublisher(tx, eventSender).PublishPending(t.Context())
+				assert.NoError(t, err)
+				assert.Equal(t, 1, count)
+				assert.Equal(t, 1, sendCount)
 			})
 
 		},
@@ -33,6 +33,7 @@ func TestPushIntegrity(t *testing.T) {
 					err := tx.AutoMigrate(
 						&db_models.Sku{},
 						&warehouse_models.DailySkuHistory{},
+						&warehouse_models.WarehouseTimezone{},
 						&db_models.InvertoryHistory{},
 						&warehouse_models.StockEventLog{},
 						&warehouse_models.StockChangeLog{},
@@ -61,7 +62,7 @@ func TestPushIntegrity(t *testing.T) {
 				migrate(t)
 				seed(t)
 
-				handler := warehouse_service.NewWarehousePushHandler(tx, event_source.EmptySender)
+				handler := warehouse_service.NewWarehousePushHandler(tx, event_source.EmptySender, nil)
 
 				event := event_source_mock.NewMockEvent(t, &warehouse_iface.StockEvent{
 					Data: &warehouse_iface.StockEvent_StockChange{
@@ -133,6 +134,7 @@ func TestPushHandler(t *testing.T) {
 					err := tx.AutoMigrate(
 						&db_models.Sku{},
 						&warehouse_models.DailySkuHistory{},
+						&warehouse_models.WarehouseTimezone{},
 						&warehouse_models.StockEventLog{},
 						&warehouse_models.StockChangeLog{},
 						&db_models.InvertoryHistory{},
@@ -167,7 +169,7 @@ func TestPushHandler(t *testing.T) {
 					func(t *testing.T) {
 						var err error
 
-						handler := warehouse_service.NewWarehousePushHandler(tx, nil)
+						handler := warehouse_service.NewWarehousePushHandler(tx, nil, nil)
 
 						event := event_source_mock.NewMockEvent(t, &warehouse_iface.StockEvent{
 							Data: &warehouse_iface.StockEvent_StockChange{
@@ -4,12 +4,15 @@ import (
 	"net/http"
 
 	"connectrpc.com/connect"
+	"github.com/pdcgo/event_source"
 	"github.com/pdcgo/san_collection/san_caches"
 	"github.com/pdcgo/schema/services/warehouse_iface/v1/warehouse_ifaceconnect"
 	"github.com/pdcgo/shared/configs"
 	"github.com/pdcgo/shared/custom_connect"
 	"github.com/pdcgo/shared/interfaces/authorization_iface"
 	"github.com/pdcgo/user_service/access_interceptors"
+	finance "github.com/pdcgo/warehouse_service"
+	"github.com/pdcgo/warehouse_service/services/warehouse_service/v1/warehouse_service_ifaceconnect"
 	"github.com/pdcgo/warehouse_service/v2/inbound"
 	"github.com/pdcgo/warehouse_service/v2/inventory"
 	"github.com/pdcgo/warehouse_service/v2/outbound"
@@ -28,6 +31,9 @@ func NewRegister(
 	pushHandler WarehousePushHttpHandler,
 	cfg *configs.AppConfig,
 	cacheMgr san_caches.CacheManager,
+	eventSender event_source.EventSender,
+	deadLetterService *DeadLetterService,
+	attachmentService finance.ExpenseAttachmentService,
 	// dispather report.ReportDispatcher,
 ) RegisterHandler {
 	return func() ServiceReflectNames {
@@ -41,19 +47,27 @@ func NewRegister(
 		grpcReflects = append(grpcReflects, warehouse_ifaceconnect.OutboundServiceName)
 
 		path, handler = warehouse_ifaceconnect.NewInboundServiceHandler(
-			inbound.NewInboundService(db, auth),
+			inbound.NewInboundService(db, auth, eventSender),
 			defaultInterceptor,
 		)
 		mux.Handle(path, handler)
 		grpcReflects = append(grpcReflects, warehouse_ifaceconnect.InboundServiceName)
 
+		inventoryService := inventory.NewInventoryService(db, auth)
 		path, handler = warehouse_ifaceconnect.NewInventoryServiceHandler(
-			inventory.NewInventoryService(db, auth),
+			inventoryService,
 			defaultInterceptor,
 		)
 		mux.Handle(path, handler)
 		grpcReflects = append(grpcReflects, warehouse_ifaceconnect.InventoryServiceName)
 
+		path, handler = warehouse_service_ifaceconnect.NewInventoryDetailServiceHandler(
+			inventoryService,
+			defaultInterceptor,
+		)
+		mux.Handle(path, handler)
+		grpcReflects = append(grpcReflects, warehouse_service_ifaceconnect.InventoryDetailServiceName)
+
 		// v2 roling: enforce the (role_base.v1.request_policy) declared on each
 		// WarehouseService request message (admin-only management; reads authenticated;
 		// WarehouseIDs public). Per-handler option — only WarehouseService is gated.
@@ -68,6 +82,56 @@ func NewRegister(
 		mux.Handle(path, handler)
 		grpcReflects = append(grpcReflects, warehouse_ifaceconnect.WarehouseServiceName)
 
+		// legacy finance rpc on connect, permission is checked in service method with legacy authorization.
+		financeService := finance.NewWarehouseFinanceService(db, auth, eventSender)
+		path, handler = finance.NewWarehouseFinanceServiceHandler(
+			auth,
+			financeService,
+			defaultInterceptor,
+		)
+		mux.Handle(path, handler)
+		grpcReflects = append(grpcReflects, finance.WarehouseFinanceServiceName)
+
+		path, handler = finance.NewWarehouseFinanceExtServiceHandler(
+			auth,
+			financeService,
+			defaultInterceptor,
+		)
+		mux.Handle(path, handler)
+		grpcReflects = append(grpcReflects, warehouse_service_ifaceconnect.WarehouseFinanceExtServiceName)
+		mux.Handle("/finance_exports/", finance.NewFinanceExportHttpHandler(auth, financeService))
+
+		path, handler = finance.NewExpenseAttachmentServiceHandler(
+			auth,
+			attachmentService,
+			defaultInterceptor,
+		)
+		mux.Handle(path, handler)
+		grpcReflects = append(grpcReflects, warehouse_service_ifaceconnect.ExpenseAttachmentServiceName)
+
+		path, handler = NewDeadLetterServiceHandler(
+			deadLetterService,
+			defaultInterceptor,
+			warehouseRoleOpt,
+		)
+		mux.Handle(path, handler)
+
+		path, handler = warehouse_service_ifaceconnect.NewDailyHistoryServiceHandler(
+			inventory.NewDailyHistoryService(db),
+			defaultInterceptor,
+			warehouseRoleOpt,
+		)
+		mux.Handle(path, handler)
+		grpcReflects = append(grpcReflects, warehouse_service_ifaceconnect.DailyHistoryServiceName)
+
+		path, handler = warehouse_service_ifaceconnect.NewStockReconciliationServiceHandler(
+			inventory.NewStockReconciliationService(db),
+			defaultInterceptor,
+			warehouseRoleOpt,
+		)
+		mux.Handle(path, handler)
+		grpcReflects = append(grpcReflects, warehouse_service_ifaceconnect.StockReconciliationServiceName)
+
 		mux.HandleFunc("/push", pushHandler)
 
 		return grpcReflects
@@ -0,0 +1,230 @@
+package warehouse_service
+
+import (
+	"context"
+	"crypto/rand"
+	"log/slog"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/pdcgo/event_source"
+	"github.com/pdcgo/schema/services/warehouse_iface/v1"
+	"github.com/pdcgo/warehouse_service/warehouse_models"
+	"google.golang.org/protobuf/encoding/protojson"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	DefaultOutboxMaxAttempt = 10
+	DefaultOutboxBatchSize  = 100
+	DefaultOutboxInterval   = 5 * time.Second
+	DefaultOutboxLease      = time.Minute
+)
+
+// CreateStockChangeOutbox writing stock change event to outbox, must be called in same transaction
+// as stock_change_logs so event is never published for rolled back change and never lost for committed one.
+func CreateStockChangeOutbox(tx *gorm.DB, messageID string, event *warehouse_iface.StockEvent) error {
+	payload, err := protojson.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	return tx.
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&warehouse_models.StockChangeOutbox{
+			MessageID:     messageID,
+			Payload:       payload,
+			Status:        warehouse_models.OutboxPending,
+			NextAttemptAt: time.Now(),
+		}).
+		Error
+}
+
+// StockOutboxPublisher sending pending outbox through event sender. failed send is retried with backoff
+// until MaxAttempt, after that status become failed. Lease is how long claimed outbox is kept from other
+// publisher, must be longer than sending one batch.
+type StockOutboxPublisher struct {
+	db          *gorm.DB
+	eventSender event_source.EventSender
+
+	MaxAttempt int
+	BatchSize  int
+	Interval   time.Duration
+	Lease      time.Duration
+}
+
+func NewStockOutboxPublisher(db *gorm.DB, eventSender event_source.EventSender) *StockOutboxPublisher {
+	publisher := StockOutboxPublisher{
+		db:          db,
+		eventSender: eventSender,
+		MaxAttempt:  DefaultOutboxMaxAttempt,
+		BatchSize:   DefaultOutboxBatchSize,
+		Interval:    DefaultOutboxInterval,
+		Lease:       DefaultOutboxLease,
+	}
+
+	maxAttempt, err := strconv.Atoi(os.Getenv("OUTBOX_MAX_ATTEMPT"))
+	if err == nil && maxAttempt > 0 {
+		publisher.MaxAttempt = maxAttempt
+	}
+
+	return &publisher
+}
+
+// Run publishing pending outbox every interval until context canceled.
+func (p *StockOutboxPublisher) Run(ctx context.Context) {
+	ticker := time.NewTicker(p.Interval)
+	defer ticker.Stop()
+
+	for {
+		// publishing until no full batch left before waiting
+		for {
+			count, err := p.PublishPending(ctx)
+			if err != nil {
+				slog.Error("publishing stock change outbox failed", "err", err.Error())
+				break
+			}
+			if count < p.BatchSize {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// PublishPending publishing one batch of pending outbox that is due, returning count of outbox processed.
+// batch is claimed in short transaction, then sent outside transaction and outcome recorded per outbox,
+// so slow event sender never hold row lock. claim not recorded until lease end (crashed instance) is due again.
+func (p *StockOutboxPublisher) PublishPending(ctx context.Context) (int, error) {
+	outboxes, err := p.claim(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, outbox := range outboxes {
+		claimID := outbox.ClaimID
+		p.publish(ctx, outbox)
+		outbox.ClaimID = ""
+
+		res := p.db.
+			WithContext(ctx).
+			Model(outbox).
+			Where("claim_id = ?", claimID).
+			Select("status", "error", "published_id", "next_attempt_at", "sent_at", "claim_id").
+			Updates(outbox)
+		if res.Error != nil {
+			return len(outboxes), res.Error
+		}
+		if res.RowsAffected == 0 {
+			slog.Warn("stock change outbox lease lost before outcome recorded", "message_id", outbox.MessageID)
+		}
+	}
+
+	return len(outboxes), nil
+}
+
+// claim is locking due outbox with skip locked so several instance can run publisher, and marking it
+// publishing with attempt counted until lease end.
+func (p *StockOutboxPublisher) claim(ctx context.Context) ([]*warehouse_models.StockChangeOutbox, error) {
+	outboxes := []*warehouse_models.StockChangeOutbox{}
+	claimID := rand.Text()
+	now := time.Now()
+
+	err := p.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.
+			Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("status IN ?", []warehouse_models.OutboxStatus{
+				warehouse_models.OutboxPending,
+				warehouse_models.OutboxPublishing,
+			}).
+			Where("next_attempt_at <= ?", now).
+			Order("id").
+			Limit(p.BatchSize).
+			Find(&outboxes).
+			Error
+		if err != nil {
+			return err
+		}
+		if len(outboxes) == 0 {
+			return nil
+		}
+
+		ids := make([]uint64, len(outboxes))
+		for i, outbox := range outboxes {
+			ids[i] = outbox.ID
+			outbox.Status = warehouse_models.OutboxPublishing
+			outbox.ClaimID = claimID
+			outbox.AttemptCount += 1
+			outbox.NextAttemptAt = now.Add(p.Lease)
+		}
+
+		return tx.
+			Model(&warehouse_models.StockChangeOutbox{}).
+			Where("id IN ?", ids).
+			Updates(map[string]interface{}{
+				"status":          warehouse_models.OutboxPublishing,
+				"claim_id":        claimID,
+				"attempt_count":   gorm.Expr("attempt_count + 1"),
+				"next_attempt_at": now.Add(p.Lease),
+			}).
+			Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return outboxes, nil
+}
+
+// publish is sending claimed outbox and setting its outcome, attempt is already counted on claim.
+func (p *StockOutboxPublisher) publish(ctx context.Context, outbox *warehouse_models.StockChangeOutbox) {
+	now := time.Now()
+
+	var event warehouse_iface.StockEvent
+	err := protojson.Unmarshal(outbox.Payload, &event)
+	if err == nil {
+		outbox.PublishedID, err = p.eventSender(ctx, &event)
+	}
+	if err == nil {
+		outbox.Status = warehouse_models.OutboxSent
+		outbox.Error = ""
+		outbox.SentAt = &now
+		return
+	}
+
+	outbox.Error = err.Error()
+	if outbox.AttemptCount >= p.MaxAttempt {
+		outbox.Status = warehouse_models.OutboxFailed
+		slog.Error("stock change outbox failed", "message_id", outbox.MessageID, "err", outbox.Error)
+		return
+	}
+
+	// backoff 1, 4, 9 ... minutes
+	outbox.Status = warehouse_models.OutboxPending
+	outbox.NextAttemptAt = now.Add(time.Duration(outbox.AttemptCount*outbox.AttemptCount) * time.Minute)
+}
+
+// RetryFailed putting failed outbox back to pending with attempt reset, 0 id is all failed outbox.
+func (p *StockOutboxPublisher) RetryFailed(ctx context.Context, id uint64) (int64, error) {
+	query := p.db.
+		WithContext(ctx).
+		Model(&warehouse_models.StockChangeOutbox{}).
+		Where("status = ?", warehouse_models.OutboxFailed)
+	if id != 0 {
+		query = query.Where("id = ?", id)
+	}
+
+	res := query.Updates(map[string]interface{}{
+		"status":          warehouse_models.OutboxPending,
+		"attempt_count":   0,
+		"next_attempt_at": time.Now(),
+	})
+	return res.RowsAffected, res.Error
+}
@@ -0,0 +1,198 @@
+package warehouse_service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/pdcgo/schema/services/warehouse_iface/v1"
+	"github.com/pdcgo/shared/pkg/moretest"
+	"github.com/pdcgo/shared/pkg/moretest/moretest_mock"
+	"github.com/pdcgo/warehouse_service/v2"
+	"github.com/pdcgo/warehouse_service/warehouse_models"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+func TestStockOutbox(t *testing.T) {
+	var db gorm.DB
+
+	var migrate moretest.SetupFunc = func(t *testing.T) func() error {
+		err := db.AutoMigrate(
+			&warehouse_models.StockChangeOutbox{},
+		)
+		assert.NoError(t, err)
+
+		return nil
+	}
+
+	moretest.Suite(t, "testing stock change outbox",
+		moretest.SetupListFunc{
+			moretest_mock.MockSqliteDatabase(&db),
+			migrate,
+		},
+		func(t *testing.T) {
+			event := &warehouse_iface.StockEvent{
+				Data: &warehouse_iface.StockEvent_StockChange{
+					StockChange: &warehouse_iface.StockChange{
+						CreatedTime: timestamppb.Now(),
+						Changes: []*warehouse_iface.StockChangeLog{
+							{
+								SkuId:         "11111111",
+								ExternalMsgId: "msg-1",
+								WarehouseId:   1,
+								ChangeCount:   -2,
+								ChangeAmount:  -6000,
+								ActorId:       1,
+								TransactionId: 1,
+							},
+						},
+					},
+				},
+			}
+
+			var sendErr error
+			var sended []proto.Message
+			publisher := warehouse_service.NewStockOutboxPublisher(&db, func(ctx context.Context, event proto.Message) (string, error) {
+				if sendErr != nil {
+					return "", sendErr
+				}
+
+				sended = append(sended, event)
+				return "published-1", nil
+			})
+			publisher.MaxAttempt = 2
+
+			t.Run("outbox written once per message", func(t *testing.T) {
+				err := warehouse_service.CreateStockChangeOutbox(&db, "msg-1", event)
+				assert.NoError(t, err)
+				err = warehouse_service.CreateStockChangeOutbox(&db, "msg-1", event)
+				assert.NoError(t, err)
+
+				var count int64
+				err = db.Model(&warehouse_models.StockChangeOutbox{}).Count(&count).Error
+				assert.NoError(t, err)
+				assert.Equal(t, int64(1), count)
+			})
+
+			t.Run("failed send retried with backoff", func(t *testing.T) {
+				sendErr = errors.New("pubsub unavailable")
+
+				count, err := publisher.PublishPending(t.Context())
+				assert.NoError(t, err)
+				assert.Equal(t, 1, count)
+
+				outbox := warehouse_models.StockChangeOutbox{}
+				err = db.Where("message_id = ?", "msg-1").First(&outbox).Error
+				assert.NoError(t, err)
+				assert.Equal(t, warehouse_models.OutboxPending, outbox.Status)
+				assert.Equal(t, 1, outbox.AttemptCount)
+				assert.Equal(t, "pubsub unavailable", outbox.Error)
+				assert.True(t, outbox.NextAttemptAt.After(time.Now()))
+
+				t.Run("not due yet", func(t *testing.T) {
+					count, err := publisher.PublishPending(t.Context())
+					assert.NoError(t, err)
+					assert.Equal(t, 0, count)
+				})
+
+				t.Run("failed on max attempt", func(t *testing.T) {
+					err := db.Model(&outbox).Update("next_attempt_at", time.Now().Add(-time.Second)).Error
+					assert.NoError(t, err)
+
+					count, err := publisher.PublishPending(t.Context())
+					assert.NoError(t, err)
+					assert.Equal(t, 1, count)
+
+					err = db.First(&outbox, outbox.ID).Error
+					assert.NoError(t, err)
+					assert.Equal(t, warehouse_models.OutboxFailed, outbox.Status)
+					assert.Equal(t, 2, outbox.AttemptCount)
+				})
+			})
+
+			t.Run("retry failed and sent", func(t *testing.T) {
+				sendErr = nil
+
+				affected, err := publisher.RetryFailed(t.Context(), 0)
+				assert.NoError(t, err)
+				assert.Equal(t, int64(1), affected)
+
+				count, err := publisher.PublishPending(t.Context())
+				assert.NoError(t, err)
+				assert.Equal(t, 1, count)
+
+				assert.Len(t, sended, 1)
+				assert.True(t, proto.Equal(event, sended[0]))
+
+				outbox := warehouse_models.StockChangeOutbox{}
+				err = db.Where("message_id = ?", "msg-1").First(&outbox).Error
+				assert.NoError(t, err)
+				assert.Equal(t, warehouse_models.OutboxSent, outbox.Status)
+				assert.Equal(t, "published-1", outbox.PublishedID)
+				assert.NotNil(t, outbox.SentAt)
+				assert.Empty(t, outbox.Error)
+				assert.Empty(t, outbox.ClaimID)
+			})
+
+			t.Run("claimed outbox sent outside transaction once", func(t *testing.T) {
+				err := warehouse_service.CreateStockChangeOutbox(&db, "msg-2", event)
+				assert.NoError(t, err)
+
+				var inFlight warehouse_models.StockChangeOutbox
+				var nested int
+				publisher := warehouse_service.NewStockOutboxPublisher(&db, func(ctx context.Context, event proto.Message) (string, error) {
+					// other publisher running while this one sending
+					err := db.Where("message_id = ?", "msg-2").First(&inFlight).Error
+					assert.NoError(t, err)
+					nested, err = warehouse_service.NewStockOutboxPublisher(&db, nil).PublishPending(ctx)
+					assert.NoError(t, err)
+					return "published-2", nil
+				})
+
+				count, err := publisher.PublishPending(t.Context())
+				assert.NoError(t, err)
+				assert.Equal(t, 1, count)
+				assert.Equal(t, 0, nested)
+				assert.Equal(t, warehouse_models.OutboxPublishing, inFlight.Status)
+				assert.NotEmpty(t, inFlight.ClaimID)
+				assert.Equal(t, 1, inFlight.AttemptCount)
+
+				outbox := warehouse_models.StockChangeOutbox{}
+				err = db.Where("message_id = ?", "msg-2").First(&outbox).Error
+				assert.NoError(t, err)
+				assert.Equal(t, warehouse_models.OutboxSent, outbox.Status)
+				assert.Equal(t, "published-2", outbox.PublishedID)
+			})
+
+			t.Run("claim after lease end is due again", func(t *testing.T) {
+				err := warehouse_service.CreateStockChangeOutbox(&db, "msg-3", event)
+				assert.NoError(t, err)
+
+				// claimed by publisher that crashed before recording outcome
+				err = db.Model(&warehouse_models.StockChangeOutbox{}).
+					Where("message_id = ?", "msg-3").
+					Updates(map[string]interface{}{
+						"status":          warehouse_models.OutboxPublishing,
+						"claim_id":        "crashed",
+						"attempt_count":   1,
+						"next_attempt_at": time.Now().Add(-time.Second),
+					}).Error
+				assert.NoError(t, err)
+
+				count, err := publisher.PublishPending(t.Context())
+				assert.NoError(t, err)
+				assert.Equal(t, 1, count)
+
+				outbox := warehouse_models.StockChangeOutbox{}
+				err = db.Where("message_id = ?", "msg-3").First(&outbox).Error
+				assert.NoError(t, err)
+				assert.Equal(t, warehouse_models.OutboxSent, outbox.Status)
+				assert.Equal(t, 2, outbox.AttemptCount)
+			})
+		},
+	)
+}
@@ -2,12 +2,344 @@ package warehouse
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"connectrpc.com/connect"
+	role_base "github.com/pdcgo/schema/services/role_base/v1"
 	"github.com/pdcgo/schema/services/warehouse_iface/v1"
+	"github.com/pdcgo/shared/authorization"
+	"github.com/pdcgo/shared/db_models"
+	"github.com/pdcgo/user_service/access_interceptors"
+	"github.com/pdcgo/user_service/user_models"
+	"gorm.io/gorm"
 )
 
+// StockVolume is stock count and its value.
+type StockVolume struct {
+	Count  int64   `json:"count"`
+	Amount float64 `json:"amount"`
+}
+
+// WarehouseKpi is warehouse level stat of a time range, computed from daily_sku_histories
+// (stock snapshot) and stock_change_logs (stock movement).
+type WarehouseKpi struct {
+	StartStock StockVolume `json:"start_stock"`
+	EndStock   StockVolume `json:"end_stock"`
+
+	Inbound  map[warehouse_iface.StockChangeType]*StockVolume `json:"inbound"`
+	Outbound map[warehouse_iface.StockChangeType]*StockVolume `json:"outbound"`
+
+	ActiveSkuCount  int64 `json:"active_sku_count"`
+	ActiveRackCount int64 `json:"active_rack_count"`
+}
+
+type stockChangeVolume struct {
+	Type           warehouse_iface.StockChangeType
+	InboundCount   int64
+	InboundAmount  float64
+	OutboundCount  int64
+	OutboundAmount float64
+}
+
+// ComputeWarehouseKpi computing stat of warehouse between start and end. teamID is optional for
+// scoping sku to one team.
+func ComputeWarehouseKpi(db *gorm.DB, warehouseID, teamID uint64, start, end time.Time) (*WarehouseKpi, error) {
+	var err error
+
+	result := WarehouseKpi{
+		Inbound:  map[warehouse_iface.StockChangeType]*StockVolume{},
+		Outbound: map[warehouse_iface.StockChangeType]*StockVolume{},
+	}
+
+	skuScope := func(query *gorm.DB, field string) *gorm.DB {
+		if teamID == 0 {
+			return query
+		}
+
+		return query.
+			Where(field+" IN (?)",
+				db.
+					Table("skus s").
+					Where("s.warehouse_id = ?", warehouseID).
+					Where("s.team_id = ?", teamID).
+					Select("s.id"),
+			)
+	}
+
+	// stock on start is end of last day before range, or start of first day in range when
+	// sku has no history before range.
+	startQuery := db.
+		Table("daily_sku_histories dsh").
+		Where("dsh.warehouse_id = ?", warehouseID).
+		Where("dsh.t <= ?", end).
+		Select(`
+			distinct on (dsh.sku_id)
+			dsh.sku_id,
+			case when dsh.t < @start then dsh.end_stock_count else dsh.start_stock_count end as count,
+			case when dsh.t < @start then dsh.end_stock_amount else dsh.start_stock_amount end as amount
+		`, map[string]interface{}{"start": start}).
+		Order(gorm.Expr("dsh.sku_id, (dsh.t < ?) desc, case when dsh.t < ? then dsh.t end desc, dsh.t asc", start, start))
+	startQuery = skuScope(startQuery, "dsh.sku_id")
+
+	err = db.
+		Table("(?) as d", startQuery).
+		Select("coalesce(sum(d.count), 0) as count, coalesce(sum(d.amount), 0) as amount").
+		Scan(&result.StartStock).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	endQuery := db.
+		Table("daily_sku_histories dsh").
+		Where("dsh.warehouse_id = ?", warehouseID).
+		Where("dsh.t <= ?", end).
+		Select([]string{
+			"distinct on (dsh.sku_id) dsh.sku_id",
+			"dsh.end_stock_count as count",
+			"dsh.end_stock_amount as amount",
+		}).
+		Order("dsh.sku_id, dsh.t desc")
+	endQuery = skuScope(endQuery, "dsh.sku_id")
+
+	err = db.
+		Table("(?) as d", endQuery).
+		Select("coalesce(sum(d.count), 0) as count, coalesce(sum(d.amount), 0) as amount").
+		Scan(&result.EndStock).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	err = db.
+		Table("(?) as d", endQuery).
+		Where("d.count > 0").
+		Count(&result.ActiveSkuCount).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	changeQuery := db.
+		Table("stock_change_logs scl").
+		Where("scl.warehouse_id = ?", warehouseID).
+		Where("scl.transaction_at >= ?", start).
+		Where("scl.transaction_at <= ?", end).
+		Group("scl.type").
+		Select([]string{
+			"scl.type",
+			"coalesce(sum(case when scl.change_count > 0 then scl.change_count else 0 end), 0) as inbound_count",
+			"coalesce(sum(case when scl.change_count > 0 then scl.change_amount else 0 end), 0) as inbound_amount",
+			"coalesce(sum(case when scl.change_count < 0 then scl.change_count * -1 else 0 end), 0) as outbound_count",
+			"coalesce(sum(case when scl.change_count < 0 then scl.change_amount * -1 else 0 end), 0) as outbound_amount",
+		})
+	changeQuery = skuScope(changeQuery, "scl.sku_id")
+
+	volumes := []*stockChangeVolume{}
+	err = changeQuery.
+		Find(&volumes).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, volume := range volumes {
+		if volume.InboundCount != 0 {
+			result.Inbound[volume.Type] = &StockVolume{
+				Count:  volume.InboundCount,
+				Amount: volume.InboundAmount,
+			}
+		}
+		if volume.OutboundCount != 0 {
+			result.Outbound[volume.Type] = &StockVolume{
+				Count:  volume.OutboundCount,
+				Amount: volume.OutboundAmount,
+			}
+		}
+	}
+
+	rackQuery := db.
+		Table("placements p").
+		Joins("join racks r on r.id = p.rack_id").
+		Where("r.warehouse_id = ?", warehouseID).
+		Where("r.deleted != ?", true).
+		Where("p.count > 0")
+	rackQuery = skuScope(rackQuery, "p.sku_id")
+
+	err = rackQuery.
+		Distinct("p.rack_id").
+		Count(&result.ActiveRackCount).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// RefreshWarehouseStat updating rack_count, product_count and capacity on warehouses from
+// current racks, skus and placements. run by warehouse-stat command, not on read.
+func RefreshWarehouseStat(tx *gorm.DB, warehouseID uint64) error {
+	rackCount := tx.
+		Table("racks r").
+		Where("r.warehouse_id = ?", warehouseID).
+		Where("r.deleted != ?", true).
+		Select("count(1)")
+
+	productCount := tx.
+		Table("skus s").
+		Where("s.warehouse_id = ?", warehouseID).
+		Where("s.stock_ready > 0").
+		Select("count(1)")
+
+	capacity := tx.
+		Table("placements p").
+		Joins("join racks r on r.id = p.rack_id").
+		Where("r.warehouse_id = ?", warehouseID).
+		Where("r.deleted != ?", true).
+		Select("coalesce(sum(p.count), 0)")
+
+	return tx.
+		Model(&db_models.Warehouse{}).
+		Where("id = ?", warehouseID).
+		Updates(map[string]interface{}{
+			"rack_count":    gorm.Expr("(?)", rackCount),
+			"product_count": gorm.Expr("(?)", productCount),
+			"capacity":      gorm.Expr("(?)", capacity),
+		}).
+		Error
+}
+
+type readyStockMetric struct {
+	SkuID     string
+	ProductID uint64
+	Name      string
+}
+
+// checkStatAccess allowing caller with role in warehouse team, or root and admin of root team.
+func (w *warehouseServiceImpl) checkStatAccess(ctx context.Context, warehouseID uint64) error {
+	caller, err := access_interceptors.GetIdentityFromCtx(ctx)
+	if err != nil {
+		return connect.NewError(connect.CodeUnauthenticated, err)
+	}
+
+	var count int64
+	err = w.db.
+		WithContext(ctx).
+		Model(&user_models.UserTeamRole{}).
+		Where("user_id = ?", caller.IdentityId).
+		Where("(team_id = ? AND role IN ?) OR team_id = ?",
+			authorization.RootDomain,
+			[]role_base.Role{role_base.Role_ROLE_ROOT, role_base.Role_ROLE_ADMIN},
+			warehouseID,
+		).
+		Count(&count).
+		Error
+	if err != nil {
+		return err
+	}
+
+	if count == 0 {
+		return connect.NewError(connect.CodePermissionDenied, errors.New("requires a role in the warehouse"))
+	}
+
+	return nil
+}
+
 // Stat implements [warehouse_ifaceconnect.WarehouseServiceHandler].
-func (w *warehouseServiceImpl) Stat(context.Context, *connect.Request[warehouse_iface.StatRequest]) (*connect.Response[warehouse_iface.StatResponse], error) {
-	panic("unimplemented")
+//
+// StatResponse only have product metric for now, warehouse KPI available from warehouse-stat
+// command through ComputeWarehouseKpi until schema have field for it.
+func (w *warehouseServiceImpl) Stat(
+	ctx context.Context,
+	req *connect.Request[warehouse_iface.StatRequest],
+) (*connect.Response[warehouse_iface.StatResponse], error) {
+	var err error
+
+	pay := req.Msg
+	filter := pay.Filter
+	if filter == nil || filter.WarehouseId == 0 {
+		return nil, errors.New("warehouse_id empty")
+	}
+	if pay.Range == nil {
+		return nil, errors.New("range empty")
+	}
+
+	start := pay.Range.Start.AsTime()
+	end := pay.Range.End.AsTime()
+
+	topN := int(filter.TopN)
+	if topN <= 0 {
+		topN = 10
+	}
+
+	result := warehouse_iface.StatResponse{
+		Metrics: []*warehouse_iface.Metric{},
+	}
+
+	err = w.checkStatAccess(ctx, filter.WarehouseId)
+	if err != nil {
+		return nil, err
+	}
+
+	db := w.db.WithContext(ctx)
+
+	for _, metricType := range pay.MetricTypes {
+		switch metricType {
+		case warehouse_iface.MetricType_METRIC_TYPE_PRODUCT_HISTORY_READY_STOCK:
+			endQuery := db.
+				Table("daily_sku_histories dsh").
+				Where("dsh.warehouse_id = ?", filter.WarehouseId).
+				Where("dsh.t >= ?", start).
+				Where("dsh.t <= ?", end).
+				Select([]string{
+					"distinct on (dsh.sku_id) dsh.sku_id",
+					"dsh.end_stock_count",
+				}).
+				Order("dsh.sku_id, dsh.t desc")
+
+			query := db.
+				Table("(?) as d", endQuery).
+				Joins("join skus s on s.id = d.sku_id").
+				Joins("join products p on p.id = s.product_id").
+				Where("d.end_stock_count > 0")
+
+			if filter.TeamId != 0 {
+				query = query.Where("s.team_id = ?", filter.TeamId)
+			}
+
+			metrics := []*readyStockMetric{}
+			err = query.
+				Select([]string{
+					"d.sku_id",
+					"s.product_id",
+					"p.name",
+				}).
+				Order("d.end_stock_count desc").
+				Limit(topN).
+				Find(&metrics).
+				Error
+			if err != nil {
+				return nil, err
+			}
+
+			for _, metric := range metrics {
+				result.Metrics = append(result.Metrics, &warehouse_iface.Metric{
+					Data: &warehouse_iface.Metric_ProductHistoryReadyStock{
+						ProductHistoryReadyStock: &warehouse_iface.ProductHistoryReadyStockMetric{
+							ProductId: metric.ProductID,
+							Sku:       metric.SkuID,
+							Name:      metric.Name,
+						},
+					},
+				})
+			}
+
+		default:
+			return nil, errors.New("metric type not supported")
+		}
+	}
+
+	return connect.NewResponse(&result), nil
 }
@@ -0,0 +1,122 @@
+package warehouse_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/pdcgo/schema/services/common/v1"
+	role_base "github.com/pdcgo/schema/services/role_base/v1"
+	warehouse_iface "github.com/pdcgo/schema/services/warehouse_iface/v1"
+	"github.com/pdcgo/shared/db_models"
+	"github.com/pdcgo/shared/pkg/moretest"
+	"github.com/pdcgo/shared/pkg/moretest/moretest_mock"
+	"github.com/pdcgo/user_service/access_interceptors"
+	"github.com/pdcgo/user_service/user_models"
+	"github.com/pdcgo/warehouse_service/v2/warehouse"
+	"github.com/pdcgo/warehouse_service/warehouse_models"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+func TestWarehouseStat(t *testing.T) {
+	var scenario moretest_mock.DbScenario
+	moretest.Suite(t, "warehouse stat",
+		moretest.SetupListFunc{moretest_mock.MockPostgresDatabase(&scenario)},
+		func(t *testing.T) {
+			scenario(t, func(tx *gorm.DB) {
+				err := tx.AutoMigrate(
+					&db_models.Warehouse{},
+					&db_models.Product{},
+					&db_models.Sku{},
+					&db_models.Rack{},
+					&db_models.Placement{},
+					&warehouse_models.DailySkuHistory{},
+					&warehouse_models.StockChangeLog{},
+					&user_models.UserTeamRole{},
+				)
+				assert.NoError(t, err)
+
+				now := time.Now()
+				assert.NoError(t, tx.Create(&db_models.Warehouse{ID: 1, Name: "Main WH", WarehouseStat: &db_models.WarehouseStat{}}).Error)
+				assert.NoError(t, tx.Create(&db_models.Product{ID: 1, TeamID: 2, Name: "kaos"}).Error)
+				assert.NoError(t, tx.Create(&db_models.Sku{ID: "sku-1", ProductID: 1, VariantID: 1, TeamID: 2, WarehouseID: 1, StockReady: 8}).Error)
+				assert.NoError(t, tx.Create(&db_models.Rack{ID: 1, WarehouseID: 1, Name: "A1"}).Error)
+				assert.NoError(t, tx.Create(&db_models.Placement{RackID: 1, SkuID: "sku-1", Count: 8}).Error)
+				assert.NoError(t, tx.Create(&[]warehouse_models.DailySkuHistory{
+					{T: now.AddDate(0, 0, -2), SkuID: "sku-1", WarehouseID: 1, StartStockCount: 0, EndStockCount: 10, EndStockAmount: 10000},
+					{T: now, SkuID: "sku-1", WarehouseID: 1, StartStockCount: 10, StartStockAmount: 10000, EndStockCount: 8, EndStockAmount: 8000},
+				}).Error)
+				assert.NoError(t, tx.Create(&warehouse_models.StockChangeLog{
+					SkuID:         "sku-1",
+					ExternalMsgId: "msg-1",
+					WarehouseID:   1,
+					ChangeCount:   -2,
+					ChangeAmount:  -2000,
+					TransactionAt: now,
+					Type:          warehouse_iface.StockChangeType_STOCK_CHANGE_TYPE_ORDER_ACCEPTED,
+				}).Error)
+
+				// user 5 is staff of warehouse 1, user 6 has no role
+				assert.NoError(t, tx.Create(&user_models.UserTeamRole{TeamID: 1, UserID: 5, Role: role_base.Role_ROLE_WAREHOUSE_STAFF}).Error)
+
+				svc := warehouse.NewWarehouseService(tx)
+				withCaller := func(userID uint32) context.Context {
+					return access_interceptors.SetIdentityToCtx(context.Background(), &role_base.Identity{IdentityId: userID})
+				}
+				stat := func(ctx context.Context) (*connect.Response[warehouse_iface.StatResponse], error) {
+					return svc.Stat(ctx, connect.NewRequest(&warehouse_iface.StatRequest{
+						Range: &common.StatTimeRange{
+							Start: timestamppb.New(now.AddDate(0, 0, -1)),
+							End:   timestamppb.New(now.Add(time.Hour)),
+						},
+						Filter:      &warehouse_iface.StatFilter{WarehouseId: 1},
+						MetricTypes: []warehouse_iface.MetricType{warehouse_iface.MetricType_METRIC_TYPE_PRODUCT_HISTORY_READY_STOCK},
+					}))
+				}
+
+				t.Run("test stat without identity", func(t *testing.T) {
+					_, err := stat(context.Background())
+					assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+				})
+
+				t.Run("test stat without role in warehouse", func(t *testing.T) {
+					_, err := stat(withCaller(6))
+					assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+				})
+
+				t.Run("test stat not writing warehouse", func(t *testing.T) {
+					res, err := stat(withCaller(5))
+					assert.NoError(t, err)
+					assert.Len(t, res.Msg.Metrics, 1)
+
+					wh := db_models.Warehouse{}
+					assert.NoError(t, tx.First(&wh, 1).Error)
+					assert.Equal(t, uint(0), wh.WarehouseStat.RackCount)
+				})
+
+				t.Run("test refresh warehouse stat", func(t *testing.T) {
+					err := warehouse.RefreshWarehouseStat(tx, 1)
+					assert.NoError(t, err)
+
+					wh := db_models.Warehouse{}
+					assert.NoError(t, tx.First(&wh, 1).Error)
+					assert.Equal(t, uint(1), wh.WarehouseStat.RackCount)
+					assert.Equal(t, uint(1), wh.WarehouseStat.ProductCount)
+					assert.Equal(t, uint(8), wh.WarehouseStat.Capacity)
+				})
+
+				t.Run("test compute kpi", func(t *testing.T) {
+					kpi, err := warehouse.ComputeWarehouseKpi(tx, 1, 0, now.AddDate(0, 0, -1), now.Add(time.Hour))
+					assert.NoError(t, err)
+					assert.Equal(t, int64(10), kpi.StartStock.Count)
+					assert.Equal(t, int64(8), kpi.EndStock.Count)
+					assert.Equal(t, int64(2), kpi.Outbound[warehouse_iface.StockChangeType_STOCK_CHANGE_TYPE_ORDER_ACCEPTED].Count)
+					assert.Equal(t, int64(1), kpi.ActiveSkuCount)
+					assert.Equal(t, int64(1), kpi.ActiveRackCount)
+				})
+			})
+		})
+}
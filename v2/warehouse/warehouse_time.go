@@ -1,9 +1,16 @@
 package warehouse
 
-import "time"
+import (
+	"time"
+
+	"github.com/pdcgo/shared/db_models"
+)
 
 // Warehouse open/close/close-order are stored as time-of-day; the proto carries them
 // as "HH:MM" strings (empty = unset), matching db_models.Warehouse's JSON marshaling.
+// The HH:MM is wall clock in the warehouse timezone (warehouses.timezone). It is stored
+// as written by time.Parse, same as legacy db_models.Warehouse, and only resolved to an
+// instant against the warehouse timezone by ClockAt.
 
 func parseHHMM(s string) *time.Time {
 	if s == "" {
@@ -16,11 +23,46 @@ func parseHHMM(s string) *time.Time {
 	return &t
 }
 
+// clockOf returns the stored wall clock. It is written on UTC and the driver localizes
+// TIMESTAMPTZ on read, so the clock is taken back in UTC, never in the warehouse timezone.
+func clockOf(t *time.Time) (hour, minute int) {
+	c := t.UTC()
+	return c.Hour(), c.Minute()
+}
+
 func formatHHMM(t *time.Time) string {
 	if t == nil {
 		return ""
 	}
-	// Times are parsed/stored as UTC; normalize back to UTC before formatting so the
-	// round-trip through a TIMESTAMPTZ column (which the driver localizes on read) is stable.
-	return t.UTC().Format("15:04")
+
+	hour, minute := clockOf(t)
+	return time.Date(0, 1, 1, hour, minute, 0, 0, time.UTC).Format("15:04")
+}
+
+// ClockAt resolves a stored time-of-day to the instant it happens on the warehouse
+// calendar day of at, with loc as the warehouse timezone.
+func ClockAt(clock *time.Time, at time.Time, loc *time.Location) *time.Time {
+	if clock == nil {
+		return nil
+	}
+
+	day := at.In(loc)
+	hour, minute := clockOf(clock)
+	t := time.Date(day.Year(), day.Month(), day.Day(), hour, minute, 0, 0, loc)
+	return &t
+}
+
+// IsOrderClosed reports whether the warehouse no longer takes order at the given instant,
+// either closed entirely or past its close-order time of the local day.
+func IsOrderClosed(wh *db_models.Warehouse, at time.Time, loc *time.Location) bool {
+	if wh.IsClosed {
+		return true
+	}
+
+	closeOrder := ClockAt(wh.CloseOrder, at, loc)
+	if closeOrder == nil {
+		return false
+	}
+
+	return !at.Before(*closeOrder)
 }
@@ -0,0 +1,62 @@
+package warehouse
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pdcgo/shared/db_models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWarehouseTime(t *testing.T) {
+	wita, err := time.LoadLocation("Asia/Makassar")
+	assert.NoError(t, err)
+	wit, err := time.LoadLocation("Asia/Jayapura")
+	assert.NoError(t, err)
+
+	closeOrder := parseHHMM("16:00")
+
+	t.Run("format stable when driver localize", func(t *testing.T) {
+		assert.Equal(t, "16:00", formatHHMM(closeOrder))
+
+		read := closeOrder.In(wit)
+		assert.Equal(t, "16:00", formatHHMM(&read))
+	})
+
+	t.Run("clock at wita", func(t *testing.T) {
+		at := time.Date(2025, 1, 1, 7, 30, 0, 0, time.UTC)
+
+		clock := ClockAt(closeOrder, at, wita)
+		assert.Equal(t, time.Date(2025, 1, 1, 8, 0, 0, 0, time.UTC), clock.UTC())
+	})
+
+	t.Run("clock at wit", func(t *testing.T) {
+		at := time.Date(2025, 1, 1, 7, 30, 0, 0, time.UTC)
+
+		clock := ClockAt(closeOrder, at, wit)
+		assert.Equal(t, time.Date(2025, 1, 1, 7, 0, 0, 0, time.UTC), clock.UTC())
+
+		// 01:30 next day in WIT
+		at = time.Date(2025, 1, 1, 16, 30, 0, 0, time.UTC)
+		clock = ClockAt(closeOrder, at, wit)
+		assert.Equal(t, time.Date(2025, 1, 2, 7, 0, 0, 0, time.UTC), clock.UTC())
+	})
+
+	t.Run("order closed", func(t *testing.T) {
+		wh := db_models.Warehouse{CloseOrder: closeOrder}
+		at := time.Date(2025, 1, 1, 7, 30, 0, 0, time.UTC)
+
+		// 15:30 WITA, 16:30 WIT
+		assert.False(t, IsOrderClosed(&wh, at, wita))
+		assert.True(t, IsOrderClosed(&wh, at, wit))
+
+		// 16:00 WITA
+		assert.True(t, IsOrderClosed(&wh, time.Date(2025, 1, 1, 8, 0, 0, 0, time.UTC), wita))
+
+		// 01:30 next day in WIT
+		assert.False(t, IsOrderClosed(&wh, time.Date(2025, 1, 1, 16, 30, 0, 0, time.UTC), wit))
+
+		assert.False(t, IsOrderClosed(&db_models.Warehouse{}, at, wit))
+		assert.True(t, IsOrderClosed(&db_models.Warehouse{IsClosed: true}, at, wita))
+	})
+}
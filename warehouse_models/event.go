@@ -7,3 +7,53 @@ type StockEventLog struct {
 	Raw       []byte
 	CreatedAt time.Time
 }
+
+type DeadLetterStatus string
+
+const (
+	DeadLetterRetrying  DeadLetterStatus = "retrying"
+	DeadLetterDead      DeadLetterStatus = "dead"
+	DeadLetterReplaying DeadLetterStatus = "replaying" // claimed by replay, back to dead when replay failed
+	DeadLetterReplayed  DeadLetterStatus = "replayed"
+	DeadLetterResolved  DeadLetterStatus = "resolved" // processed on later redelivery before dead
+)
+
+type StockEventDeadLetter struct {
+	ID            uint64           `gorm:"primarykey" json:"id"`
+	MessageID     string           `gorm:"uniqueIndex" json:"message_id"`
+	Raw           []byte           `json:"raw"`
+	Error         string           `json:"error"`
+	AttemptCount  int              `json:"attempt_count"`
+	MaxAttempt    int              `json:"max_attempt"`
+	Status        DeadLetterStatus `gorm:"index" json:"status"`
+	LastAttemptAt time.Time        `json:"last_attempt_at"`
+	ReplayedAt    *time.Time       `json:"replayed_at"`
+	CreatedAt     time.Time        `json:"created_at"`
+	UpdatedAt     time.Time        `json:"updated_at"`
+}
+
+type OutboxStatus string
+
+const (
+	OutboxPending    OutboxStatus = "pending"
+	OutboxPublishing OutboxStatus = "publishing" // claimed by publisher until next_attempt_at, due again after it
+	OutboxSent       OutboxStatus = "sent"
+	OutboxFailed     OutboxStatus = "failed" // attempt reach max attempt, not retried anymore
+)
+
+// StockChangeOutbox is stock change event written in same transaction as stock_change_logs,
+// published later by outbox publisher. MessageID is source stock event.
+type StockChangeOutbox struct {
+	ID            uint64       `gorm:"primarykey" json:"id"`
+	MessageID     string       `gorm:"uniqueIndex" json:"message_id"`
+	Payload       []byte       `json:"payload"`
+	Status        OutboxStatus `gorm:"index" json:"status"`
+	AttemptCount  int          `json:"attempt_count"`
+	Error         string       `json:"error"`
+	PublishedID   string       `json:"published_id"` // message id returned by event sender
+	NextAttemptAt time.Time    `gorm:"index" json:"next_attempt_at"`
+	SentAt        *time.Time   `json:"sent_at"`
+	CreatedAt     time.Time    `json:"created_at"`
+	UpdatedAt     time.Time    `json:"updated_at"`
+	ClaimID       string       `json:"claim_id"` // publisher claim, outcome only recorded by the claim holder
+}
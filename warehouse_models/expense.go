@@ -1,12 +1,13 @@
 package warehouse_models
 
 import (
-	"slices"
 	"time"
 
 	"github.com/pdcgo/shared/db_models"
 )
 
+// ExpenseType is key of ware_expense_types. constants are built-in types seeded by migration,
+// more type can be added from admin without deploy.
 type ExpenseType string
 
 const (
@@ -26,47 +27,12 @@ const (
 	ExpenseTypeTools       ExpenseType = "tools"       // Peralatan
 	ExpenseTypeOther       ExpenseType = "other"       // Lain-Lain
 
-	// additional types
-	// ExpenseTypeBank        ExpenseType = "bank"         // Bank
-	// ExpenseTypePayable     ExpenseType = "payable"      // Hutang
-	// ExpenseTypeBonusSalary ExpenseType = "bonus_salary" // Bonus
+	// additional types, seeded disabled
+	ExpenseTypeBank        ExpenseType = "bank"         // Bank
+	ExpenseTypePayable     ExpenseType = "payable"      // Hutang
+	ExpenseTypeBonusSalary ExpenseType = "bonus_salary" // Bonus
 )
 
-var CanCreateExpense = map[db_models.TeamType]map[ExpenseType]bool{
-	db_models.AdminTeamType: map[ExpenseType]bool{
-		ExpenseTypeEquity:      true,
-		ExpenseTypeBasicSalary: true,
-		ExpenseTypePettyCash:   true,
-		ExpenseTypeServer:      true,
-		ExpenseTypeTransport:   true,
-		ExpenseTypeReceivable:  true,
-		ExpenseTypeInternet:    true,
-		ExpenseTypePacking:     true,
-		ExpenseTypeShipping:    true,
-		ExpenseTypeElectricity: true,
-		ExpenseTypeKitchen:     true,
-		ExpenseTypeEquipment:   true,
-		ExpenseTypeTools:       true,
-		ExpenseTypeOther:       true,
-		// ExpenseTypeBank:        true,
-		// ExpenseTypePayable:     true,
-		// ExpenseTypeBonusSalary: true,
-	},
-	db_models.WarehouseTeamType: map[ExpenseType]bool{
-		ExpenseTypePettyCash:   true,
-		ExpenseTypeTransport:   true,
-		ExpenseTypeReceivable:  true,
-		ExpenseTypeInternet:    true,
-		ExpenseTypePacking:     true,
-		ExpenseTypeShipping:    true,
-		ExpenseTypeElectricity: true,
-		ExpenseTypeKitchen:     true,
-		ExpenseTypeEquipment:   true,
-		ExpenseTypeTools:       true,
-		ExpenseTypeOther:       true,
-	},
-}
-
 func (ExpenseType) EnumList() []string {
 	return []string{
 		"equity",
@@ -85,42 +51,13 @@ func (ExpenseType) EnumList() []string {
 		"tools",
 		"other",
 
-		// // additional types
-		// "bank",
-		// "payable",
-		// "bonus_salary",
+		// additional types
+		"bank",
+		"payable",
+		"bonus_salary",
 	}
 }
 
-func (t ExpenseType) NeedAdminPermission() bool {
-	values := []ExpenseType{
-		ExpenseTypeEquity,
-		ExpenseTypeBasicSalary,
-		ExpenseTypeServer,
-	}
-	return slices.Contains(values, t)
-}
-
-type ListExpenseType []ExpenseType
-
-type GroupExpenseType map[db_models.TeamType]ListExpenseType
-
-var GroupExpense GroupExpenseType = GroupExpenseType{
-	db_models.WarehouseTeamType: ListExpenseType{
-		ExpenseTypePettyCash,
-		ExpenseTypeTransport,
-		ExpenseTypeReceivable,
-		ExpenseTypeInternet,
-		ExpenseTypePacking,
-		ExpenseTypeShipping,
-		ExpenseTypeElectricity,
-		ExpenseTypeKitchen,
-		ExpenseTypeEquipment,
-		ExpenseTypeTools,
-		ExpenseTypeOther,
-	},
-}
-
 type WareExpenseAccount struct {
 	ID            uint      `json:"id" gorm:"primarykey"`
 	AccountTypeID uint      `json:"account_type_id"`
@@ -146,16 +83,46 @@ type WareExpenseAccountWarehouse struct {
 	Account *WareExpenseAccount `json:"account,omitempty" gorm:"foreignKey:AccountID"`
 }
 
+// WareExpenseAccountStatusLog is audit trail of account disabled and re-enabled.
+type WareExpenseAccountStatusLog struct {
+	ID          uint      `json:"id" gorm:"primarykey"`
+	AccountID   uint      `json:"account_id" gorm:"index"`
+	WarehouseID uint      `json:"warehouse_id"`
+	ActorID     uint      `json:"actor_id"`
+	Disabled    bool      `json:"disabled"`
+	Note        string    `json:"note"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+type ExpenseStatus string
+
+const (
+	ExpenseStatusPending  ExpenseStatus = "pending"
+	ExpenseStatusApproved ExpenseStatus = "approved"
+	ExpenseStatusRejected ExpenseStatus = "rejected"
+)
+
+func (ExpenseStatus) EnumList() []string {
+	return []string{
+		"pending",
+		"approved",
+		"rejected",
+	}
+}
+
 type WareExpenseHistory struct {
-	ID          uint        `json:"id" gorm:"primarykey"`
-	WarehouseID uint        `json:"warehouse_id"`
-	AccountID   uint        `json:"account_id"`
-	CreatedByID uint        `json:"created_by_id"`
-	ExpenseType ExpenseType `json:"expense_type"`
-	Amount      float64     `json:"amount"`
-	Note        string      `json:"note"`
-	At          time.Time   `json:"at"`
-	CreatedAt   time.Time   `json:"created_at"`
+	ID          uint          `json:"id" gorm:"primarykey"`
+	WarehouseID uint          `json:"warehouse_id"`
+	AccountID   uint          `json:"account_id"`
+	CreatedByID uint          `json:"created_by_id"`
+	ExpenseType ExpenseType   `json:"expense_type"`
+	Amount      float64       `json:"amount"`
+	Note        string        `json:"note"`
+	Status      ExpenseStatus `json:"status" gorm:"default:approved"`     // only approved counted in report
+	TransferID  uint          `json:"transfer_id,omitempty" gorm:"index"` // 0 is not transfer leg
+	Deleted     bool          `json:"deleted" gorm:"index"`               // soft deleted, hidden from list and report
+	At          time.Time     `json:"at"`
+	CreatedAt   time.Time     `json:"created_at"`
 }
 
 // GetEntityID implements authorization_iface.Entity
@@ -163,6 +130,39 @@ func (w *WareExpenseHistory) GetEntityID() string {
 	return "ware_expense_history"
 }
 
+// WareExpenseStatusLog is every status transition of expense history, FromStatus empty when expense created.
+type WareExpenseStatusLog struct {
+	ID            uint          `json:"id" gorm:"primarykey"`
+	ExpenseHistID uint          `json:"expense_hist_id" gorm:"index"`
+	FromStatus    ExpenseStatus `json:"from_status"`
+	ToStatus      ExpenseStatus `json:"to_status"`
+	ActorID       uint          `json:"actor_id"`
+	Note          string        `json:"note"`
+	CreatedAt     time.Time     `json:"created_at"`
+}
+
+// WareExpenseDeleteLog is audit trail of expense history soft deleted and restored.
+type WareExpenseDeleteLog struct {
+	ID            uint      `json:"id" gorm:"primarykey"`
+	ExpenseHistID uint      `json:"expense_hist_id" gorm:"index"`
+	ActorID       uint      `json:"actor_id"`
+	Deleted       bool      `json:"deleted"`
+	Reason        string    `json:"reason"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// WareExpenseAttachment is receipt of expense history, content is in blob storage under StorageKey.
+type WareExpenseAttachment struct {
+	ID            uint      `json:"id" gorm:"primarykey"`
+	ExpenseHistID uint      `json:"expense_hist_id" gorm:"index"`
+	FileName      string    `json:"file_name"`
+	ContentType   string    `json:"content_type"`
+	Size          int64     `json:"size"`
+	StorageKey    string    `json:"-"`
+	UploadedByID  uint      `json:"uploaded_by_id"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
 type WareBalanceAccountHistory struct {
 	ID          uint `json:"id" gorm:"primarykey"`
 	WarehouseID uint `json:"warehouse_id"`
@@ -0,0 +1,37 @@
+package warehouse_models
+
+import "time"
+
+// BudgetEnforce is what happen to new expense making spending over budget.
+type BudgetEnforce string
+
+const (
+	BudgetEnforceWarn   BudgetEnforce = "warn"
+	BudgetEnforceReject BudgetEnforce = "reject"
+)
+
+func (BudgetEnforce) EnumList() []string {
+	return []string{
+		"warn",
+		"reject",
+	}
+}
+
+// WareExpenseBudget is monthly outcome budget of expense type in warehouse. Month is "2006-01" in warehouse timezone.
+type WareExpenseBudget struct {
+	ID           uint          `json:"id" gorm:"primarykey"`
+	WarehouseID  uint          `json:"warehouse_id" gorm:"uniqueIndex:idx_ware_expense_budget"`
+	ExpenseType  ExpenseType   `json:"expense_type" gorm:"uniqueIndex:idx_ware_expense_budget"`
+	Month        string        `json:"month" gorm:"uniqueIndex:idx_ware_expense_budget"`
+	Amount       float64       `json:"amount"`
+	AlertPercent float64       `json:"alert_percent"` // 0 is only alerting when budget exceeded
+	Enforce      BudgetEnforce `json:"enforce"`
+	UpdatedByID  uint          `json:"updated_by_id"`
+	CreatedAt    time.Time     `json:"created_at"`
+	UpdatedAt    time.Time     `json:"updated_at"`
+}
+
+// GetEntityID implements authorization_iface.Entity
+func (w *WareExpenseBudget) GetEntityID() string {
+	return "ware_expense_budget"
+}
@@ -0,0 +1,73 @@
+package warehouse_models
+
+import (
+	"time"
+
+	"github.com/pdcgo/shared/db_models"
+	"gorm.io/datatypes"
+)
+
+// ExpenseRevisionValue is editable value of expense history at one point of time.
+type ExpenseRevisionValue struct {
+	WarehouseID uint          `json:"warehouse_id"`
+	AccountID   uint          `json:"account_id"`
+	ExpenseType ExpenseType   `json:"expense_type"`
+	Amount      float64       `json:"amount"`
+	Note        string        `json:"note"`
+	Status      ExpenseStatus `json:"status"`
+	At          time.Time     `json:"at"`
+}
+
+func NewExpenseRevisionValue(data *WareExpenseHistory) ExpenseRevisionValue {
+	return ExpenseRevisionValue{
+		WarehouseID: data.WarehouseID,
+		AccountID:   data.AccountID,
+		ExpenseType: data.ExpenseType,
+		Amount:      data.Amount,
+		Note:        data.Note,
+		Status:      data.Status,
+		At:          data.At,
+	}
+}
+
+// WareExpenseRevision is append only audit of expense history edit, row is never updated or deleted.
+type WareExpenseRevision struct {
+	ID            uint                                     `json:"id" gorm:"primarykey"`
+	ExpenseHistID uint                                     `json:"expense_hist_id" gorm:"index"`
+	EditorID      uint                                     `json:"editor_id"`
+	EditorFrom    db_models.TeamType                       `json:"editor_from"`
+	Before        datatypes.JSONType[ExpenseRevisionValue] `json:"before"`
+	After         datatypes.JSONType[ExpenseRevisionValue] `json:"after"`
+	CreatedAt     time.Time                                `json:"created_at"`
+}
+
+// Changes is json name of field changed by edit.
+func (w *WareExpenseRevision) Changes() []string {
+	before := w.Before.Data()
+	after := w.After.Data()
+
+	changes := []string{}
+	if before.WarehouseID != after.WarehouseID {
+		changes = append(changes, "warehouse_id")
+	}
+	if before.AccountID != after.AccountID {
+		changes = append(changes, "account_id")
+	}
+	if before.ExpenseType != after.ExpenseType {
+		changes = append(changes, "expense_type")
+	}
+	if before.Amount != after.Amount {
+		changes = append(changes, "amount")
+	}
+	if before.Note != after.Note {
+		changes = append(changes, "note")
+	}
+	if before.Status != after.Status {
+		changes = append(changes, "status")
+	}
+	if !before.At.Equal(after.At) {
+		changes = append(changes, "at")
+	}
+
+	return changes
+}
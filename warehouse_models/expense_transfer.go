@@ -0,0 +1,27 @@
+package warehouse_models
+
+import "time"
+
+// ExpenseTypeTransfer is reserved type of transfer leg, it is not row of ware_expense_types so it can't be
+// used for manual expense.
+const ExpenseTypeTransfer ExpenseType = "transfer" // Transfer
+
+// WareExpenseTransfer is moving cash between account, written as outcome history on from account and
+// income history on to account, both with TransferID.
+type WareExpenseTransfer struct {
+	ID              uint      `json:"id" gorm:"primarykey"`
+	FromWarehouseID uint      `json:"from_warehouse_id"`
+	FromAccountID   uint      `json:"from_account_id"`
+	ToWarehouseID   uint      `json:"to_warehouse_id"`
+	ToAccountID     uint      `json:"to_account_id"`
+	Amount          float64   `json:"amount"`
+	Note            string    `json:"note"`
+	At              time.Time `json:"at"`
+	CreatedByID     uint      `json:"created_by_id"`
+	CreatedAt       time.Time `json:"created_at"`
+}
+
+// GetEntityID implements authorization_iface.Entity
+func (w *WareExpenseTransfer) GetEntityID() string {
+	return "ware_expense_transfer"
+}
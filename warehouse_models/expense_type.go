@@ -0,0 +1,114 @@
+package warehouse_models
+
+import (
+	"slices"
+	"time"
+
+	"github.com/pdcgo/shared/db_models"
+)
+
+// ExpenseFlow is direction of expense type, same value as warehouse_query.FlowType.
+type ExpenseFlow string
+
+const (
+	ExpenseFlowIncome  ExpenseFlow = "income"
+	ExpenseFlowOutcome ExpenseFlow = "outcome"
+)
+
+func (ExpenseFlow) EnumList() []string {
+	return []string{
+		"income",
+		"outcome",
+	}
+}
+
+// WareExpenseType is expense category. AdminOnly type submitted by non admin team wait for admin approval.
+type WareExpenseType struct {
+	Key         ExpenseType `json:"key" gorm:"primarykey"`
+	Name        string      `json:"name"`
+	Flow        ExpenseFlow `json:"flow"`
+	AdminOnly   bool        `json:"admin_only"`
+	WarehouseID uint        `json:"warehouse_id"` // 0 is usable in every warehouse
+	Disabled    bool        `json:"disabled"`
+	CreatedAt   time.Time   `json:"created_at"`
+	UpdatedAt   time.Time   `json:"updated_at"`
+
+	Teams []*WareExpenseTypeTeam `json:"teams,omitempty" gorm:"foreignKey:ExpenseType;references:Key"`
+}
+
+// GetEntityID implements authorization_iface.Entity
+func (w *WareExpenseType) GetEntityID() string {
+	return "ware_expense_type"
+}
+
+// AllowTeam is team type can use this expense type, admin team can use every type.
+func (w *WareExpenseType) AllowTeam(teamType db_models.TeamType) bool {
+	if teamType == db_models.AdminTeamType {
+		return true
+	}
+	return slices.ContainsFunc(w.Teams, func(team *WareExpenseTypeTeam) bool {
+		return team.TeamType == teamType
+	})
+}
+
+func (w *WareExpenseType) AvailableAt(warehouseID uint) bool {
+	return w.WarehouseID == 0 || w.WarehouseID == warehouseID
+}
+
+// NeedApproval is expense of this type submitted from team type wait for admin approval.
+func (w *WareExpenseType) NeedApproval(teamType db_models.TeamType) bool {
+	return w.AdminOnly && teamType != db_models.AdminTeamType
+}
+
+type WareExpenseTypeTeam struct {
+	ID          uint               `json:"id" gorm:"primarykey"`
+	ExpenseType ExpenseType        `json:"expense_type" gorm:"uniqueIndex:idx_ware_expense_type_team"`
+	TeamType    db_models.TeamType `json:"team_type" gorm:"uniqueIndex:idx_ware_expense_type_team"`
+}
+
+// DefaultExpenseTypes is built-in expense type, same as seeded by migration 00017.
+func DefaultExpenseTypes() []*WareExpenseType {
+	type seed struct {
+		key       ExpenseType
+		name      string
+		flow      ExpenseFlow
+		adminOnly bool
+		disabled  bool
+	}
+
+	seeds := []seed{
+		{ExpenseTypeEquity, "Modal", ExpenseFlowIncome, true, false},
+		{ExpenseTypeBasicSalary, "Gaji Pokok", ExpenseFlowOutcome, true, false},
+		{ExpenseTypeServer, "Server", ExpenseFlowOutcome, true, false},
+		{ExpenseTypePettyCash, "Kas Kecil", ExpenseFlowOutcome, false, false},
+		{ExpenseTypeTransport, "Transport", ExpenseFlowOutcome, false, false},
+		{ExpenseTypeReceivable, "Piutang", ExpenseFlowOutcome, false, false},
+		{ExpenseTypeInternet, "Internet", ExpenseFlowOutcome, false, false},
+		{ExpenseTypePacking, "Packing", ExpenseFlowOutcome, false, false},
+		{ExpenseTypeShipping, "Ongkir", ExpenseFlowOutcome, false, false},
+		{ExpenseTypeElectricity, "Listrik", ExpenseFlowOutcome, false, false},
+		{ExpenseTypeKitchen, "Dapur", ExpenseFlowOutcome, false, false},
+		{ExpenseTypeEquipment, "Perlengkapan", ExpenseFlowOutcome, false, false},
+		{ExpenseTypeTools, "Peralatan", ExpenseFlowOutcome, false, false},
+		{ExpenseTypeOther, "Lain-Lain", ExpenseFlowOutcome, false, false},
+		{ExpenseTypeBank, "Bank", ExpenseFlowOutcome, true, true},
+		{ExpenseTypePayable, "Hutang", ExpenseFlowIncome, true, true},
+		{ExpenseTypeBonusSalary, "Bonus", ExpenseFlowOutcome, true, true},
+	}
+
+	types := make([]*WareExpenseType, len(seeds))
+	for i, item := range seeds {
+		types[i] = &WareExpenseType{
+			Key:       item.key,
+			Name:      item.name,
+			Flow:      item.flow,
+			AdminOnly: item.adminOnly,
+			Disabled:  item.disabled,
+			Teams: []*WareExpenseTypeTeam{
+				{ExpenseType: item.key, TeamType: db_models.WarehouseTeamType},
+			},
+		}
+	}
+
+	return types
+}
@@ -0,0 +1,40 @@
+package warehouse_models
+
+import "time"
+
+type PrepareStatStatus string
+
+const (
+	PrepareStatRunning PrepareStatStatus = "running"
+	PrepareStatDone    PrepareStatStatus = "done"
+	PrepareStatFailed  PrepareStatStatus = "failed"
+)
+
+// PrepareStatRun is one prepare-stat run, kept so interrupted or failed run can be resumed with same filter.
+type PrepareStatRun struct {
+	ID           uint64            `gorm:"primarykey" json:"id"`
+	WarehouseID  uint64            `json:"warehouse_id"`
+	TeamID       uint64            `json:"team_id"`
+	BatchSize    int               `json:"batch_size"`
+	Status       PrepareStatStatus `json:"status"`
+	SuccessCount int64             `json:"success_count"`
+	FailedCount  int64             `json:"failed_count"`
+	SkippedCount int64             `json:"skipped_count"`
+	CreatedAt    time.Time         `json:"created_at"`
+	UpdatedAt    time.Time         `json:"updated_at"`
+	FinishedAt   *time.Time        `json:"finished_at"`
+}
+
+// PrepareStatCheckpoint is result of one sku batch in run. batch identified by first and last sku id,
+// because sku streamed in order.
+type PrepareStatCheckpoint struct {
+	ID           uint64            `gorm:"primarykey" json:"id"`
+	RunID        uint64            `gorm:"uniqueIndex:idx_prepare_stat_checkpoint_batch" json:"run_id"`
+	FirstSkuID   string            `gorm:"uniqueIndex:idx_prepare_stat_checkpoint_batch" json:"first_sku_id"`
+	LastSkuID    string            `gorm:"uniqueIndex:idx_prepare_stat_checkpoint_batch" json:"last_sku_id"`
+	SkuCount     int               `json:"sku_count"`
+	Status       PrepareStatStatus `json:"status"`
+	AttemptCount int               `json:"attempt_count"`
+	Error        string            `json:"error"`
+	UpdatedAt    time.Time         `json:"updated_at"`
+}
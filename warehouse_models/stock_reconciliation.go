@@ -0,0 +1,40 @@
+package warehouse_models
+
+import (
+	"time"
+
+	"github.com/pdcgo/shared/db_models"
+)
+
+type ReconciliationSeverity string
+
+const (
+	ReconciliationOk       ReconciliationSeverity = "ok"
+	ReconciliationWarning  ReconciliationSeverity = "warning"
+	ReconciliationCritical ReconciliationSeverity = "critical"
+)
+
+type StockReconciliationRun struct {
+	ID            uint64    `gorm:"primarykey" json:"id"`
+	WarehouseID   uint64    `gorm:"index" json:"warehouse_id"`
+	SkuCount      int64     `json:"sku_count"`
+	WarningCount  int64     `json:"warning_count"`
+	CriticalCount int64     `json:"critical_count"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// StockReconciliationItem is sku that sources disagree in one reconciliation run.
+type StockReconciliationItem struct {
+	ID            uint64                 `gorm:"primarykey" json:"id"`
+	RunID         uint64                 `gorm:"index" json:"run_id"`
+	SkuID         db_models.SkuID        `gorm:"index" json:"sku_id"`
+	WarehouseID   uint64                 `json:"warehouse_id"`
+	StockTotal    int64                  `json:"stock_total"`
+	StockReady    int64                  `json:"stock_ready"`
+	HistoryCount  int64                  `json:"history_count"`
+	DailyEndCount *int64                 `json:"daily_end_count"`
+	DailyT        *time.Time             `json:"daily_t"`
+	Severity      ReconciliationSeverity `json:"severity"`
+	Note          string                 `json:"note"`
+	CreatedAt     time.Time              `json:"created_at"`
+}
@@ -0,0 +1,13 @@
+package warehouse_models
+
+const DefaultWarehouseTimezone = "Asia/Jakarta"
+
+// WarehouseTimezone is timezone column of warehouses, which db_models.Warehouse not having.
+type WarehouseTimezone struct {
+	ID       uint   `gorm:"primarykey;autoIncrement:false" json:"id"`
+	Timezone string `gorm:"not null;default:Asia/Jakarta" json:"timezone"`
+}
+
+func (WarehouseTimezone) TableName() string {
+	return "warehouses"
+}
@@ -1,12 +1,14 @@
 package warehouse_mutations
 
 import (
+	"errors"
 	"time"
 
 	"github.com/pdcgo/shared/interfaces/identity_iface"
 	"github.com/pdcgo/warehouse_service/warehouse_models"
 	"github.com/pdcgo/warehouse_service/warehouse_query"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 func NewWareBalanceHistMutation(tx *gorm.DB, agent identity_iface.Agent) WareBalanceAccountHistService {
@@ -16,8 +18,16 @@ func NewWareBalanceHistMutation(tx *gorm.DB, agent identity_iface.Agent) WareBal
 	}
 }
 
+var ErrBalanceHistNotFound = errors.New("balance history not found")
+var ErrBalanceHistExist = errors.New("balance history already exist at that day")
+
+// WareBalanceAccountHistService is closing balance of account, one balance per account per warehouse day.
+// at is stored as start of warehouse day so ware_account_at unique index holds it.
 type WareBalanceAccountHistService interface {
-	Create(accountID uint, amount float64, at time.Time) error
+	Create(accountID uint, amount float64, at time.Time) (*warehouse_models.WareBalanceAccountHistory, error)
+	Get(histID uint) (*warehouse_models.WareBalanceAccountHistory, error)
+	Update(amount float64, at time.Time) error
+	Delete() error
 }
 
 type wareBalanceAccountHistImpl struct {
@@ -28,31 +38,59 @@ type wareBalanceAccountHistImpl struct {
 	data    *warehouse_models.WareBalanceAccountHistory
 }
 
-func (w *wareBalanceAccountHistImpl) Create(accountID uint, amount float64, at time.Time) error {
-	var err error
+func (w *wareBalanceAccountHistImpl) getAccount(accountID, warehouseID uint) error {
 	w.account = &warehouse_models.WareExpenseAccountWarehouse{}
-	w.data = &warehouse_models.WareBalanceAccountHistory{}
 
 	accountQuery := warehouse_query.NewWarehouseExpenseAccountQuery(w.tx, false)
-	err = accountQuery.
+	err := accountQuery.
 		FromAccount(accountID).
+		FromWarehouse(warehouseID).
 		GetQuery().
 		Find(w.account).Error
 	if err != nil {
 		return err
 	}
+	if w.account.ID == 0 {
+		return ErrExpenseAccountNotFound
+	}
+
+	return checkAccountActive(w.tx, accountID)
+}
+
+func startOfDay(at time.Time, loc *time.Location) time.Time {
+	day := at.In(loc)
+	return time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, loc)
+}
+
+// Create is creating balance, or updating amount when account already has balance at that day.
+func (w *wareBalanceAccountHistImpl) Create(accountID uint, amount float64, at time.Time) (*warehouse_models.WareBalanceAccountHistory, error) {
+	var err error
+	w.data = &warehouse_models.WareBalanceAccountHistory{}
+
+	err = w.getAccount(accountID, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	loc, err := warehouse_query.GetWarehouseLocation(w.tx, w.account.WarehouseID)
+	if err != nil {
+		return nil, err
+	}
 
 	balanceQuery := warehouse_query.NewWarehouseBalanceHistQuery(w.tx, false)
 	sqlQuery := balanceQuery.
 		FromWarehouse(w.account.WarehouseID).
 		FromAccount(w.account.AccountID).
-		BalanceAt(at).
+		BalanceAt(at, loc).
 		GetQuery()
 
 	err = sqlQuery.Find(w.data).Error
 	if err != nil {
-		return err
+		return nil, err
 	}
+
+	at = startOfDay(at, loc)
+
 	if w.data.ID == 0 { // create if doesn't exist
 		w.data = &warehouse_models.WareBalanceAccountHistory{
 			WarehouseID: w.account.WarehouseID,
@@ -63,12 +101,17 @@ func (w *wareBalanceAccountHistImpl) Create(accountID uint, amount float64, at t
 			CreatedAt:   time.Now(),
 		}
 
-		err := w.tx.Create(w.data).Error
+		err := w.tx.
+			Clauses(clause.OnConflict{
+				Columns:   []clause.Column{{Name: "account_id"}, {Name: "at"}},
+				DoUpdates: clause.AssignmentColumns([]string{"amount"}),
+			}).
+			Create(w.data).Error
 		if err != nil {
-			return err
+			return nil, err
 		}
 
-		return nil
+		return w.data, nil
 	}
 
 	err = w.tx.Model(&warehouse_models.WareBalanceAccountHistory{}).
@@ -79,9 +122,95 @@ func (w *wareBalanceAccountHistImpl) Create(accountID uint, amount float64, at t
 			"at":     at,
 		}).
 		Error
+	if err != nil {
+		return nil, err
+	}
+
+	w.data.Amount = amount
+	w.data.At = at
+
+	return w.data, nil
+}
+
+func (w *wareBalanceAccountHistImpl) Get(histID uint) (*warehouse_models.WareBalanceAccountHistory, error) {
+	w.data = &warehouse_models.WareBalanceAccountHistory{}
+
+	err := warehouse_query.
+		NewWarehouseBalanceHistQuery(w.tx, true).
+		WithHistID(histID).
+		GetQuery().
+		Find(w.data).Error
+	if err != nil {
+		return nil, err
+	}
+	if histID == 0 || w.data.ID == 0 {
+		return nil, ErrBalanceHistNotFound
+	}
+
+	return w.data, nil
+}
+
+func (w *wareBalanceAccountHistImpl) Update(amount float64, at time.Time) error {
+	if w.data == nil || w.data.ID == 0 {
+		return errors.New("balance history not initialized")
+	}
+
+	err := w.getAccount(w.data.AccountID, w.data.WarehouseID)
+	if err != nil {
+		return err
+	}
+
+	loc, err := warehouse_query.GetWarehouseLocation(w.tx, w.account.WarehouseID)
+	if err != nil {
+		return err
+	}
+
+	var count int64
+	err = warehouse_query.
+		NewWarehouseBalanceHistQuery(w.tx, false).
+		FromAccount(w.data.AccountID).
+		BalanceAt(at, loc).
+		GetQuery().
+		Where("ware_balance_account_histories.id != ?", w.data.ID).
+		Count(&count).Error
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return ErrBalanceHistExist
+	}
+
+	at = startOfDay(at, loc)
+
+	err = w.tx.Model(&warehouse_models.WareBalanceAccountHistory{}).
+		Where("id = ?", w.data.ID).
+		Updates(map[string]interface{}{
+			"amount": amount,
+			"at":     at,
+		}).
+		Error
 	if err != nil {
 		return err
 	}
 
+	w.data.Amount = amount
+	w.data.At = at
+
 	return nil
 }
+
+func (w *wareBalanceAccountHistImpl) Delete() error {
+	if w.data == nil || w.data.ID == 0 {
+		return errors.New("balance history not initialized")
+	}
+
+	err := checkAccountActive(w.tx, w.data.AccountID)
+	if err != nil {
+		return err
+	}
+
+	return w.tx.
+		Where("id = ?", w.data.ID).
+		Delete(&warehouse_models.WareBalanceAccountHistory{}).
+		Error
+}
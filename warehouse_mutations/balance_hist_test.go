@@ -95,6 +95,7 @@ func TestWarehouseBalanceMutation(t *testing.T) {
 					&warehouse_models.WareExpenseAccountWarehouse{},
 					&warehouse_models.WareExpenseHistory{},
 					&warehouse_models.WareBalanceAccountHistory{},
+					&warehouse_models.WarehouseTimezone{},
 					&db_models.Team{},
 					&db_models.User{},
 					&db_models.UserTeam{},
@@ -117,7 +118,7 @@ func TestWarehouseBalanceMutation(t *testing.T) {
 			balanceService := warehouse_mutations.NewWareBalanceHistMutation(&db, adminUser)
 
 			t.Run("test create balance history", func(t *testing.T) {
-				err := balanceService.Create(account.ID, 150_000_000, time.Now())
+				_, err := balanceService.Create(account.ID, 150_000_000, time.Now())
 				assert.Nil(t, err)
 
 				t.Run("test check data", func(t *testing.T) {
@@ -128,7 +129,7 @@ func TestWarehouseBalanceMutation(t *testing.T) {
 				})
 
 				t.Run("test update balance history", func(t *testing.T) {
-					err := balanceService.Create(account.ID, 175_000_000, time.Now())
+					_, err := balanceService.Create(account.ID, 175_000_000, time.Now())
 					assert.Nil(t, err)
 
 					t.Run("test check updated data", func(t *testing.T) {
@@ -2,6 +2,7 @@ package warehouse_mutations
 
 import (
 	"errors"
+	"time"
 
 	"github.com/pdcgo/warehouse_service/warehouse_models"
 	"github.com/pdcgo/warehouse_service/warehouse_query"
@@ -17,11 +18,32 @@ func NewExpenseAccountService(tx *gorm.DB, warehouseID uint) ExpenseAccount {
 }
 
 var ErrExpenseAccountNotFound = errors.New("expense account not found")
+var ErrExpenseAccountDisabled = errors.New("expense account disabled")
+
+// checkAccountActive rejects when any of the account is disabled.
+func checkAccountActive(tx *gorm.DB, accountIDs ...uint) error {
+	var count int64
+	err := warehouse_query.
+		NewWareExpenseAccountQuery(tx, false).
+		Disabled(warehouse_query.AccountStatusDisabled).
+		GetQuery().
+		Where("ware_expense_accounts.id IN ?", accountIDs).
+		Count(&count).
+		Error
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return ErrExpenseAccountDisabled
+	}
+
+	return nil
+}
 
 type ExpenseAccount interface {
 	GetByQuery(lock bool, query func(tx *gorm.DB) *gorm.DB) (*warehouse_models.WareExpenseAccountWarehouse, error)
 	Update(accountTypeID uint, isOpsAccount bool, name, numberId string) error
-	Disabled(isDisabled bool) error
+	Disabled(actorID uint, isDisabled bool, note string) error
 }
 
 type expenseAccountImpl struct {
@@ -113,14 +135,14 @@ func (e *expenseAccountImpl) Update(accountTypeID uint, isOpsAccount bool, name,
 	return nil
 }
 
-// Disabled implements ExpenseAccount.
-func (e *expenseAccountImpl) Disabled(isDisabled bool) error {
+// Disabled implements ExpenseAccount. every status change is written to account status log.
+func (e *expenseAccountImpl) Disabled(actorID uint, isDisabled bool, note string) error {
 	if e.data == nil {
 		return errors.New("expense account not initialized")
 	}
 
 	err := e.tx.Model(&warehouse_models.WareExpenseAccount{}).
-		Where("ware_expense_accounts.id = ?", e.data.ID).
+		Where("ware_expense_accounts.id = ?", e.data.AccountID).
 		Updates(map[string]interface{}{
 			"disabled": isDisabled,
 		}).Error
@@ -128,6 +150,18 @@ func (e *expenseAccountImpl) Disabled(isDisabled bool) error {
 		return err
 	}
 
+	err = e.tx.Create(&warehouse_models.WareExpenseAccountStatusLog{
+		AccountID:   e.data.AccountID,
+		WarehouseID: e.data.WarehouseID,
+		ActorID:     actorID,
+		Disabled:    isDisabled,
+		Note:        note,
+		CreatedAt:   time.Now(),
+	}).Error
+	if err != nil {
+		return err
+	}
+
 	e.data.Account.Disabled = isDisabled
 
 	return nil
@@ -0,0 +1,227 @@
+package warehouse_mutations
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/pdcgo/warehouse_service/warehouse_models"
+	"github.com/pdcgo/warehouse_service/warehouse_query"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var ErrExpenseBudgetExceeded = errors.New("expense budget exceeded")
+
+type BudgetAlertLevel string
+
+const (
+	BudgetAlertThreshold BudgetAlertLevel = "threshold"
+	BudgetAlertExceeded  BudgetAlertLevel = "exceeded"
+)
+
+// BudgetAlert is budget crossed by new expense, Spent is including the new expense.
+type BudgetAlert struct {
+	Budget        *warehouse_models.WareExpenseBudget `json:"budget"`
+	ExpenseHistID uint                                `json:"expense_hist_id"`
+	ActorID       uint                                `json:"actor_id"`
+	Level         BudgetAlertLevel                    `json:"level"`
+	Spent         float64                             `json:"spent"`
+}
+
+func (alert *BudgetAlert) Percent() float64 {
+	if alert.Budget.Amount == 0 {
+		return 0
+	}
+	return alert.Spent / alert.Budget.Amount * 100
+}
+
+func (alert *BudgetAlert) Message() string {
+	return fmt.Sprintf("budget %s %s %s: spent %.2f of %.2f (%.1f%%)",
+		alert.Budget.ExpenseType,
+		alert.Budget.Month,
+		alert.Level,
+		alert.Spent,
+		alert.Budget.Amount,
+		alert.Percent(),
+	)
+}
+
+// checkBudget is checking outcome against monthly budget of expense type. it return alert when new expense
+// crossing alert percent or budget, and error when budget is enforced by reject. outcome is spent by negative
+// amount, positive amount is correction reducing spent and never cross budget.
+func checkBudget(tx *gorm.DB, warehouseID uint, expenseType *warehouse_models.WareExpenseType, at time.Time, amount float64) (*BudgetAlert, error) {
+	if expenseType.Flow != warehouse_models.ExpenseFlowOutcome {
+		return nil, nil
+	}
+	spend := -amount
+	if spend <= 0 {
+		return nil, nil
+	}
+
+	loc, err := warehouse_query.GetWarehouseLocation(tx, warehouseID)
+	if err != nil {
+		return nil, err
+	}
+
+	budget := warehouse_models.WareExpenseBudget{}
+	err = tx.
+		Model(&warehouse_models.WareExpenseBudget{}).
+		Where("warehouse_id = ?", warehouseID).
+		Where("expense_type = ?", expenseType.Key).
+		Where("month = ?", warehouse_query.BudgetMonth(at, loc)).
+		Find(&budget).
+		Error
+	if err != nil {
+		return nil, err
+	}
+	if budget.ID == 0 {
+		return nil, nil
+	}
+
+	start, end, err := warehouse_query.BudgetMonthRange(budget.Month, loc)
+	if err != nil {
+		return nil, err
+	}
+	spent, err := warehouse_query.GetExpenseBudgetSpent(tx, warehouseID, start, end, expenseType.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	before := spent[expenseType.Key]
+	after := before + spend
+
+	alert := BudgetAlert{
+		Budget: &budget,
+		Spent:  after,
+	}
+
+	threshold := budget.Amount * budget.AlertPercent / 100
+	switch {
+	case after > budget.Amount:
+		if budget.Enforce == warehouse_models.BudgetEnforceReject {
+			return nil, fmt.Errorf("%w: %s", ErrExpenseBudgetExceeded, alert.Message())
+		}
+		if before > budget.Amount {
+			return nil, nil
+		}
+		alert.Level = BudgetAlertExceeded
+	case budget.AlertPercent > 0 && before < threshold && after >= threshold:
+		alert.Level = BudgetAlertThreshold
+	default:
+		return nil, nil
+	}
+
+	return &alert, nil
+}
+
+// countedInBudget is whether saved expense is already counted in spent of budget it has after edit, same
+// warehouse, expense type and budget month, and not rejected.
+func countedInBudget(tx *gorm.DB, expense *warehouse_models.WareExpenseHistory, warehouseID uint, expenseType warehouse_models.ExpenseType, at time.Time) (bool, error) {
+	if expense.Status == warehouse_models.ExpenseStatusRejected || expense.WarehouseID != warehouseID || expense.ExpenseType != expenseType {
+		return false, nil
+	}
+
+	loc, err := warehouse_query.GetWarehouseLocation(tx, expense.WarehouseID)
+	if err != nil {
+		return false, err
+	}
+
+	return warehouse_query.BudgetMonth(expense.At, loc) == warehouse_query.BudgetMonth(at, loc), nil
+}
+
+type ExpenseBudgetPayload struct {
+	WarehouseID  uint                           `json:"warehouse_id"`
+	ExpenseType  warehouse_models.ExpenseType   `json:"expense_type"`
+	Month        string                         `json:"month"`
+	Amount       float64                        `json:"amount"`
+	AlertPercent float64                        `json:"alert_percent"`
+	Enforce      warehouse_models.BudgetEnforce `json:"enforce"`
+}
+
+// SetExpenseBudget is creating or replacing monthly budget, zero amount is removing budget.
+func SetExpenseBudget(tx *gorm.DB, actorID uint, payload *ExpenseBudgetPayload) (*warehouse_models.WareExpenseBudget, error) {
+	if payload.WarehouseID == 0 {
+		return nil, errors.New("budget warehouse empty")
+	}
+	_, err := time.Parse(warehouse_query.BudgetMonthLayout, payload.Month)
+	if err != nil {
+		return nil, errors.New("budget month must be formatted as 2006-01")
+	}
+	if payload.Amount < 0 {
+		return nil, errors.New("budget amount can't be negative")
+	}
+	if payload.AlertPercent < 0 || payload.AlertPercent > 100 {
+		return nil, errors.New("budget alert percent must be between 0 and 100")
+	}
+	if payload.Enforce == "" {
+		payload.Enforce = warehouse_models.BudgetEnforceWarn
+	}
+	if !slices.Contains(payload.Enforce.EnumList(), string(payload.Enforce)) {
+		return nil, errors.New("budget enforce must be warn or reject")
+	}
+
+	var count int64
+	err = warehouse_query.
+		NewExpenseTypeQuery(tx).
+		WithKey(payload.ExpenseType).
+		GetQuery().
+		Count(&count).
+		Error
+	if err != nil {
+		return nil, err
+	}
+	if payload.ExpenseType == "" || count == 0 {
+		return nil, warehouse_query.ErrExpenseTypeNotFound
+	}
+
+	budget := warehouse_models.WareExpenseBudget{
+		WarehouseID:  payload.WarehouseID,
+		ExpenseType:  payload.ExpenseType,
+		Month:        payload.Month,
+		Amount:       payload.Amount,
+		AlertPercent: payload.AlertPercent,
+		Enforce:      payload.Enforce,
+		UpdatedByID:  actorID,
+		CreatedAt:    time.Now(),
+		UpdatedAt:    time.Now(),
+	}
+
+	if payload.Amount == 0 {
+		err = tx.
+			Where("warehouse_id = ?", budget.WarehouseID).
+			Where("expense_type = ?", budget.ExpenseType).
+			Where("month = ?", budget.Month).
+			Delete(&warehouse_models.WareExpenseBudget{}).
+			Error
+		if err != nil {
+			return nil, err
+		}
+		return &budget, nil
+	}
+
+	err = tx.
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "warehouse_id"}, {Name: "expense_type"}, {Name: "month"}},
+			DoUpdates: clause.AssignmentColumns([]string{"amount", "alert_percent", "enforce", "updated_by_id", "updated_at"}),
+		}).
+		Create(&budget).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.
+		Model(&warehouse_models.WareExpenseBudget{}).
+		Where("warehouse_id = ?", budget.WarehouseID).
+		Where("expense_type = ?", budget.ExpenseType).
+		Where("month = ?", budget.Month).
+		First(&budget).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &budget, nil
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/pdcgo/shared/interfaces/identity_iface"
 	"github.com/pdcgo/warehouse_service/warehouse_models"
 	"github.com/pdcgo/warehouse_service/warehouse_query"
+	"gorm.io/datatypes"
 	"gorm.io/gorm"
 )
 
@@ -23,14 +24,23 @@ type ExpenseHist interface {
 	Create(from db_models.TeamType, payload *CreateExpensePayload) error
 	GetExpense(expenseHistID uint) (*warehouse_models.WareExpenseHistory, error)
 	Update(from db_models.TeamType, payload *UpdateWareExpenseHistPayload) error
+	Review(from db_models.TeamType, approve bool, note string) error
+	SetDeleted(deleted bool, reason string) error
+	BudgetAlert() *BudgetAlert
 }
 
+var ErrExpenseNotPending = errors.New("expense is not pending approval")
+var ErrExpenseDeleted = errors.New("expense is deleted")
+var ErrExpenseNotDeleted = errors.New("expense is not deleted")
+var ErrExpenseDeleteReasonEmpty = errors.New("delete or restore reason is empty")
+
 type expenseHistImpl struct {
 	tx    *gorm.DB
 	agent identity_iface.Agent
 
 	account *warehouse_models.WareExpenseAccountWarehouse
 	data    *warehouse_models.WareExpenseHistory
+	alert   *BudgetAlert
 }
 
 func (e *expenseHistImpl) GetAccount(accountID, warehouseID uint) (*warehouse_models.WareExpenseAccountWarehouse, error) {
@@ -67,10 +77,23 @@ func (e *expenseHistImpl) Create(from db_models.TeamType, payload *CreateExpense
 	if e.account == nil {
 		return errors.New("account not initialized")
 	}
-	if from != db_models.AdminTeamType {
-		if !warehouse_models.CanCreateExpense[from][payload.ExpenseType] {
-			return errors.New("not allowed create expense")
-		}
+	if e.account.Account != nil && e.account.Account.Disabled {
+		return ErrExpenseAccountDisabled
+	}
+	expenseType, err := e.getExpenseType(from, payload.ExpenseType, e.account.WarehouseID)
+	if err != nil {
+		return err
+	}
+
+	alert, err := checkBudget(e.tx, e.account.WarehouseID, expenseType, payload.At, payload.Amount)
+	if err != nil {
+		return err
+	}
+
+	// admin only expense type submitted by non admin wait for admin approval
+	status := warehouse_models.ExpenseStatusApproved
+	if expenseType.NeedApproval(from) {
+		status = warehouse_models.ExpenseStatusPending
 	}
 
 	expense := warehouse_models.WareExpenseHistory{
@@ -80,17 +103,53 @@ func (e *expenseHistImpl) Create(from db_models.TeamType, payload *CreateExpense
 		ExpenseType: payload.ExpenseType,
 		Amount:      payload.Amount,
 		Note:        payload.Note,
+		Status:      status,
 		At:          payload.At,
 		CreatedAt:   time.Now(),
 	}
-	err := e.tx.Create(&expense).Error
+	err = e.tx.Create(&expense).Error
 	if err != nil {
 		return err
 	}
 
 	e.data = &expense
+	if alert != nil {
+		alert.ExpenseHistID = expense.ID
+		alert.ActorID = expense.CreatedByID
+		e.alert = alert
+	}
 
-	return nil
+	return e.logStatus("", payload.Note)
+}
+
+// BudgetAlert is budget crossed by last Create or Update, nil when no budget crossed.
+func (e *expenseHistImpl) BudgetAlert() *BudgetAlert {
+	return e.alert
+}
+
+var ErrExpenseTypeNotAllowed = errors.New("expense type not allowed")
+
+func (e *expenseHistImpl) getExpenseType(from db_models.TeamType, key warehouse_models.ExpenseType, warehouseID uint) (*warehouse_models.WareExpenseType, error) {
+	expenseType, err := warehouse_query.GetExpenseType(e.tx, key)
+	if err != nil {
+		return nil, err
+	}
+	if !expenseType.AvailableAt(warehouseID) || !expenseType.AllowTeam(from) {
+		return nil, ErrExpenseTypeNotAllowed
+	}
+
+	return expenseType, nil
+}
+
+func (e *expenseHistImpl) logStatus(fromStatus warehouse_models.ExpenseStatus, note string) error {
+	return e.tx.Create(&warehouse_models.WareExpenseStatusLog{
+		ExpenseHistID: e.data.ID,
+		FromStatus:    fromStatus,
+		ToStatus:      e.data.Status,
+		ActorID:       e.agent.GetUserID(),
+		Note:          note,
+		CreatedAt:     time.Now(),
+	}).Error
 }
 
 func (e *expenseHistImpl) GetExpense(expenseHistID uint) (*warehouse_models.WareExpenseHistory, error) {
@@ -118,6 +177,20 @@ func (e *expenseHistImpl) Update(from db_models.TeamType, payload *UpdateWareExp
 	if e.data == nil {
 		return errors.New("expense data not initialized")
 	}
+	if e.data.TransferID != 0 {
+		return ErrExpenseIsTransfer
+	}
+	if e.data.Deleted {
+		return ErrExpenseDeleted
+	}
+
+	// expense can't be edited while its account or the new account is disabled
+	err := checkAccountActive(e.tx, e.data.AccountID, payload.AccountID)
+	if err != nil {
+		return err
+	}
+	before := warehouse_models.NewExpenseRevisionValue(e.data)
+	saved := *e.data
 
 	if e.data.WarehouseID != payload.WarehouseID {
 		if from != db_models.AdminTeamType {
@@ -127,26 +200,133 @@ func (e *expenseHistImpl) Update(from db_models.TeamType, payload *UpdateWareExp
 		e.data.WarehouseID = payload.WarehouseID
 	}
 
-	if e.data.ExpenseType != payload.ExpenseType {
-		if e.data.ExpenseType.NeedAdminPermission() || payload.ExpenseType.NeedAdminPermission() {
-			if from != db_models.AdminTeamType {
-				err := errors.New("need admin permission for update")
-				return err
-			}
-		}
+	expenseType, err := e.getExpenseType(from, payload.ExpenseType, e.data.WarehouseID)
+	if err != nil {
+		return err
+	}
+
+	// edit is checked against budget on difference to amount already counted in budget of the expense
+	amount := payload.Amount
+	counted, err := countedInBudget(e.tx, &saved, e.data.WarehouseID, payload.ExpenseType, payload.At)
+	if err != nil {
+		return err
+	}
+	if counted {
+		amount -= saved.Amount
+	}
+	alert, err := checkBudget(e.tx, e.data.WarehouseID, expenseType, payload.At, amount)
+	if err != nil {
+		return err
+	}
 
-		e.data.ExpenseType = payload.ExpenseType
+	// non admin edit of admin only expense type is approved again by admin,
+	// edit to type not admin only no longer wait for approval
+	fromStatus := e.data.Status
+	switch {
+	case expenseType.NeedApproval(from):
+		e.data.Status = warehouse_models.ExpenseStatusPending
+	case !expenseType.AdminOnly:
+		e.data.Status = warehouse_models.ExpenseStatusApproved
 	}
+	e.data.ExpenseType = payload.ExpenseType
 
+	// created_by_id stay original author, editor is kept in revision
 	e.data.AccountID = payload.AccountID
-	e.data.CreatedByID = e.agent.GetUserID()
 	e.data.Note = payload.Note
 	e.data.At = payload.At
 	e.data.Amount = payload.Amount
-	err := e.tx.Save(e.data).Error
+	err = e.tx.Save(e.data).Error
+	if err != nil {
+		return err
+	}
+
+	err = e.tx.Create(&warehouse_models.WareExpenseRevision{
+		ExpenseHistID: e.data.ID,
+		EditorID:      e.agent.GetUserID(),
+		EditorFrom:    from,
+		Before:        datatypes.NewJSONType(before),
+		After:         datatypes.NewJSONType(warehouse_models.NewExpenseRevisionValue(e.data)),
+		CreatedAt:     time.Now(),
+	}).Error
 	if err != nil {
 		return err
 	}
 
+	if alert != nil {
+		alert.ExpenseHistID = e.data.ID
+		alert.ActorID = e.agent.GetUserID()
+		e.alert = alert
+	}
+
+	if e.data.Status != fromStatus {
+		return e.logStatus(fromStatus, "")
+	}
+
 	return nil
 }
+
+// Review is admin approving or rejecting pending expense.
+func (e *expenseHistImpl) Review(from db_models.TeamType, approve bool, note string) error {
+	if e.data == nil {
+		return errors.New("expense data not initialized")
+	}
+	if from != db_models.AdminTeamType {
+		return errors.New("need admin permission for review")
+	}
+	if e.data.Deleted {
+		return ErrExpenseDeleted
+	}
+	if e.data.Status != warehouse_models.ExpenseStatusPending {
+		return ErrExpenseNotPending
+	}
+
+	fromStatus := e.data.Status
+	e.data.Status = warehouse_models.ExpenseStatusRejected
+	if approve {
+		e.data.Status = warehouse_models.ExpenseStatusApproved
+	}
+
+	err := e.tx.Model(&warehouse_models.WareExpenseHistory{}).
+		Where("ware_expense_histories.id = ?", e.data.ID).
+		Update("status", e.data.Status).Error
+	if err != nil {
+		return err
+	}
+
+	return e.logStatus(fromStatus, note)
+}
+
+// SetDeleted is soft deleting or restoring expense with reason, transfer leg can't be deleted alone.
+func (e *expenseHistImpl) SetDeleted(deleted bool, reason string) error {
+	if e.data == nil {
+		return errors.New("expense data not initialized")
+	}
+	if e.data.TransferID != 0 {
+		return ErrExpenseIsTransfer
+	}
+	if reason == "" {
+		return ErrExpenseDeleteReasonEmpty
+	}
+	if e.data.Deleted == deleted {
+		if deleted {
+			return ErrExpenseDeleted
+		}
+		return ErrExpenseNotDeleted
+	}
+
+	e.data.Deleted = deleted
+	err := e.tx.Model(&warehouse_models.WareExpenseHistory{}).
+		Where("ware_expense_histories.id = ?", e.data.ID).
+		Update("deleted", deleted).Error
+	if err != nil {
+		return err
+	}
+
+	return e.tx.Create(&warehouse_models.WareExpenseDeleteLog{
+		ExpenseHistID: e.data.ID,
+		ActorID:       e.agent.GetUserID(),
+		Deleted:       deleted,
+		Reason:        reason,
+		CreatedAt:     time.Now(),
+	}).Error
+}
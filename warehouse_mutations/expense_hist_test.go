@@ -95,13 +95,23 @@ func TestWareExpenseHistory(t *testing.T) {
 					&warehouse_models.WareExpenseAccount{},
 					&warehouse_models.WareExpenseAccountWarehouse{},
 					&warehouse_models.WareExpenseHistory{},
+					&warehouse_models.WareExpenseType{},
+					&warehouse_models.WareExpenseTypeTeam{},
+					&warehouse_models.WareExpenseBudget{},
 					&warehouse_models.WareBalanceAccountHistory{},
+					&warehouse_models.WareExpenseAccountStatusLog{},
+					&warehouse_models.WareExpenseStatusLog{},
+					&warehouse_models.WareExpenseRevision{},
+					&warehouse_models.WarehouseTimezone{},
 					&db_models.Team{},
 					&db_models.User{},
 					&db_models.UserTeam{},
 				)
 				assert.Nil(t, err)
 
+				err = db.Create(warehouse_models.DefaultExpenseTypes()).Error
+				assert.Nil(t, err)
+
 				return nil
 			},
 		},
@@ -177,6 +187,56 @@ func TestWareExpenseHistory(t *testing.T) {
 					assert.Equal(t, warehouse_models.ExpenseTypeKitchen, expense.ExpenseType)
 				})
 			})
+
+			t.Run("test disabled account", func(t *testing.T) {
+				accountService := warehouse_mutations.NewExpenseAccountService(&db, warehouseTeam.ID)
+				_, err := accountService.GetByQuery(false, func(tx *gorm.DB) *gorm.DB {
+					return tx.Where("ware_expense_accounts.id = ?", account.AccountID)
+				})
+				assert.Nil(t, err)
+
+				err = accountService.Disabled(warehouseUser.ID, true, "")
+				assert.Nil(t, err)
+
+				t.Run("test create expense", func(t *testing.T) {
+					expenseService := warehouse_mutations.NewExpenseHistService(&db, warehouseUser)
+					_, err := expenseService.GetAccount(account.AccountID, warehouseTeam.ID)
+					assert.Nil(t, err)
+
+					err = expenseService.
+						Create(warehouseTeam.Type, &warehouse_mutations.CreateExpensePayload{
+							ExpenseType: warehouse_models.ExpenseTypeOther,
+							At:          time.Now(),
+							Amount:      10_000,
+						})
+					assert.ErrorIs(t, err, warehouse_mutations.ErrExpenseAccountDisabled)
+				})
+
+				t.Run("test edit expense", func(t *testing.T) {
+					expense := warehouse_models.WareExpenseHistory{}
+					err := db.Where("account_id = ?", account.AccountID).First(&expense).Error
+					assert.Nil(t, err)
+
+					expenseService := warehouse_mutations.NewExpenseHistService(&db, warehouseUser)
+					_, err = expenseService.GetExpense(expense.ID)
+					assert.Nil(t, err)
+
+					err = expenseService.Update(warehouseTeam.Type, &warehouse_mutations.UpdateWareExpenseHistPayload{
+						WarehouseID: warehouseTeam.ID,
+						AccountID:   account.AccountID,
+						ExpenseType: expense.ExpenseType,
+						Amount:      1_000,
+						At:          expense.At,
+					})
+					assert.ErrorIs(t, err, warehouse_mutations.ErrExpenseAccountDisabled)
+				})
+
+				t.Run("test create balance", func(t *testing.T) {
+					balanceService := warehouse_mutations.NewWareBalanceHistMutation(&db, warehouseUser)
+					_, err := balanceService.Create(account.AccountID, 100_000, time.Now())
+					assert.ErrorIs(t, err, warehouse_mutations.ErrExpenseAccountDisabled)
+				})
+			})
 		},
 	)
 }
@@ -0,0 +1,122 @@
+package warehouse_mutations
+
+import (
+	"errors"
+	"time"
+
+	"github.com/pdcgo/shared/db_models"
+	"github.com/pdcgo/shared/interfaces/identity_iface"
+	"github.com/pdcgo/warehouse_service/warehouse_models"
+	"gorm.io/gorm"
+)
+
+func NewExpenseTransferService(tx *gorm.DB, agent identity_iface.Agent) ExpenseTransfer {
+	return &expenseTransferImpl{
+		tx:    tx,
+		agent: agent,
+	}
+}
+
+var ErrTransferCrossWarehouse = errors.New("transfer between warehouse need admin permission")
+var ErrTransferSameAccount = errors.New("transfer to same account")
+var ErrExpenseIsTransfer = errors.New("expense is transfer leg, can't be edited")
+
+// ExpenseTransfer is moving cash between account as linked outcome and income expense history.
+type ExpenseTransfer interface {
+	Create(from db_models.TeamType, payload *CreateTransferPayload) (*warehouse_models.WareExpenseTransfer, error)
+}
+
+type CreateTransferPayload struct {
+	FromWarehouseID uint      `json:"from_warehouse_id"`
+	FromAccountID   uint      `json:"from_account_id"`
+	ToWarehouseID   uint      `json:"to_warehouse_id"`
+	ToAccountID     uint      `json:"to_account_id"`
+	Amount          float64   `json:"amount"`
+	Note            string    `json:"note"`
+	At              time.Time `json:"at"`
+}
+
+type expenseTransferImpl struct {
+	tx    *gorm.DB
+	agent identity_iface.Agent
+}
+
+func (e *expenseTransferImpl) getAccount(accountID, warehouseID uint) (*warehouse_models.WareExpenseAccountWarehouse, error) {
+	account, err := NewExpenseHistService(e.tx, e.agent).GetAccount(accountID, warehouseID)
+	if err != nil {
+		return nil, err
+	}
+	if account.Account != nil && account.Account.Disabled {
+		return nil, ErrExpenseAccountDisabled
+	}
+
+	return account, nil
+}
+
+func (e *expenseTransferImpl) Create(from db_models.TeamType, payload *CreateTransferPayload) (*warehouse_models.WareExpenseTransfer, error) {
+	if payload.Amount <= 0 {
+		return nil, errors.New("transfer amount must be positive")
+	}
+	if payload.ToWarehouseID == 0 {
+		payload.ToWarehouseID = payload.FromWarehouseID
+	}
+	if payload.FromWarehouseID != payload.ToWarehouseID && from != db_models.AdminTeamType {
+		return nil, ErrTransferCrossWarehouse
+	}
+	if payload.FromAccountID == payload.ToAccountID && payload.FromWarehouseID == payload.ToWarehouseID {
+		return nil, ErrTransferSameAccount
+	}
+
+	fromAccount, err := e.getAccount(payload.FromAccountID, payload.FromWarehouseID)
+	if err != nil {
+		return nil, err
+	}
+	toAccount, err := e.getAccount(payload.ToAccountID, payload.ToWarehouseID)
+	if err != nil {
+		return nil, err
+	}
+
+	transfer := warehouse_models.WareExpenseTransfer{
+		FromWarehouseID: fromAccount.WarehouseID,
+		FromAccountID:   fromAccount.AccountID,
+		ToWarehouseID:   toAccount.WarehouseID,
+		ToAccountID:     toAccount.AccountID,
+		Amount:          payload.Amount,
+		Note:            payload.Note,
+		At:              payload.At,
+		CreatedByID:     e.agent.GetUserID(),
+		CreatedAt:       time.Now(),
+	}
+	err = e.tx.Create(&transfer).Error
+	if err != nil {
+		return nil, err
+	}
+
+	legs := []*warehouse_models.WareExpenseHistory{
+		{
+			WarehouseID: transfer.FromWarehouseID,
+			AccountID:   transfer.FromAccountID,
+			Amount:      -transfer.Amount,
+		},
+		{
+			WarehouseID: transfer.ToWarehouseID,
+			AccountID:   transfer.ToAccountID,
+			Amount:      transfer.Amount,
+		},
+	}
+	for _, leg := range legs {
+		leg.CreatedByID = transfer.CreatedByID
+		leg.ExpenseType = warehouse_models.ExpenseTypeTransfer
+		leg.Note = transfer.Note
+		leg.Status = warehouse_models.ExpenseStatusApproved
+		leg.TransferID = transfer.ID
+		leg.At = transfer.At
+		leg.CreatedAt = transfer.CreatedAt
+	}
+	err = e.tx.Create(&legs).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &transfer, nil
+}
@@ -0,0 +1,184 @@
+package warehouse_mutations
+
+import (
+	"errors"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/pdcgo/shared/db_models"
+	"github.com/pdcgo/warehouse_service/warehouse_models"
+	"github.com/pdcgo/warehouse_service/warehouse_query"
+	"gorm.io/gorm"
+)
+
+func NewExpenseTypeMutation(tx *gorm.DB) ExpenseTypeMutation {
+	return &expenseTypeImpl{
+		tx: tx,
+	}
+}
+
+var ErrExpenseTypeExist = errors.New("expense type already exist")
+var ErrExpenseTypeKeyInvalid = errors.New("expense type key must be lowercase letter, number and underscore")
+
+var expenseTypeKeyPattern = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+// ExpenseTypeMutation is managing expense type. type is never deleted because expense history refer to
+// its key, disable it instead.
+type ExpenseTypeMutation interface {
+	Create(key warehouse_models.ExpenseType, payload *ExpenseTypePayload) (*warehouse_models.WareExpenseType, error)
+	Update(key warehouse_models.ExpenseType, payload *ExpenseTypePayload) (*warehouse_models.WareExpenseType, error)
+}
+
+type ExpenseTypePayload struct {
+	Name        string                       `json:"name"`
+	Flow        warehouse_models.ExpenseFlow `json:"flow"`
+	AdminOnly   bool                         `json:"admin_only"`
+	WarehouseID uint                         `json:"warehouse_id"`
+	Disabled    bool                         `json:"disabled"`
+	TeamTypes   []db_models.TeamType         `json:"team_types"`
+}
+
+type expenseTypeImpl struct {
+	tx *gorm.DB
+}
+
+func (payload *ExpenseTypePayload) validate() error {
+	payload.Name = strings.Trim(payload.Name, " ")
+	if payload.Name == "" {
+		return errors.New("expense type name empty")
+	}
+	if !slices.Contains(payload.Flow.EnumList(), string(payload.Flow)) {
+		return errors.New("expense type flow must be income or outcome")
+	}
+	return nil
+}
+
+func (e *expenseTypeImpl) setTeams(key warehouse_models.ExpenseType, teamTypes []db_models.TeamType) ([]*warehouse_models.WareExpenseTypeTeam, error) {
+	err := e.tx.
+		Where("expense_type = ?", key).
+		Delete(&warehouse_models.WareExpenseTypeTeam{}).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	teams := []*warehouse_models.WareExpenseTypeTeam{}
+	for _, teamType := range teamTypes {
+		if slices.ContainsFunc(teams, func(team *warehouse_models.WareExpenseTypeTeam) bool {
+			return team.TeamType == teamType
+		}) {
+			continue
+		}
+		teams = append(teams, &warehouse_models.WareExpenseTypeTeam{
+			ExpenseType: key,
+			TeamType:    teamType,
+		})
+	}
+	if len(teams) == 0 {
+		return teams, nil
+	}
+
+	err = e.tx.Create(&teams).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return teams, nil
+}
+
+func (e *expenseTypeImpl) Create(key warehouse_models.ExpenseType, payload *ExpenseTypePayload) (*warehouse_models.WareExpenseType, error) {
+	if !expenseTypeKeyPattern.MatchString(string(key)) {
+		return nil, ErrExpenseTypeKeyInvalid
+	}
+	err := payload.validate()
+	if err != nil {
+		return nil, err
+	}
+
+	var count int64
+	err = warehouse_query.
+		NewExpenseTypeQuery(e.tx).
+		WithKey(key).
+		GetQuery().
+		Count(&count).
+		Error
+	if err != nil {
+		return nil, err
+	}
+	if count > 0 {
+		return nil, ErrExpenseTypeExist
+	}
+
+	data := warehouse_models.WareExpenseType{
+		Key:         key,
+		Name:        payload.Name,
+		Flow:        payload.Flow,
+		AdminOnly:   payload.AdminOnly,
+		WarehouseID: payload.WarehouseID,
+		Disabled:    payload.Disabled,
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}
+	err = e.tx.Omit("Teams").Create(&data).Error
+	if err != nil {
+		return nil, err
+	}
+
+	data.Teams, err = e.setTeams(key, payload.TeamTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &data, nil
+}
+
+func (e *expenseTypeImpl) Update(key warehouse_models.ExpenseType, payload *ExpenseTypePayload) (*warehouse_models.WareExpenseType, error) {
+	err := payload.validate()
+	if err != nil {
+		return nil, err
+	}
+
+	data := warehouse_models.WareExpenseType{}
+	err = warehouse_query.
+		NewExpenseTypeQuery(e.tx).
+		WithKey(key).
+		GetQuery().
+		Find(&data).
+		Error
+	if err != nil {
+		return nil, err
+	}
+	if key == "" || data.Key == "" {
+		return nil, warehouse_query.ErrExpenseTypeNotFound
+	}
+
+	data.Name = payload.Name
+	data.Flow = payload.Flow
+	data.AdminOnly = payload.AdminOnly
+	data.WarehouseID = payload.WarehouseID
+	data.Disabled = payload.Disabled
+	data.UpdatedAt = time.Now()
+
+	err = e.tx.Model(&warehouse_models.WareExpenseType{}).
+		Where("ware_expense_types.key = ?", key).
+		Updates(map[string]interface{}{
+			"name":         data.Name,
+			"flow":         data.Flow,
+			"admin_only":   data.AdminOnly,
+			"warehouse_id": data.WarehouseID,
+			"disabled":     data.Disabled,
+			"updated_at":   data.UpdatedAt,
+		}).Error
+	if err != nil {
+		return nil, err
+	}
+
+	data.Teams, err = e.setTeams(key, payload.TeamTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &data, nil
+}
@@ -0,0 +1,37 @@
+package warehouse_mutations
+
+import (
+	"errors"
+	"time"
+
+	"github.com/pdcgo/warehouse_service/warehouse_models"
+	"gorm.io/gorm"
+)
+
+var ErrWarehouseNotFound = errors.New("warehouse not found")
+
+// SetWarehouseTimezone changing timezone of warehouse, timezone must be IANA name like Asia/Makassar.
+func SetWarehouseTimezone(tx *gorm.DB, warehouseID uint, timezone string) error {
+	if timezone == "" {
+		return errors.New("timezone empty")
+	}
+
+	_, err := time.LoadLocation(timezone)
+	if err != nil {
+		return err
+	}
+
+	res := tx.
+		Model(&warehouse_models.WarehouseTimezone{}).
+		Where("id = ?", warehouseID).
+		Update("timezone", timezone)
+	if res.Error != nil {
+		return res.Error
+	}
+
+	if res.RowsAffected == 0 {
+		return ErrWarehouseNotFound
+	}
+
+	return nil
+}
@@ -28,7 +28,7 @@ type WarehouseBalanceHistQuery interface {
 	FromWarehouse(warehouseID uint) WarehouseBalanceHistQuery
 	FromAccount(accountID uint) WarehouseBalanceHistQuery
 	CreatedBy(userID uint) WarehouseBalanceHistQuery
-	BalanceAt(at time.Time) WarehouseBalanceHistQuery
+	BalanceAt(at time.Time, loc *time.Location) WarehouseBalanceHistQuery
 	CreatedTime(timeMin, timeMax time.Time) WarehouseBalanceHistQuery
 	BalanceTime(timeMin, timeMax time.Time) WarehouseBalanceHistQuery
 	GetQuery() *gorm.DB
@@ -71,18 +71,14 @@ func (w *warehouseBalanceHistQueryImpl) CreatedBy(userID uint) WarehouseBalanceH
 	return w
 }
 
-// In Day format
-func (w *warehouseBalanceHistQueryImpl) BalanceAt(at time.Time) WarehouseBalanceHistQuery {
+// In Day format, day is taken in loc which is warehouse timezone
+func (w *warehouseBalanceHistQueryImpl) BalanceAt(at time.Time, loc *time.Location) WarehouseBalanceHistQuery {
 	if at.IsZero() {
 		return w
 	}
 
-	field := "DATE(ware_balance_account_histories.at AT TIME ZONE 'Asia/Jakarta')"
-	if w.tx.Dialector.Name() == "sqlite" {
-		field = "DATE(ware_balance_account_histories.at, 'localtime')"
-	}
-
-	w.tx = w.tx.Where(fmt.Sprintf("%s = ?", field), at.Format("2006-01-02"))
+	field := DayField(w.tx, "ware_balance_account_histories.at", loc)
+	w.tx = w.tx.Where(fmt.Sprintf("%s = ?", field), at.In(loc).Format("2006-01-02"))
 	return w
 }
 
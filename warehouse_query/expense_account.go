@@ -160,7 +160,7 @@ func (w *wareExpenseAccountQueryImpl) WithAccountType(accountTypeID uint) WareEx
 
 // IsDisabled implements WareExpenseAccountQuery.
 func (w *wareExpenseAccountQueryImpl) IsDisabled(isDisabled bool) WareExpenseAccountQuery {
-	w.tx = w.tx.Where("ware_expense_accounts.is_disabled = ?", isDisabled)
+	w.tx = w.tx.Where("ware_expense_accounts.disabled = ?", isDisabled)
 	return w
 }
 
@@ -182,9 +182,9 @@ func (AccountStatus) EnumList() []string {
 func (w *wareExpenseAccountQueryImpl) Disabled(accountStatus AccountStatus) WareExpenseAccountQuery {
 	switch accountStatus {
 	case AccountStatusActive:
-		return w.IsDisabled(true)
-	case AccountStatusDisabled:
 		return w.IsDisabled(false)
+	case AccountStatusDisabled:
+		return w.IsDisabled(true)
 	}
 	return w
 }
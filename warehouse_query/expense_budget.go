@@ -0,0 +1,66 @@
+package warehouse_query
+
+import (
+	"time"
+
+	"github.com/pdcgo/warehouse_service/warehouse_models"
+	"gorm.io/gorm"
+)
+
+const BudgetMonthLayout = "2006-01"
+
+// BudgetMonthRange is start and end of budget month in warehouse timezone.
+func BudgetMonthRange(month string, loc *time.Location) (time.Time, time.Time, error) {
+	start, err := time.ParseInLocation(BudgetMonthLayout, month, loc)
+	if err != nil {
+		return start, start, err
+	}
+
+	return start, start.AddDate(0, 1, 0), nil
+}
+
+// BudgetMonth is budget month of time in warehouse timezone.
+func BudgetMonth(at time.Time, loc *time.Location) string {
+	return at.In(loc).Format(BudgetMonthLayout)
+}
+
+type expenseBudgetSpent struct {
+	ExpenseType warehouse_models.ExpenseType
+	Spent       float64
+}
+
+// GetExpenseBudgetSpent summing outcome of expense per type in range, outcome is by flow of expense type. rejected
+// and deleted expense not counted but pending is because it is still waiting to be spent. empty expenseTypes is all type.
+func GetExpenseBudgetSpent(tx *gorm.DB, warehouseID uint, start, end time.Time, expenseTypes ...warehouse_models.ExpenseType) (map[warehouse_models.ExpenseType]float64, error) {
+	query := tx.
+		Model(&warehouse_models.WareExpenseHistory{}).
+		Select([]string{
+			"ware_expense_histories.expense_type",
+			"sum(" + ExpenseFlowAmountField + ") as spent",
+		}).
+		Joins(ExpenseFlowJoin).
+		Where("ware_expense_histories.warehouse_id = ?", warehouseID).
+		Where("ware_expense_histories.at >= ?", start).
+		Where("ware_expense_histories.at < ?", end).
+		Where(ExpenseFlowField+" = ?", FlowTypeOutcome).
+		Where("ware_expense_histories.status != ?", warehouse_models.ExpenseStatusRejected).
+		Where("ware_expense_histories.deleted = ?", false).
+		Group("ware_expense_histories.expense_type")
+
+	if len(expenseTypes) != 0 {
+		query = query.Where("ware_expense_histories.expense_type IN ?", expenseTypes)
+	}
+
+	rows := []*expenseBudgetSpent{}
+	err := query.Find(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	result := map[warehouse_models.ExpenseType]float64{}
+	for _, row := range rows {
+		result[row.ExpenseType] = row.Spent
+	}
+
+	return result, nil
+}
@@ -1,6 +1,7 @@
 package warehouse_query
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/pdcgo/warehouse_service/warehouse_models"
@@ -38,7 +39,11 @@ type WarehouseExpenseQuery interface {
 	WithTypes(expenseTypes []warehouse_models.ExpenseType) WarehouseExpenseQuery
 	CreatedTime(timeMin, timeMax time.Time) WarehouseExpenseQuery
 	ExpenseAt(timeMin, timeMax time.Time) WarehouseExpenseQuery
+	FilterTime(timeType WareExpenseTimeType, timeMin, timeMax time.Time) WarehouseExpenseQuery
 	FlowType(flowType FlowType) WarehouseExpenseQuery
+	WithStatus(status warehouse_models.ExpenseStatus) WarehouseExpenseQuery
+	ExcludeInternalTransfer() WarehouseExpenseQuery
+	IncludeDeleted(include bool) WarehouseExpenseQuery
 	GetQuery() *gorm.DB
 }
 
@@ -112,6 +117,13 @@ func (w *warehouseExpenseQueryImpl) WithTypes(expenseTypes []warehouse_models.Ex
 	return w
 }
 
+func (w *warehouseExpenseQueryImpl) WithStatus(status warehouse_models.ExpenseStatus) WarehouseExpenseQuery {
+	if status != "" {
+		w.tx = w.tx.Where("ware_expense_histories.status = ?", status)
+	}
+	return w
+}
+
 func (w *warehouseExpenseQueryImpl) CreatedTime(timeMin, timeMax time.Time) WarehouseExpenseQuery {
 	if !timeMin.IsZero() {
 		w.tx = w.tx.Where("ware_expense_histories.created_at >= ?", timeMin)
@@ -157,16 +169,53 @@ func (w *warehouseExpenseQueryImpl) FilterTime(timeType WareExpenseTimeType, tim
 	}
 }
 
+// ExpenseFlowJoin is joining expense type of expense history, needed by ExpenseFlowField.
+const ExpenseFlowJoin = "LEFT JOIN ware_expense_types ON ware_expense_types.key = ware_expense_histories.expense_type"
+
+// ExpenseFlowField is flow type of expense history from flow of its expense type. history without
+// expense type row is income or outcome by amount sign.
+var ExpenseFlowField = fmt.Sprintf(
+	"COALESCE(ware_expense_types.flow, CASE WHEN ware_expense_histories.amount >= 0 THEN '%s' ELSE '%s' END)",
+	FlowTypeIncome,
+	FlowTypeOutcome,
+)
+
+// ExpenseFlowAmountField is amount of expense history signed by its flow, outcome is negated so spending sum
+// positive and correction row on opposite sign reduces the sum. needs ExpenseFlowJoin.
+var ExpenseFlowAmountField = fmt.Sprintf(
+	"CASE WHEN %s = '%s' THEN -ware_expense_histories.amount ELSE ware_expense_histories.amount END",
+	ExpenseFlowField,
+	FlowTypeOutcome,
+)
+
+// FlowType is classified by flow of expense type, not amount sign.
 func (w *warehouseExpenseQueryImpl) FlowType(flowType FlowType) WarehouseExpenseQuery {
-	if flowType == "" {
-		return w
-	}
 	switch flowType {
-	case FlowTypeIncome:
-		w.tx = w.tx.Where("ware_expense_histories.amount >= 0")
-	case FlowTypeOutcome:
-		w.tx = w.tx.Where("ware_expense_histories.amount < 0")
+	case FlowTypeIncome, FlowTypeOutcome:
+		w.tx = w.tx.
+			Joins(ExpenseFlowJoin).
+			Where(ExpenseFlowField+" = ?", flowType)
 	}
 
 	return w
 }
+
+// ExcludeInternalTransfer is removing both leg of transfer between account of same warehouse,
+// it is not income or outcome of warehouse.
+func (w *warehouseExpenseQueryImpl) ExcludeInternalTransfer() WarehouseExpenseQuery {
+	w.tx = w.tx.Where(`NOT (ware_expense_histories.transfer_id <> 0 AND EXISTS (
+		SELECT 1 FROM ware_expense_histories transfer_pair
+		WHERE transfer_pair.transfer_id = ware_expense_histories.transfer_id
+		AND transfer_pair.id <> ware_expense_histories.id
+		AND transfer_pair.warehouse_id = ware_expense_histories.warehouse_id
+	))`)
+	return w
+}
+
+// IncludeDeleted false is hiding soft deleted expense.
+func (w *warehouseExpenseQueryImpl) IncludeDeleted(include bool) WarehouseExpenseQuery {
+	if !include {
+		w.tx = w.tx.Where("ware_expense_histories.deleted = ?", false)
+	}
+	return w
+}
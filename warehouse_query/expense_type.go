@@ -0,0 +1,85 @@
+package warehouse_query
+
+import (
+	"errors"
+
+	"github.com/pdcgo/shared/db_models"
+	"github.com/pdcgo/warehouse_service/warehouse_models"
+	"gorm.io/gorm"
+)
+
+var ErrExpenseTypeNotFound = errors.New("expense type not found")
+
+func NewExpenseTypeQuery(tx *gorm.DB) ExpenseTypeQuery {
+	return &expenseTypeQueryImpl{
+		tx: tx.Model(&warehouse_models.WareExpenseType{}),
+	}
+}
+
+type ExpenseTypeQuery interface {
+	WithKey(key warehouse_models.ExpenseType) ExpenseTypeQuery
+	AvailableAt(warehouseID uint) ExpenseTypeQuery
+	ForTeam(teamType db_models.TeamType) ExpenseTypeQuery
+	IsDisabled(isDisabled bool) ExpenseTypeQuery
+	GetQuery() *gorm.DB
+}
+
+type expenseTypeQueryImpl struct {
+	tx *gorm.DB
+}
+
+// GetQuery implements ExpenseTypeQuery.
+func (e *expenseTypeQueryImpl) GetQuery() *gorm.DB {
+	return e.tx
+}
+
+func (e *expenseTypeQueryImpl) WithKey(key warehouse_models.ExpenseType) ExpenseTypeQuery {
+	if key != "" {
+		e.tx = e.tx.Where("ware_expense_types.key = ?", key)
+	}
+	return e
+}
+
+// AvailableAt is type for every warehouse plus type only for that warehouse.
+func (e *expenseTypeQueryImpl) AvailableAt(warehouseID uint) ExpenseTypeQuery {
+	if warehouseID != 0 {
+		e.tx = e.tx.Where("ware_expense_types.warehouse_id IN ?", []uint{0, warehouseID})
+	}
+	return e
+}
+
+// ForTeam is type usable by team type, admin team can use every type.
+func (e *expenseTypeQueryImpl) ForTeam(teamType db_models.TeamType) ExpenseTypeQuery {
+	if teamType != "" && teamType != db_models.AdminTeamType {
+		e.tx = e.tx.Where(
+			"EXISTS (SELECT 1 FROM ware_expense_type_teams WHERE ware_expense_type_teams.expense_type = ware_expense_types.key AND ware_expense_type_teams.team_type = ?)",
+			teamType,
+		)
+	}
+	return e
+}
+
+func (e *expenseTypeQueryImpl) IsDisabled(isDisabled bool) ExpenseTypeQuery {
+	e.tx = e.tx.Where("ware_expense_types.disabled = ?", isDisabled)
+	return e
+}
+
+// GetExpenseType loading active expense type with its allowed team.
+func GetExpenseType(tx *gorm.DB, key warehouse_models.ExpenseType) (*warehouse_models.WareExpenseType, error) {
+	data := warehouse_models.WareExpenseType{}
+	err := NewExpenseTypeQuery(tx).
+		WithKey(key).
+		IsDisabled(false).
+		GetQuery().
+		Preload("Teams").
+		Find(&data).
+		Error
+	if err != nil {
+		return nil, err
+	}
+	if key == "" || data.Key == "" {
+		return nil, ErrExpenseTypeNotFound
+	}
+
+	return &data, nil
+}
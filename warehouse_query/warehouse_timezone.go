@@ -0,0 +1,72 @@
+package warehouse_query
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pdcgo/warehouse_service/warehouse_models"
+	"gorm.io/gorm"
+)
+
+// GetWarehouseLocation loading timezone of warehouse. warehouse 0 or not found is in default timezone.
+func GetWarehouseLocation(tx *gorm.DB, warehouseID uint) (*time.Location, error) {
+	timezone := warehouse_models.DefaultWarehouseTimezone
+
+	if warehouseID != 0 {
+		data := warehouse_models.WarehouseTimezone{}
+		err := tx.
+			Model(&warehouse_models.WarehouseTimezone{}).
+			Select([]string{"id", "timezone"}).
+			Where("id = ?", warehouseID).
+			Find(&data).
+			Error
+		if err != nil {
+			return nil, err
+		}
+
+		if data.Timezone != "" {
+			timezone = data.Timezone
+		}
+	}
+
+	return time.LoadLocation(timezone)
+}
+
+// WarehouseLocationCache loading warehouse timezone once per warehouse, for processing many row in loop.
+type WarehouseLocationCache struct {
+	tx   *gorm.DB
+	locs map[uint]*time.Location
+}
+
+func NewWarehouseLocationCache(tx *gorm.DB) *WarehouseLocationCache {
+	return &WarehouseLocationCache{
+		tx:   tx,
+		locs: map[uint]*time.Location{},
+	}
+}
+
+func (c *WarehouseLocationCache) Get(warehouseID uint) (*time.Location, error) {
+	loc := c.locs[warehouseID]
+	if loc != nil {
+		return loc, nil
+	}
+
+	loc, err := GetWarehouseLocation(c.tx, warehouseID)
+	if err != nil {
+		return nil, err
+	}
+
+	c.locs[warehouseID] = loc
+	return loc, nil
+}
+
+// DayField is sql expression of column as YYYY-MM-DD day in loc. sqlite not knowing timezone name,
+// so using current offset, which is fine for indonesian zone that has no daylight saving.
+func DayField(tx *gorm.DB, column string, loc *time.Location) string {
+	if tx.Dialector.Name() == "sqlite" {
+		_, offset := time.Now().In(loc).Zone()
+		return fmt.Sprintf("DATE(%s, '%+d seconds')", column, offset)
+	}
+
+	return fmt.Sprintf("TO_CHAR(%s AT TIME ZONE '%s', 'YYYY-MM-DD')", column, loc.String())
+}